@@ -1,11 +1,15 @@
 package main
 
 import (
+	"context"
 	"log/slog"
 	"os"
 
 	_ "github.com/joho/godotenv/autoload"
 	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/adapters/http"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/adapters/worker"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/config"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/dto"
 	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/di"
 	_ "github.com/tupizz/restaurant-food-golang-api-fiap/swagger"
 )
@@ -30,7 +34,10 @@ func main() {
 
 	slog.Info("[Tadeu] --> Container built")
 
-	err := container.Invoke(func(router http.Router) {
+	err := container.Invoke(func(cfg *config.Config, router http.Router, workers worker.Workers) {
+		dto.SetMoneyJSONFormat(dto.MoneyJSONFormat(cfg.MoneyJSONFormat))
+		workers.Start(context.Background())
+
 		slog.Info("Server started at port 8080")
 		slog.Info("Swagger UI at http://localhost:8080/swagger/index.html")
 		slog.Info("API Documentation at http://localhost:8080/swagger/doc.json")
@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS refunds;
+
+ALTER TABLE payments DROP COLUMN IF EXISTS refunded_amount;
+
+ALTER TABLE payments DROP CONSTRAINT IF EXISTS payments_status_check;
+ALTER TABLE payments ADD CONSTRAINT payments_status_check
+    CHECK (status IN ('pending', 'approved', 'failed'));
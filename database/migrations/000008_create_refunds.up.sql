@@ -0,0 +1,27 @@
+ALTER TABLE payments DROP CONSTRAINT IF EXISTS payments_status_check;
+ALTER TABLE payments ADD CONSTRAINT payments_status_check
+    CHECK (status IN ('pending', 'approved', 'failed', 'partially_refunded', 'refunded'));
+
+ALTER TABLE payments ADD COLUMN IF NOT EXISTS refunded_amount DECIMAL(10, 2) NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS refunds (
+     id SERIAL PRIMARY KEY,
+     payment_id INT NOT NULL,
+     order_id INT NOT NULL,
+     amount DECIMAL(10, 2) NOT NULL CHECK (amount > 0),
+     reason VARCHAR(255),
+     status VARCHAR(20) DEFAULT 'pending' CHECK (status IN ('pending', 'approved', 'failed')),
+     external_reference VARCHAR(100),
+     created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+     updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+     deleted_at TIMESTAMP DEFAULT NULL,
+     FOREIGN KEY (payment_id) REFERENCES payments(id),
+     FOREIGN KEY (order_id) REFERENCES orders(id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_refunds_payment_id ON refunds (payment_id);
+CREATE INDEX IF NOT EXISTS idx_refunds_external_reference ON refunds (external_reference);
+
+CREATE TRIGGER update_refunds_modtime
+    BEFORE UPDATE ON refunds
+    FOR EACH ROW EXECUTE FUNCTION update_modified_column();
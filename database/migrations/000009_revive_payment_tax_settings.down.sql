@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS order_fees;
+
+ALTER TABLE orders DROP COLUMN IF EXISTS delivery;
+
+DROP TABLE IF EXISTS payment_tax_settings;
@@ -0,0 +1,36 @@
+CREATE TABLE IF NOT EXISTS payment_tax_settings (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    description TEXT,
+    amount_type VARCHAR(20) NOT NULL CHECK (amount_type IN ('fixed', 'percentage')),
+    amount_value NUMERIC(10, 2) NOT NULL CHECK (amount_value >= 0),
+    applicable_to VARCHAR(50) NOT NULL CHECK (applicable_to IN ('credit_card', 'qr_code', 'platform_fee', 'delivery')),
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    deleted_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE TRIGGER update_payment_tax_settings_modtime
+    BEFORE UPDATE ON payment_tax_settings
+    FOR EACH ROW EXECUTE FUNCTION update_modified_column();
+
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS delivery BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE TABLE IF NOT EXISTS order_fees (
+     id SERIAL PRIMARY KEY,
+     order_id INT NOT NULL,
+     payment_tax_setting_id INT,
+     name VARCHAR(100) NOT NULL,
+     amount_type VARCHAR(20) NOT NULL,
+     amount_value NUMERIC(10, 2) NOT NULL,
+     applicable_to VARCHAR(50) NOT NULL,
+     amount DECIMAL(10, 2) NOT NULL,
+     created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+     updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+     deleted_at TIMESTAMP DEFAULT NULL,
+     FOREIGN KEY (order_id) REFERENCES orders(id),
+     FOREIGN KEY (payment_tax_setting_id) REFERENCES payment_tax_settings(id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_order_fees_order_id ON order_fees (order_id);
@@ -0,0 +1,18 @@
+ALTER TABLE order_fees ALTER COLUMN amount_cents TYPE DECIMAL(10, 2) USING amount_cents / 100.0;
+ALTER TABLE order_fees RENAME COLUMN amount_cents TO amount;
+
+ALTER TABLE refunds ALTER COLUMN amount_cents TYPE DECIMAL(10, 2) USING amount_cents / 100.0;
+ALTER TABLE refunds RENAME COLUMN amount_cents TO amount;
+
+ALTER TABLE payments DROP COLUMN IF EXISTS currency;
+ALTER TABLE payments ALTER COLUMN refunded_amount_cents TYPE DECIMAL(10, 2) USING refunded_amount_cents / 100.0;
+ALTER TABLE payments RENAME COLUMN refunded_amount_cents TO refunded_amount;
+ALTER TABLE payments ALTER COLUMN amount_cents TYPE DECIMAL(10, 2) USING amount_cents / 100.0;
+ALTER TABLE payments RENAME COLUMN amount_cents TO amount;
+
+ALTER TABLE order_items ALTER COLUMN price_cents TYPE DECIMAL(10, 2) USING price_cents / 100.0;
+ALTER TABLE order_items RENAME COLUMN price_cents TO price;
+
+ALTER TABLE products DROP COLUMN IF EXISTS currency;
+ALTER TABLE products ALTER COLUMN price_cents TYPE DECIMAL(10, 2) USING price_cents / 100.0;
+ALTER TABLE products RENAME COLUMN price_cents TO price;
@@ -0,0 +1,18 @@
+ALTER TABLE products RENAME COLUMN price TO price_cents;
+ALTER TABLE products ALTER COLUMN price_cents TYPE BIGINT USING ROUND(price_cents * 100)::BIGINT;
+ALTER TABLE products ADD COLUMN IF NOT EXISTS currency VARCHAR(3) NOT NULL DEFAULT 'BRL';
+
+ALTER TABLE order_items RENAME COLUMN price TO price_cents;
+ALTER TABLE order_items ALTER COLUMN price_cents TYPE BIGINT USING ROUND(price_cents * 100)::BIGINT;
+
+ALTER TABLE payments RENAME COLUMN amount TO amount_cents;
+ALTER TABLE payments ALTER COLUMN amount_cents TYPE BIGINT USING ROUND(amount_cents * 100)::BIGINT;
+ALTER TABLE payments RENAME COLUMN refunded_amount TO refunded_amount_cents;
+ALTER TABLE payments ALTER COLUMN refunded_amount_cents TYPE BIGINT USING ROUND(refunded_amount_cents * 100)::BIGINT;
+ALTER TABLE payments ADD COLUMN IF NOT EXISTS currency VARCHAR(3) NOT NULL DEFAULT 'BRL';
+
+ALTER TABLE refunds RENAME COLUMN amount TO amount_cents;
+ALTER TABLE refunds ALTER COLUMN amount_cents TYPE BIGINT USING ROUND(amount_cents * 100)::BIGINT;
+
+ALTER TABLE order_fees RENAME COLUMN amount TO amount_cents;
+ALTER TABLE order_fees ALTER COLUMN amount_cents TYPE BIGINT USING ROUND(amount_cents * 100)::BIGINT;
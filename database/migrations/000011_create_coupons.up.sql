@@ -0,0 +1,55 @@
+CREATE TABLE IF NOT EXISTS coupons (
+    id SERIAL PRIMARY KEY,
+    code VARCHAR(50) NOT NULL,
+    description TEXT,
+    amount_type VARCHAR(20) NOT NULL CHECK (amount_type IN ('fixed', 'percentage')),
+    amount_value NUMERIC(10, 2) NOT NULL CHECK (amount_value > 0),
+    min_order_amount_cents BIGINT NOT NULL DEFAULT 0 CHECK (min_order_amount_cents >= 0),
+    starts_at TIMESTAMP WITH TIME ZONE,
+    ends_at TIMESTAMP WITH TIME ZONE,
+    max_redemptions INT CHECK (max_redemptions > 0),
+    max_redemptions_per_client INT CHECK (max_redemptions_per_client > 0),
+    redemptions_count INT NOT NULL DEFAULT 0,
+    product_ids INT[] NOT NULL DEFAULT '{}',
+    category_ids INT[] NOT NULL DEFAULT '{}',
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    deleted_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_coupons_code ON coupons (UPPER(code)) WHERE deleted_at IS NULL;
+
+CREATE TRIGGER update_coupons_modtime
+    BEFORE UPDATE ON coupons
+    FOR EACH ROW EXECUTE FUNCTION update_modified_column();
+
+CREATE TABLE IF NOT EXISTS coupon_redemptions (
+     id SERIAL PRIMARY KEY,
+     coupon_id INT NOT NULL,
+     order_id INT NOT NULL,
+     client_id INT NOT NULL,
+     created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+     FOREIGN KEY (coupon_id) REFERENCES coupons(id),
+     FOREIGN KEY (order_id) REFERENCES orders(id),
+     FOREIGN KEY (client_id) REFERENCES clients(id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_coupon_redemptions_coupon_id_client_id ON coupon_redemptions (coupon_id, client_id);
+
+CREATE TABLE IF NOT EXISTS order_discounts (
+     id SERIAL PRIMARY KEY,
+     order_id INT NOT NULL,
+     coupon_id INT,
+     code VARCHAR(50) NOT NULL,
+     amount_type VARCHAR(20) NOT NULL,
+     amount_value NUMERIC(10, 2) NOT NULL,
+     amount_cents BIGINT NOT NULL,
+     created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+     updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+     deleted_at TIMESTAMP DEFAULT NULL,
+     FOREIGN KEY (order_id) REFERENCES orders(id),
+     FOREIGN KEY (coupon_id) REFERENCES coupons(id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_order_discounts_order_id ON order_discounts (order_id);
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS loyalty_ledger;
+
+DROP FUNCTION IF EXISTS prevent_loyalty_ledger_changes();
+
+ALTER TABLE order_discounts DROP COLUMN IF EXISTS loyalty_points;
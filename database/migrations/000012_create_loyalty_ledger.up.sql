@@ -0,0 +1,37 @@
+CREATE TABLE IF NOT EXISTS loyalty_ledger (
+     id SERIAL PRIMARY KEY,
+     client_id INT NOT NULL,
+     order_id INT,
+     entry_type VARCHAR(20) NOT NULL CHECK (entry_type IN ('earn', 'redeem', 'earn_reversal', 'redeem_reversal')),
+     points INT NOT NULL CHECK (points <> 0),
+     description VARCHAR(255),
+     created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+     FOREIGN KEY (client_id) REFERENCES clients(id),
+     FOREIGN KEY (order_id) REFERENCES orders(id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_loyalty_ledger_client_id ON loyalty_ledger (client_id);
+CREATE INDEX IF NOT EXISTS idx_loyalty_ledger_order_id ON loyalty_ledger (order_id);
+
+-- An order earns, redeems and gets its redemption back at most once. Earned points may be
+-- reversed in several steps, one per partial refund.
+CREATE UNIQUE INDEX IF NOT EXISTS idx_loyalty_ledger_order_id_entry_type ON loyalty_ledger (order_id, entry_type)
+    WHERE entry_type IN ('earn', 'redeem', 'redeem_reversal');
+
+ALTER TABLE order_discounts ADD COLUMN IF NOT EXISTS loyalty_points INT NOT NULL DEFAULT 0;
+
+-- The ledger is append-only so balances can be audited, corrections are new reversal entries.
+CREATE OR REPLACE FUNCTION prevent_loyalty_ledger_changes()
+RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'loyalty_ledger is append-only, % is not allowed', TG_OP;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER prevent_loyalty_ledger_update_delete
+    BEFORE UPDATE OR DELETE ON loyalty_ledger
+    FOR EACH ROW EXECUTE FUNCTION prevent_loyalty_ledger_changes();
+
+CREATE TRIGGER prevent_loyalty_ledger_truncate
+    BEFORE TRUNCATE ON loyalty_ledger
+    FOR EACH STATEMENT EXECUTE FUNCTION prevent_loyalty_ledger_changes();
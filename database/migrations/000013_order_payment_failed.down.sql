@@ -0,0 +1,9 @@
+UPDATE orders SET status = 'canceled' WHERE status = 'payment_failed';
+
+DROP INDEX IF EXISTS idx_orders_payment_failed_at;
+
+ALTER TABLE orders DROP COLUMN IF EXISTS payment_failed_at;
+
+ALTER TABLE orders DROP CONSTRAINT IF EXISTS orders_status_check;
+ALTER TABLE orders ADD CONSTRAINT orders_status_check
+    CHECK (status IN ('pending', 'preparing', 'ready', 'delivered', 'canceled'));
@@ -0,0 +1,9 @@
+ALTER TABLE orders DROP CONSTRAINT IF EXISTS orders_status_check;
+ALTER TABLE orders ADD CONSTRAINT orders_status_check
+    CHECK (status IN ('pending', 'payment_failed', 'preparing', 'ready', 'delivered', 'canceled'));
+
+-- Orders whose payment failed wait in payment_failed for a retry, the window counts from this moment
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS payment_failed_at TIMESTAMP DEFAULT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_orders_payment_failed_at ON orders (payment_failed_at)
+    WHERE status = 'payment_failed';
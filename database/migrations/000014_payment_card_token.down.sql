@@ -0,0 +1,3 @@
+ALTER TABLE payments DROP COLUMN IF EXISTS card_last4;
+ALTER TABLE payments DROP COLUMN IF EXISTS card_brand;
+ALTER TABLE payments DROP COLUMN IF EXISTS card_token;
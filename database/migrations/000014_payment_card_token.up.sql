@@ -0,0 +1,4 @@
+-- Card payments keep the vault token and what identifies the card to the customer, never the number
+ALTER TABLE payments ADD COLUMN IF NOT EXISTS card_token VARCHAR(64) DEFAULT NULL;
+ALTER TABLE payments ADD COLUMN IF NOT EXISTS card_brand VARCHAR(20) DEFAULT NULL;
+ALTER TABLE payments ADD COLUMN IF NOT EXISTS card_last4 VARCHAR(4) DEFAULT NULL;
@@ -0,0 +1,11 @@
+DROP INDEX IF EXISTS idx_products_category_id;
+
+DROP INDEX IF EXISTS idx_categories_handle_unique;
+DROP INDEX IF EXISTS idx_categories_name_unique;
+ALTER TABLE categories ADD CONSTRAINT unique_handle UNIQUE (handle);
+ALTER TABLE categories ADD CONSTRAINT categories_name_key UNIQUE (name);
+
+ALTER TABLE categories DROP COLUMN IF EXISTS active;
+ALTER TABLE categories DROP COLUMN IF EXISTS display_order;
+ALTER TABLE categories DROP COLUMN IF EXISTS image;
+ALTER TABLE categories DROP COLUMN IF EXISTS description;
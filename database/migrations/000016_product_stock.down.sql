@@ -0,0 +1,7 @@
+ALTER TABLE order_items DROP COLUMN IF EXISTS stock_reserved;
+
+ALTER TABLE products DROP CONSTRAINT IF EXISTS products_low_stock_threshold_check;
+ALTER TABLE products DROP CONSTRAINT IF EXISTS products_stock_quantity_check;
+ALTER TABLE products DROP COLUMN IF EXISTS low_stock_threshold;
+ALTER TABLE products DROP COLUMN IF EXISTS stock_quantity;
+ALTER TABLE products DROP COLUMN IF EXISTS track_stock;
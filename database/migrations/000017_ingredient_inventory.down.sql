@@ -0,0 +1,6 @@
+ALTER TABLE products DROP COLUMN IF EXISTS ingredients_available;
+
+DROP TABLE IF EXISTS ingredient_counts;
+DROP TABLE IF EXISTS ingredient_movements;
+DROP TABLE IF EXISTS product_ingredients;
+DROP TABLE IF EXISTS ingredients;
@@ -0,0 +1,62 @@
+CREATE TABLE IF NOT EXISTS ingredients (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    unit VARCHAR(10) NOT NULL CHECK (unit IN ('g', 'kg', 'ml', 'l', 'unit')),
+    -- Theoretical stock, receipts add to it and orders consume it. It may go negative when the
+    -- kitchen uses more than was received, the next count sets it straight.
+    stock_quantity NUMERIC(12, 3) NOT NULL DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    deleted_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_ingredients_name_unique ON ingredients (LOWER(name)) WHERE deleted_at IS NULL;
+
+CREATE TRIGGER update_ingredients_modtime
+    BEFORE UPDATE ON ingredients
+    FOR EACH ROW EXECUTE FUNCTION update_modified_column();
+
+-- Recipes, the quantity of each ingredient used to make one unit of the product
+CREATE TABLE IF NOT EXISTS product_ingredients (
+    product_id INT NOT NULL,
+    ingredient_id INT NOT NULL,
+    quantity NUMERIC(12, 3) NOT NULL CHECK (quantity > 0),
+    PRIMARY KEY (product_id, ingredient_id),
+    FOREIGN KEY (product_id) REFERENCES products(id),
+    FOREIGN KEY (ingredient_id) REFERENCES ingredients(id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_product_ingredients_ingredient_id ON product_ingredients (ingredient_id);
+
+CREATE TABLE IF NOT EXISTS ingredient_movements (
+    id SERIAL PRIMARY KEY,
+    ingredient_id INT NOT NULL,
+    order_id INT,
+    movement_type VARCHAR(20) NOT NULL CHECK (movement_type IN ('receipt', 'consumption', 'count_adjustment')),
+    quantity NUMERIC(12, 3) NOT NULL CHECK (quantity <> 0),
+    description VARCHAR(255) NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    FOREIGN KEY (ingredient_id) REFERENCES ingredients(id),
+    FOREIGN KEY (order_id) REFERENCES orders(id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_ingredient_movements_ingredient_id ON ingredient_movements (ingredient_id, created_at);
+
+-- An order consumes each ingredient once, however many times its payment is confirmed
+CREATE UNIQUE INDEX IF NOT EXISTS idx_ingredient_movements_consumption ON ingredient_movements (order_id, ingredient_id)
+    WHERE movement_type = 'consumption';
+
+CREATE TABLE IF NOT EXISTS ingredient_counts (
+    id SERIAL PRIMARY KEY,
+    ingredient_id INT NOT NULL,
+    counted_quantity NUMERIC(12, 3) NOT NULL CHECK (counted_quantity >= 0),
+    theoretical_quantity NUMERIC(12, 3) NOT NULL,
+    description VARCHAR(255) NOT NULL DEFAULT '',
+    counted_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    FOREIGN KEY (ingredient_id) REFERENCES ingredients(id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_ingredient_counts_ingredient_id ON ingredient_counts (ingredient_id, counted_at);
+
+-- Products are disabled while an ingredient of their recipe is short
+ALTER TABLE products ADD COLUMN IF NOT EXISTS ingredients_available BOOLEAN NOT NULL DEFAULT TRUE;
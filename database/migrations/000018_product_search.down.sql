@@ -0,0 +1,13 @@
+DROP TRIGGER IF EXISTS update_category_products_search_vector ON categories;
+DROP FUNCTION IF EXISTS update_category_products_search_vector();
+
+DROP TRIGGER IF EXISTS update_product_search_vector ON products;
+DROP FUNCTION IF EXISTS update_product_search_vector();
+
+DROP INDEX IF EXISTS idx_products_name_trgm;
+DROP INDEX IF EXISTS idx_products_search_vector;
+ALTER TABLE products DROP COLUMN IF EXISTS search_vector;
+
+DROP FUNCTION IF EXISTS product_search_vector(TEXT, TEXT, TEXT);
+DROP TEXT SEARCH CONFIGURATION IF EXISTS portuguese_unaccent;
+DROP FUNCTION IF EXISTS immutable_unaccent(text);
@@ -0,0 +1,68 @@
+CREATE EXTENSION IF NOT EXISTS unaccent;
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- unaccent is only STABLE, indexes need an IMMUTABLE function. The dictionary is fixed, so
+-- pinning it makes the wrapper safe to index.
+CREATE OR REPLACE FUNCTION immutable_unaccent(text)
+RETURNS text AS $$
+    SELECT public.unaccent('public.unaccent', $1)
+$$ LANGUAGE sql IMMUTABLE PARALLEL SAFE STRICT;
+
+-- Portuguese stemming over unaccented words, "pão" and "paes" both match "pães"
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_ts_config WHERE cfgname = 'portuguese_unaccent') THEN
+        CREATE TEXT SEARCH CONFIGURATION portuguese_unaccent (COPY = portuguese);
+        ALTER TEXT SEARCH CONFIGURATION portuguese_unaccent
+            ALTER MAPPING FOR hword, hword_part, word WITH unaccent, portuguese_stem;
+    END IF;
+END
+$$;
+
+-- Name weighs more than the category, which weighs more than the description
+CREATE OR REPLACE FUNCTION product_search_vector(name TEXT, description TEXT, category_name TEXT)
+RETURNS tsvector AS $$
+    SELECT setweight(to_tsvector('portuguese_unaccent', COALESCE(name, '')), 'A')
+        || setweight(to_tsvector('portuguese_unaccent', COALESCE(category_name, '')), 'B')
+        || setweight(to_tsvector('portuguese_unaccent', COALESCE(description, '')), 'C')
+$$ LANGUAGE sql STABLE;
+
+ALTER TABLE products ADD COLUMN IF NOT EXISTS search_vector tsvector;
+
+UPDATE products p
+SET search_vector = product_search_vector(p.name, p.description, c.name)
+FROM categories c
+WHERE c.id = p.category_id;
+
+CREATE INDEX IF NOT EXISTS idx_products_search_vector ON products USING GIN (search_vector);
+CREATE INDEX IF NOT EXISTS idx_products_name_trgm ON products USING GIN (immutable_unaccent(LOWER(name)) gin_trgm_ops);
+
+-- The index follows every product insert and update, whatever writes it
+CREATE OR REPLACE FUNCTION update_product_search_vector()
+RETURNS TRIGGER AS $$
+BEGIN
+    NEW.search_vector := product_search_vector(NEW.name, NEW.description, (SELECT name FROM categories WHERE id = NEW.category_id));
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER update_product_search_vector
+    BEFORE INSERT OR UPDATE OF name, description, category_id ON products
+    FOR EACH ROW EXECUTE FUNCTION update_product_search_vector();
+
+-- Renaming a category changes what its products are found by
+CREATE OR REPLACE FUNCTION update_category_products_search_vector()
+RETURNS TRIGGER AS $$
+BEGIN
+    UPDATE products
+    SET search_vector = product_search_vector(name, description, NEW.name)
+    WHERE category_id = NEW.id;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER update_category_products_search_vector
+    AFTER UPDATE OF name ON categories
+    FOR EACH ROW
+    WHEN (OLD.name IS DISTINCT FROM NEW.name)
+    EXECUTE FUNCTION update_category_products_search_vector();
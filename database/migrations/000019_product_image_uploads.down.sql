@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_products_images_product_id;
+
+ALTER TABLE products_images
+    DROP COLUMN IF EXISTS thumbnails,
+    DROP COLUMN IF EXISTS content_type,
+    DROP COLUMN IF EXISTS storage_key;
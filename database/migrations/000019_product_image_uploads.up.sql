@@ -0,0 +1,8 @@
+-- Uploaded images keep the storage key of the original and of each thumbnail, the image column
+-- holds the stable URL the API serves them from. Images added as external URLs have no key.
+ALTER TABLE products_images
+    ADD COLUMN storage_key VARCHAR(255),
+    ADD COLUMN content_type VARCHAR(50),
+    ADD COLUMN thumbnails JSONB NOT NULL DEFAULT '[]';
+
+CREATE INDEX IF NOT EXISTS idx_products_images_product_id ON products_images (product_id) WHERE deleted_at IS NULL;
@@ -0,0 +1,23 @@
+-- Every price a product had or will have. Changes made through the API are applied at once,
+-- scheduled ones wait until effective_at for the price scheduler worker.
+CREATE TABLE IF NOT EXISTS product_prices (
+    id SERIAL PRIMARY KEY,
+    product_id INT NOT NULL,
+    price_cents BIGINT NOT NULL CHECK (price_cents >= 0),
+    currency VARCHAR(3) NOT NULL DEFAULT 'BRL',
+    effective_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    applied_at TIMESTAMP WITH TIME ZONE,
+    canceled_at TIMESTAMP WITH TIME ZONE,
+    changed_by VARCHAR(100),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    FOREIGN KEY (product_id) REFERENCES products(id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_product_prices_product_id ON product_prices (product_id, effective_at DESC);
+CREATE INDEX IF NOT EXISTS idx_product_prices_scheduled ON product_prices (effective_at)
+    WHERE applied_at IS NULL AND canceled_at IS NULL;
+
+-- The current prices start the history, their author is unknown
+INSERT INTO product_prices (product_id, price_cents, currency, effective_at, applied_at, created_at)
+SELECT id, price_cents, currency, COALESCE(created_at, NOW()), COALESCE(created_at, NOW()), COALESCE(created_at, NOW())
+FROM products;
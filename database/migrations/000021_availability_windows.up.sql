@@ -0,0 +1,19 @@
+-- Dayparts, when products can be ordered, in the store timezone. A product with windows of its
+-- own follows them, otherwise it follows the windows of its category. Without any it is always
+-- available. A window ending at or before its start runs past midnight into the next day, one
+-- starting and ending at 00:00 covers the whole weekday.
+CREATE TABLE IF NOT EXISTS availability_windows (
+    id SERIAL PRIMARY KEY,
+    product_id INT,
+    category_id INT,
+    weekday SMALLINT NOT NULL CHECK (weekday BETWEEN 0 AND 6),
+    start_time TIME NOT NULL,
+    end_time TIME NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    CHECK ((product_id IS NULL) <> (category_id IS NULL)),
+    FOREIGN KEY (product_id) REFERENCES products(id),
+    FOREIGN KEY (category_id) REFERENCES categories(id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_availability_windows_product_id ON availability_windows (product_id) WHERE product_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_availability_windows_category_id ON availability_windows (category_id) WHERE category_id IS NOT NULL;
@@ -0,0 +1,6 @@
+DROP TRIGGER IF EXISTS update_product_nutrition_modtime ON product_nutrition;
+DROP TABLE IF EXISTS product_nutrition;
+
+DROP INDEX IF EXISTS idx_products_dietary_tags;
+DROP INDEX IF EXISTS idx_products_allergens;
+ALTER TABLE products DROP COLUMN IF EXISTS dietary_tags, DROP COLUMN IF EXISTS allergens;
@@ -0,0 +1,34 @@
+-- Allergens and dietary tags use a controlled vocabulary, kept in sync with entities.Allergens
+-- and entities.DietaryTags.
+ALTER TABLE products
+    ADD COLUMN allergens TEXT[] NOT NULL DEFAULT '{}' CHECK (allergens <@ ARRAY[
+        'gluten', 'lactose', 'milk', 'eggs', 'peanuts', 'tree_nuts', 'soy', 'fish',
+        'crustaceans', 'molluscs', 'sesame', 'mustard', 'celery', 'sulfites', 'lupin'
+    ]::TEXT[]),
+    ADD COLUMN dietary_tags TEXT[] NOT NULL DEFAULT '{}' CHECK (dietary_tags <@ ARRAY[
+        'vegan', 'vegetarian', 'gluten_free', 'lactose_free'
+    ]::TEXT[]);
+
+CREATE INDEX IF NOT EXISTS idx_products_allergens ON products USING GIN (allergens);
+CREATE INDEX IF NOT EXISTS idx_products_dietary_tags ON products USING GIN (dietary_tags);
+
+-- Nutrition facts per serving, products without a row have none informed
+CREATE TABLE IF NOT EXISTS product_nutrition (
+    product_id INT PRIMARY KEY,
+    serving_size_g NUMERIC(8, 2) NOT NULL CHECK (serving_size_g > 0),
+    energy_kcal NUMERIC(8, 2) NOT NULL CHECK (energy_kcal >= 0),
+    carbohydrates_g NUMERIC(8, 2) NOT NULL CHECK (carbohydrates_g >= 0),
+    sugars_g NUMERIC(8, 2) NOT NULL CHECK (sugars_g >= 0),
+    protein_g NUMERIC(8, 2) NOT NULL CHECK (protein_g >= 0),
+    fat_g NUMERIC(8, 2) NOT NULL CHECK (fat_g >= 0),
+    saturated_fat_g NUMERIC(8, 2) NOT NULL CHECK (saturated_fat_g >= 0),
+    fiber_g NUMERIC(8, 2) NOT NULL CHECK (fiber_g >= 0),
+    sodium_mg NUMERIC(8, 2) NOT NULL CHECK (sodium_mg >= 0),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    FOREIGN KEY (product_id) REFERENCES products(id)
+);
+
+CREATE TRIGGER update_product_nutrition_modtime
+    BEFORE UPDATE ON product_nutrition
+    FOR EACH ROW EXECUTE FUNCTION update_modified_column();
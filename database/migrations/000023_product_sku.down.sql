@@ -0,0 +1,5 @@
+DROP TRIGGER IF EXISTS set_product_default_sku ON products;
+DROP FUNCTION IF EXISTS set_product_default_sku();
+
+DROP INDEX IF EXISTS idx_products_sku;
+ALTER TABLE products DROP COLUMN IF EXISTS sku;
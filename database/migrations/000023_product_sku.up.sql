@@ -0,0 +1,24 @@
+-- The SKU identifies a product across environments, catalog imports upsert by it
+ALTER TABLE products ADD COLUMN sku VARCHAR(64);
+
+UPDATE products SET sku = 'PRD-' || LPAD(id::TEXT, 6, '0');
+
+ALTER TABLE products ALTER COLUMN sku SET NOT NULL;
+
+-- Deleted products release their SKU
+CREATE UNIQUE INDEX IF NOT EXISTS idx_products_sku ON products (sku) WHERE deleted_at IS NULL;
+
+-- Products created without a SKU get one from their id
+CREATE OR REPLACE FUNCTION set_product_default_sku()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF NEW.sku IS NULL OR NEW.sku = '' THEN
+        NEW.sku := 'PRD-' || LPAD(NEW.id::TEXT, 6, '0');
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER set_product_default_sku
+    BEFORE INSERT ON products
+    FOR EACH ROW EXECUTE FUNCTION set_product_default_sku();
@@ -0,0 +1,6 @@
+ALTER TABLE order_items DROP COLUMN IF EXISTS variant_id;
+
+DROP TRIGGER IF EXISTS update_product_variants_modtime ON product_variants;
+DROP INDEX IF EXISTS idx_product_variants_name;
+DROP INDEX IF EXISTS idx_product_variants_sku;
+DROP TABLE IF EXISTS product_variants;
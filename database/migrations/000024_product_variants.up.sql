@@ -0,0 +1,28 @@
+-- Sizes of a product, like 300ml/500ml/1L or P/M/G. Each one has its own price, in the currency of
+-- the product, its own SKU and, when track_stock is set, its own stock. Products with variants are
+-- ordered by variant.
+CREATE TABLE IF NOT EXISTS product_variants (
+    id SERIAL PRIMARY KEY,
+    product_id INT NOT NULL,
+    name VARCHAR(50) NOT NULL,
+    sku VARCHAR(64) NOT NULL,
+    price_cents BIGINT NOT NULL CHECK (price_cents >= 0),
+    track_stock BOOLEAN NOT NULL DEFAULT FALSE,
+    stock_quantity INT NOT NULL DEFAULT 0 CHECK (stock_quantity >= 0),
+    low_stock_threshold INT NOT NULL DEFAULT 5 CHECK (low_stock_threshold >= 0),
+    display_order INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    deleted_at TIMESTAMP WITH TIME ZONE,
+    FOREIGN KEY (product_id) REFERENCES products(id)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_product_variants_sku ON product_variants (sku) WHERE deleted_at IS NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_product_variants_name ON product_variants (product_id, LOWER(name)) WHERE deleted_at IS NULL;
+
+CREATE TRIGGER update_product_variants_modtime
+    BEFORE UPDATE ON product_variants
+    FOR EACH ROW EXECUTE FUNCTION update_modified_column();
+
+-- Items of products with variants remember the variant ordered, its stock is the one reserved
+ALTER TABLE order_items ADD COLUMN IF NOT EXISTS variant_id INT REFERENCES product_variants(id);
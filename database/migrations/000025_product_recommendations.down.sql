@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_product_recommendations_rank;
+DROP TABLE IF EXISTS product_recommendations;
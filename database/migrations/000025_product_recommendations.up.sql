@@ -0,0 +1,13 @@
+-- How many paid orders had both products, rebuilt periodically from order_items. Each pair is stored
+-- both ways, so the recommendations of a product are the rows with its product_id.
+CREATE TABLE IF NOT EXISTS product_recommendations (
+    product_id INT NOT NULL,
+    recommended_product_id INT NOT NULL,
+    orders_count INT NOT NULL CHECK (orders_count > 0),
+    computed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (product_id, recommended_product_id),
+    FOREIGN KEY (product_id) REFERENCES products(id),
+    FOREIGN KEY (recommended_product_id) REFERENCES products(id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_product_recommendations_rank ON product_recommendations (product_id, orders_count DESC);
@@ -3,12 +3,29 @@ UPDATE orders
 SET status = $2
 WHERE id = $1;
 
--- name: UpdateOrderPaymentStatus :exec
+-- name: UpdateOrderPaymentStatus :execrows
 UPDATE payments
 SET status = $3
-WHERE external_reference = $1 AND method = $2;
+WHERE external_reference = $1 AND method = $2 AND status = 'pending';
+
+-- name: MarkOrderPaymentFailed :execrows
+UPDATE orders
+SET status = 'payment_failed', payment_failed_at = NOW()
+WHERE id = $1 AND status IN ('pending', 'payment_failed');
+
+-- name: MarkOrderPaid :execrows
+UPDATE orders
+SET status = 'preparing'
+WHERE id = $1 AND status IN ('pending', 'payment_failed');
 
 -- name: GetOrderIdByExternalReferenceAndMethod :one
 SELECT order_id
 FROM payments
 WHERE external_reference = $1 AND method = $2;
+
+-- name: GetPendingPaymentsCreatedBefore :many
+SELECT id, order_id, status, method, amount_cents, currency, external_reference, created_at
+FROM payments
+WHERE status = 'pending' AND deleted_at IS NULL AND created_at < $1
+ORDER BY created_at
+LIMIT $2;
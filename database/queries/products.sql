@@ -1,6 +1,6 @@
 -- name: UpdateProduct :one
 UPDATE products
-SET name = $2, description = $3, price = $4, category_id = $5
+SET name = $2, description = $3, price_cents = $4, category_id = $5
 WHERE id = $1
 RETURNING *;
 
@@ -9,7 +9,8 @@ SELECT
     p.id, 
     p.name, 
     p.description, 
-    p.price, 
+    p.price_cents, 
+    p.currency, 
     p.created_at,
     p.updated_at,
     c.id AS category_id, 
@@ -30,7 +31,8 @@ SELECT
     p.id, 
     p.name, 
     p.description, 
-    p.price, 
+    p.price_cents, 
+    p.currency, 
     p.created_at,
     p.updated_at,
     c.id AS category_id, 
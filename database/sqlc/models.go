@@ -8,13 +8,27 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type AvailabilityWindow struct {
+	ID         int32
+	ProductID  pgtype.Int4
+	CategoryID pgtype.Int4
+	Weekday    int16
+	StartTime  pgtype.Time
+	EndTime    pgtype.Time
+	CreatedAt  pgtype.Timestamptz
+}
+
 type Category struct {
-	ID        int32
-	CreatedAt pgtype.Timestamptz
-	UpdatedAt pgtype.Timestamptz
-	DeletedAt pgtype.Timestamptz
-	Name      string
-	Handle    string
+	ID           int32
+	CreatedAt    pgtype.Timestamptz
+	UpdatedAt    pgtype.Timestamptz
+	DeletedAt    pgtype.Timestamptz
+	Name         string
+	Handle       string
+	Description  string
+	Image        string
+	DisplayOrder int32
+	Active       bool
 }
 
 type Client struct {
@@ -26,37 +40,141 @@ type Client struct {
 	DeletedAt pgtype.Timestamptz
 }
 
-type Order struct {
+type Coupon struct {
+	ID                      int32
+	Code                    string
+	Description             pgtype.Text
+	AmountType              string
+	AmountValue             pgtype.Numeric
+	MinOrderAmountCents     int64
+	StartsAt                pgtype.Timestamptz
+	EndsAt                  pgtype.Timestamptz
+	MaxRedemptions          pgtype.Int4
+	MaxRedemptionsPerClient pgtype.Int4
+	RedemptionsCount        int32
+	ProductIds              []int32
+	CategoryIds             []int32
+	Active                  bool
+	CreatedAt               pgtype.Timestamptz
+	UpdatedAt               pgtype.Timestamptz
+	DeletedAt               pgtype.Timestamptz
+}
+
+type CouponRedemption struct {
 	ID        int32
+	CouponID  int32
+	OrderID   int32
 	ClientID  int32
-	Status    pgtype.Text
 	CreatedAt pgtype.Timestamp
-	UpdatedAt pgtype.Timestamp
-	DeletedAt pgtype.Timestamp
+}
+
+type Ingredient struct {
+	ID            int32
+	Name          string
+	Unit          string
+	StockQuantity pgtype.Numeric
+	CreatedAt     pgtype.Timestamptz
+	UpdatedAt     pgtype.Timestamptz
+	DeletedAt     pgtype.Timestamptz
+}
+
+type IngredientCount struct {
+	ID                  int32
+	IngredientID        int32
+	CountedQuantity     pgtype.Numeric
+	TheoreticalQuantity pgtype.Numeric
+	Description         string
+	CountedAt           pgtype.Timestamptz
+}
+
+type IngredientMovement struct {
+	ID           int32
+	IngredientID int32
+	OrderID      pgtype.Int4
+	MovementType string
+	Quantity     pgtype.Numeric
+	Description  string
+	CreatedAt    pgtype.Timestamptz
+}
+
+type LoyaltyLedger struct {
+	ID          int32
+	ClientID    int32
+	OrderID     pgtype.Int4
+	EntryType   string
+	Points      int32
+	Description pgtype.Text
+	CreatedAt   pgtype.Timestamptz
+}
+
+type Order struct {
+	ID              int32
+	ClientID        int32
+	Status          pgtype.Text
+	CreatedAt       pgtype.Timestamp
+	UpdatedAt       pgtype.Timestamp
+	DeletedAt       pgtype.Timestamp
+	Delivery        bool
+	PaymentFailedAt pgtype.Timestamp
+}
+
+type OrderDiscount struct {
+	ID            int32
+	OrderID       int32
+	CouponID      pgtype.Int4
+	Code          string
+	AmountType    string
+	AmountValue   pgtype.Numeric
+	AmountCents   int64
+	CreatedAt     pgtype.Timestamp
+	UpdatedAt     pgtype.Timestamp
+	DeletedAt     pgtype.Timestamp
+	LoyaltyPoints int32
+}
+
+type OrderFee struct {
+	ID                  int32
+	OrderID             int32
+	PaymentTaxSettingID pgtype.Int4
+	Name                string
+	AmountType          string
+	AmountValue         pgtype.Numeric
+	ApplicableTo        string
+	AmountCents         int64
+	CreatedAt           pgtype.Timestamp
+	UpdatedAt           pgtype.Timestamp
+	DeletedAt           pgtype.Timestamp
 }
 
 type OrderItem struct {
-	ID        int32
-	OrderID   int32
-	ProductID int32
-	Quantity  int32
-	Price     pgtype.Numeric
-	CreatedAt pgtype.Timestamp
-	UpdatedAt pgtype.Timestamp
-	DeletedAt pgtype.Timestamp
+	ID            int32
+	OrderID       int32
+	ProductID     int32
+	Quantity      int32
+	PriceCents    int64
+	CreatedAt     pgtype.Timestamp
+	UpdatedAt     pgtype.Timestamp
+	DeletedAt     pgtype.Timestamp
+	StockReserved bool
+	VariantID     pgtype.Int4
 }
 
 type Payment struct {
-	ID                int32
-	OrderID           int32
-	Status            pgtype.Text
-	Method            string
-	Amount            pgtype.Numeric
-	ExternalReference pgtype.Text
-	QrData            pgtype.Text
-	CreatedAt         pgtype.Timestamp
-	UpdatedAt         pgtype.Timestamp
-	DeletedAt         pgtype.Timestamp
+	ID                  int32
+	OrderID             int32
+	Status              pgtype.Text
+	Method              string
+	AmountCents         int64
+	ExternalReference   pgtype.Text
+	QrData              pgtype.Text
+	CreatedAt           pgtype.Timestamp
+	UpdatedAt           pgtype.Timestamp
+	DeletedAt           pgtype.Timestamp
+	RefundedAmountCents int64
+	Currency            string
+	CardToken           pgtype.Text
+	CardBrand           pgtype.Text
+	CardLast4           pgtype.Text
 }
 
 type PaymentTaxSetting struct {
@@ -66,27 +184,101 @@ type PaymentTaxSetting struct {
 	AmountType   string
 	AmountValue  pgtype.Numeric
 	ApplicableTo string
+	Active       bool
 	CreatedAt    pgtype.Timestamptz
 	UpdatedAt    pgtype.Timestamptz
 	DeletedAt    pgtype.Timestamptz
 }
 
+type ProductIngredient struct {
+	ProductID    int32
+	IngredientID int32
+	Quantity     pgtype.Numeric
+}
+
+type ProductNutrition struct {
+	ProductID      int32
+	ServingSizeG   pgtype.Numeric
+	EnergyKcal     pgtype.Numeric
+	CarbohydratesG pgtype.Numeric
+	SugarsG        pgtype.Numeric
+	ProteinG       pgtype.Numeric
+	FatG           pgtype.Numeric
+	SaturatedFatG  pgtype.Numeric
+	FiberG         pgtype.Numeric
+	SodiumMg       pgtype.Numeric
+	CreatedAt      pgtype.Timestamptz
+	UpdatedAt      pgtype.Timestamptz
+}
+
+type ProductPrice struct {
+	ID          int32
+	ProductID   int32
+	PriceCents  int64
+	Currency    string
+	EffectiveAt pgtype.Timestamptz
+	AppliedAt   pgtype.Timestamptz
+	CanceledAt  pgtype.Timestamptz
+	ChangedBy   pgtype.Text
+	CreatedAt   pgtype.Timestamptz
+}
+
 type Product struct {
+	ID                   int32
+	Name                 string
+	Description          string
+	PriceCents           int64
+	CategoryID           int32
+	CreatedAt            pgtype.Timestamptz
+	UpdatedAt            pgtype.Timestamptz
+	DeletedAt            pgtype.Timestamptz
+	Currency             string
+	TrackStock           bool
+	StockQuantity        int32
+	LowStockThreshold    int32
+	IngredientsAvailable bool
+	SearchVector         interface{}
+	Allergens            []string
+	DietaryTags          []string
+	Sku                  string
+}
+
+type ProductVariant struct {
+	ID                int32
+	ProductID         int32
+	Name              string
+	Sku               string
+	PriceCents        int64
+	TrackStock        bool
+	StockQuantity     int32
+	LowStockThreshold int32
+	DisplayOrder      int32
+	CreatedAt         pgtype.Timestamptz
+	UpdatedAt         pgtype.Timestamptz
+	DeletedAt         pgtype.Timestamptz
+}
+
+type ProductsImage struct {
 	ID          int32
-	Name        string
-	Description string
-	Price       pgtype.Numeric
-	CategoryID  int32
+	ProductID   int32
+	Image       string
 	CreatedAt   pgtype.Timestamptz
 	UpdatedAt   pgtype.Timestamptz
 	DeletedAt   pgtype.Timestamptz
+	StorageKey  pgtype.Text
+	ContentType pgtype.Text
+	Thumbnails  []byte
 }
 
-type ProductsImage struct {
-	ID        int32
-	ProductID int32
-	Image     string
-	CreatedAt pgtype.Timestamptz
-	UpdatedAt pgtype.Timestamptz
-	DeletedAt pgtype.Timestamptz
+type Refund struct {
+	ID                int32
+	PaymentID         int32
+	OrderID           int32
+	AmountCents       int64
+	Reason            pgtype.Text
+	Status            pgtype.Text
+	ExternalReference pgtype.Text
+	CreatedAt         pgtype.Timestamp
+	UpdatedAt         pgtype.Timestamp
+	DeletedAt         pgtype.Timestamp
 }
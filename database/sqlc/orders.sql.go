@@ -30,20 +30,27 @@ SELECT o.id         AS order_id,
        c.id         AS client_id,
        p.id         AS product_id,
        p.name       AS product_name,
-       p.price      AS product_price,
+       p.price_cents AS product_price_cents,
        p.description AS product_description,
        oi.quantity  AS product_quantity,
+       oi.price_cents AS item_price_cents,
+       COALESCE(oi.variant_id, 0)::int AS variant_id,
+       COALESCE(pv.name, '')::text AS variant_name,
        py.id        AS payment_id,
        py.status    AS payment_status,
-       py.amount    AS payment_amount,
+       py.amount_cents AS payment_amount_cents,
+       py.refunded_amount_cents AS payment_refunded_amount_cents,
+       py.amount_cents - py.refunded_amount_cents AS payment_net_amount_cents,
+       py.currency  AS payment_currency,
        py.method    AS payment_method,
        pt.handle    AS category_handle
 FROM paginated_orders po 
 JOIN orders o ON o.id = po.id
 JOIN order_items oi ON oi.order_id = o.id
 JOIN products p ON oi.product_id = p.id
+LEFT JOIN product_variants pv ON pv.id = oi.variant_id
 JOIN categories pt ON p.category_id = pt.id
-JOIN payments py ON py.order_id = o.id
+JOIN payments py ON py.order_id = o.id AND py.deleted_at IS NULL
 JOIN clients c ON c.id = o.client_id
 WHERE o.deleted_at IS NULL AND o.status IN ('ready', 'preparing', 'pending')
 ORDER BY 
@@ -62,23 +69,29 @@ type GetAllOrdersParams struct {
 }
 
 type GetAllOrdersRow struct {
-	OrderID            int32
-	OrderCreatedAt     pgtype.Timestamp
-	OrderUpdatedAt     pgtype.Timestamp
-	OrderStatus        pgtype.Text
-	ClientName         string
-	ClientCpf          string
-	ClientID           int32
-	ProductID          int32
-	ProductName        string
-	ProductPrice       pgtype.Numeric
-	ProductDescription string
-	ProductQuantity    int32
-	PaymentID          int32
-	PaymentStatus      pgtype.Text
-	PaymentAmount      pgtype.Numeric
-	PaymentMethod      string
-	CategoryHandle     string
+	OrderID                    int32
+	OrderCreatedAt             pgtype.Timestamp
+	OrderUpdatedAt             pgtype.Timestamp
+	OrderStatus                pgtype.Text
+	ClientName                 string
+	ClientCpf                  string
+	ClientID                   int32
+	ProductID                  int32
+	ProductName                string
+	ProductPriceCents          int64
+	ProductDescription         string
+	ProductQuantity            int32
+	ItemPriceCents             int64
+	VariantID                  int32
+	VariantName                string
+	PaymentID                  int32
+	PaymentStatus              pgtype.Text
+	PaymentAmountCents         int64
+	PaymentRefundedAmountCents int64
+	PaymentNetAmountCents      int64
+	PaymentCurrency            string
+	PaymentMethod              string
+	CategoryHandle             string
 }
 
 func (q *Queries) GetAllOrders(ctx context.Context, arg GetAllOrdersParams) ([]GetAllOrdersRow, error) {
@@ -100,12 +113,18 @@ func (q *Queries) GetAllOrders(ctx context.Context, arg GetAllOrdersParams) ([]G
 			&i.ClientID,
 			&i.ProductID,
 			&i.ProductName,
-			&i.ProductPrice,
+			&i.ProductPriceCents,
 			&i.ProductDescription,
 			&i.ProductQuantity,
+			&i.ItemPriceCents,
+			&i.VariantID,
+			&i.VariantName,
 			&i.PaymentID,
 			&i.PaymentStatus,
-			&i.PaymentAmount,
+			&i.PaymentAmountCents,
+			&i.PaymentRefundedAmountCents,
+			&i.PaymentNetAmountCents,
+			&i.PaymentCurrency,
 			&i.PaymentMethod,
 			&i.CategoryHandle,
 		); err != nil {
@@ -29,10 +29,91 @@ func (q *Queries) GetOrderIdByExternalReferenceAndMethod(ctx context.Context, ar
 	return order_id, err
 }
 
-const updateOrderPaymentStatus = `-- name: UpdateOrderPaymentStatus :exec
+const getPendingPaymentsCreatedBefore = `-- name: GetPendingPaymentsCreatedBefore :many
+SELECT id, order_id, status, method, amount_cents, currency, external_reference, created_at
+FROM payments
+WHERE status = 'pending' AND deleted_at IS NULL AND created_at < $1
+ORDER BY created_at
+LIMIT $2
+`
+
+type GetPendingPaymentsCreatedBeforeParams struct {
+	CreatedAt pgtype.Timestamp
+	Limit     int32
+}
+
+type GetPendingPaymentsCreatedBeforeRow struct {
+	ID                int32
+	OrderID           int32
+	Status            pgtype.Text
+	Method            string
+	AmountCents       int64
+	Currency          string
+	ExternalReference pgtype.Text
+	CreatedAt         pgtype.Timestamp
+}
+
+func (q *Queries) GetPendingPaymentsCreatedBefore(ctx context.Context, arg GetPendingPaymentsCreatedBeforeParams) ([]GetPendingPaymentsCreatedBeforeRow, error) {
+	rows, err := q.db.Query(ctx, getPendingPaymentsCreatedBefore, arg.CreatedAt, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetPendingPaymentsCreatedBeforeRow
+	for rows.Next() {
+		var i GetPendingPaymentsCreatedBeforeRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrderID,
+			&i.Status,
+			&i.Method,
+			&i.AmountCents,
+			&i.Currency,
+			&i.ExternalReference,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markOrderPaid = `-- name: MarkOrderPaid :execrows
+UPDATE orders
+SET status = 'preparing'
+WHERE id = $1 AND status IN ('pending', 'payment_failed')
+`
+
+func (q *Queries) MarkOrderPaid(ctx context.Context, id int32) (int64, error) {
+	result, err := q.db.Exec(ctx, markOrderPaid, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const markOrderPaymentFailed = `-- name: MarkOrderPaymentFailed :execrows
+UPDATE orders
+SET status = 'payment_failed', payment_failed_at = NOW()
+WHERE id = $1 AND status IN ('pending', 'payment_failed')
+`
+
+func (q *Queries) MarkOrderPaymentFailed(ctx context.Context, id int32) (int64, error) {
+	result, err := q.db.Exec(ctx, markOrderPaymentFailed, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateOrderPaymentStatus = `-- name: UpdateOrderPaymentStatus :execrows
 UPDATE payments
 SET status = $3
-WHERE external_reference = $1 AND method = $2
+WHERE external_reference = $1 AND method = $2 AND status = 'pending'
 `
 
 type UpdateOrderPaymentStatusParams struct {
@@ -41,9 +122,12 @@ type UpdateOrderPaymentStatusParams struct {
 	Status            pgtype.Text
 }
 
-func (q *Queries) UpdateOrderPaymentStatus(ctx context.Context, arg UpdateOrderPaymentStatusParams) error {
-	_, err := q.db.Exec(ctx, updateOrderPaymentStatus, arg.ExternalReference, arg.Method, arg.Status)
-	return err
+func (q *Queries) UpdateOrderPaymentStatus(ctx context.Context, arg UpdateOrderPaymentStatusParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateOrderPaymentStatus, arg.ExternalReference, arg.Method, arg.Status)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
 
 const updateOrderStatus = `-- name: UpdateOrderStatus :exec
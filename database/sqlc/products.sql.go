@@ -16,7 +16,8 @@ SELECT
     p.id, 
     p.name, 
     p.description, 
-    p.price, 
+    p.price_cents, 
+    p.currency, 
     p.created_at,
     p.updated_at,
     c.id AS category_id, 
@@ -38,7 +39,8 @@ type GetProductByIdRow struct {
 	ID                int32
 	Name              string
 	Description       string
-	Price             pgtype.Numeric
+	PriceCents        int64
+	Currency          string
 	CreatedAt         pgtype.Timestamptz
 	UpdatedAt         pgtype.Timestamptz
 	CategoryID        pgtype.Int4
@@ -65,7 +67,8 @@ func (q *Queries) GetProductById(ctx context.Context, id int32) ([]GetProductByI
 			&i.ID,
 			&i.Name,
 			&i.Description,
-			&i.Price,
+			&i.PriceCents,
+			&i.Currency,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.CategoryID,
@@ -93,7 +96,8 @@ SELECT
     p.id, 
     p.name, 
     p.description, 
-    p.price, 
+    p.price_cents, 
+    p.currency, 
     p.created_at,
     p.updated_at,
     c.id AS category_id, 
@@ -114,7 +118,8 @@ type GetProductsByIdsRow struct {
 	ID                int32
 	Name              string
 	Description       string
-	Price             pgtype.Numeric
+	PriceCents        int64
+	Currency          string
 	CreatedAt         pgtype.Timestamptz
 	UpdatedAt         pgtype.Timestamptz
 	CategoryID        pgtype.Int4
@@ -140,7 +145,8 @@ func (q *Queries) GetProductsByIds(ctx context.Context, ids []int32) ([]GetProdu
 			&i.ID,
 			&i.Name,
 			&i.Description,
-			&i.Price,
+			&i.PriceCents,
+			&i.Currency,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.CategoryID,
@@ -164,16 +170,16 @@ func (q *Queries) GetProductsByIds(ctx context.Context, ids []int32) ([]GetProdu
 
 const updateProduct = `-- name: UpdateProduct :one
 UPDATE products
-SET name = $2, description = $3, price = $4, category_id = $5
+SET name = $2, description = $3, price_cents = $4, category_id = $5
 WHERE id = $1
-RETURNING id, name, description, price, category_id, created_at, updated_at, deleted_at
+RETURNING id, name, description, price_cents, category_id, created_at, updated_at, deleted_at, currency, track_stock, stock_quantity, low_stock_threshold, ingredients_available, search_vector, allergens, dietary_tags, sku
 `
 
 type UpdateProductParams struct {
 	ID          int32
 	Name        string
 	Description string
-	Price       pgtype.Numeric
+	PriceCents  int64
 	CategoryID  int32
 }
 
@@ -182,7 +188,7 @@ func (q *Queries) UpdateProduct(ctx context.Context, arg UpdateProductParams) (P
 		arg.ID,
 		arg.Name,
 		arg.Description,
-		arg.Price,
+		arg.PriceCents,
 		arg.CategoryID,
 	)
 	var i Product
@@ -190,11 +196,20 @@ func (q *Queries) UpdateProduct(ctx context.Context, arg UpdateProductParams) (P
 		&i.ID,
 		&i.Name,
 		&i.Description,
-		&i.Price,
+		&i.PriceCents,
 		&i.CategoryID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.Currency,
+		&i.TrackStock,
+		&i.StockQuantity,
+		&i.LowStockThreshold,
+		&i.IngredientsAvailable,
+		&i.SearchVector,
+		&i.Allergens,
+		&i.DietaryTags,
+		&i.Sku,
 	)
 	return i, err
 }
@@ -0,0 +1,21 @@
+package cache
+
+import (
+	"context"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+)
+
+// disabledProductCache stands in for the cache when PRODUCT_CACHE_ENABLED is false, product reads
+// go straight to the database.
+type disabledProductCache struct{}
+
+func NewDisabledProductCache() ports.ProductCache {
+	return disabledProductCache{}
+}
+
+func (disabledProductCache) Invalidate(ctx context.Context) {}
+
+func (disabledProductCache) Stats(ctx context.Context) ports.ProductCacheStats {
+	return ports.ProductCacheStats{}
+}
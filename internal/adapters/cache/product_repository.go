@@ -0,0 +1,307 @@
+package cache
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/gob"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	productCacheVersionKey = "products:cache:version"
+	productCacheKeyPrefix  = "products:cache:v"
+)
+
+// cachedProductRepository decorates a product repository, caching lists and products read by id in
+// Redis. Keys carry the cache version, so an invalidation is a single INCR and the old entries
+// expire with their TTL. Concurrent misses of the same key share one database read.
+//
+// GetByIds is not cached, checkout reads prices and stock through it and needs them current.
+type cachedProductRepository struct {
+	ports.ProductRepository
+	redisClient *redis.Client
+	ttl         time.Duration
+	group       singleflight.Group
+
+	hits          atomic.Uint64
+	misses        atomic.Uint64
+	failures      atomic.Uint64
+	invalidations atomic.Uint64
+}
+
+func NewProductRepository(productRepository ports.ProductRepository, redisClient *redis.Client, ttl time.Duration) (ports.ProductRepository, ports.ProductCache) {
+	cached := &cachedProductRepository{ProductRepository: productRepository, redisClient: redisClient, ttl: ttl}
+	return cached, cached
+}
+
+// Values are cached with gob, JSON would follow the money format of the API and could lose the currency
+type cachedProducts struct {
+	Products []entities.Product
+	Total    int
+}
+
+// productListKey identifies the filter, availability is checked by the minute so requests asking
+// for products available now share the entry.
+type productListKey struct {
+	Category         string
+	Query            string
+	AvailableAt      int64
+	ExcludeAllergens []string
+	DietaryTags      []string
+	Page             int
+	PageSize         int
+}
+
+func (r *cachedProductRepository) GetAll(ctx context.Context, filter *ports.ProductFilter) ([]entities.Product, int, error) {
+	// Deleted products and those of inactive categories are only listed to admins, that read the
+	// database itself
+	if filter.IncludeDeleted || filter.OnlyDeleted || filter.IncludeInactiveCategories {
+		return r.ProductRepository.GetAll(ctx, filter)
+	}
+
+	key := productListKey{
+		Category:         filter.Category,
+		Query:            filter.Query,
+		ExcludeAllergens: filter.ExcludeAllergens,
+		DietaryTags:      filter.DietaryTags,
+		Page:             filter.Page,
+		PageSize:         filter.PageSize,
+	}
+	if filter.AvailableAt != nil {
+		availableAt := filter.AvailableAt.Truncate(time.Minute)
+		filter.AvailableAt = &availableAt
+		key.AvailableAt = availableAt.Unix()
+	}
+
+	encodedKey, err := json.Marshal(key)
+	if err != nil {
+		return nil, 0, err
+	}
+	hash := sha256.Sum256(encodedKey)
+
+	var cached cachedProducts
+	err = r.read(ctx, "list:"+hex.EncodeToString(hash[:]), &cached, func(ctx context.Context) (any, error) {
+		products, total, err := r.ProductRepository.GetAll(ctx, filter)
+		return cachedProducts{Products: products, Total: total}, err
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return cached.Products, cached.Total, nil
+}
+
+func (r *cachedProductRepository) GetById(ctx context.Context, id int) (entities.Product, error) {
+	var product entities.Product
+	err := r.read(ctx, "id:"+strconv.Itoa(id), &product, func(ctx context.Context) (any, error) {
+		return r.ProductRepository.GetById(ctx, id)
+	})
+
+	return product, err
+}
+
+// read fills value from the cache or, on a miss, from load, storing the result. Redis failures fall
+// back to load, the menu keeps working without the cache.
+func (r *cachedProductRepository) read(ctx context.Context, key string, value any, load func(ctx context.Context) (any, error)) error {
+	version, err := r.version(ctx)
+	if err != nil {
+		r.failures.Add(1)
+		slog.Error("Error reading the product cache version", "error", err)
+
+		loaded, err := load(ctx)
+		if err != nil {
+			return err
+		}
+
+		return copyValue(loaded, value)
+	}
+	key = productCacheKeyPrefix + strconv.FormatInt(version, 10) + ":" + key
+
+	encoded, err := r.redisClient.Get(ctx, key).Bytes()
+	if err == nil {
+		if err = gob.NewDecoder(bytes.NewReader(encoded)).Decode(value); err == nil {
+			r.hits.Add(1)
+			return nil
+		}
+	}
+	if !errors.Is(err, redis.Nil) {
+		r.failures.Add(1)
+		slog.Error("Error reading the product cache", "key", key, "error", err)
+	}
+	r.misses.Add(1)
+
+	// Only one request per key reads the database, the others wait for its result. The read goes
+	// on if the request that started it is canceled, the others still need it.
+	shared, err, _ := r.group.Do(key, func() (any, error) {
+		ctx := context.WithoutCancel(ctx)
+		loaded, err := load(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		encoded, err := encode(loaded)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := r.redisClient.Set(ctx, key, encoded, r.ttl).Err(); err != nil {
+			r.failures.Add(1)
+			slog.Error("Error writing the product cache", "key", key, "error", err)
+		}
+
+		return encoded, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// Each request decodes its own copy, the products are not shared between them
+	return gob.NewDecoder(bytes.NewReader(shared.([]byte))).Decode(value)
+}
+
+func (r *cachedProductRepository) version(ctx context.Context) (int64, error) {
+	version, err := r.redisClient.Get(ctx, productCacheVersionKey).Int64()
+	if errors.Is(err, redis.Nil) {
+		return 0, nil
+	}
+
+	return version, err
+}
+
+func (r *cachedProductRepository) Invalidate(ctx context.Context) {
+	if err := r.redisClient.Incr(ctx, productCacheVersionKey).Err(); err != nil {
+		r.failures.Add(1)
+		slog.Error("Error invalidating the product cache, entries expire with their TTL", "error", err)
+		return
+	}
+
+	r.invalidations.Add(1)
+}
+
+func (r *cachedProductRepository) Stats(ctx context.Context) ports.ProductCacheStats {
+	version, err := r.version(ctx)
+	if err != nil {
+		slog.Error("Error reading the product cache version", "error", err)
+	}
+
+	return ports.ProductCacheStats{
+		Enabled:       true,
+		Version:       version,
+		Hits:          r.hits.Load(),
+		Misses:        r.misses.Load(),
+		Errors:        r.failures.Load(),
+		Invalidations: r.invalidations.Load(),
+	}
+}
+
+func (r *cachedProductRepository) Create(ctx context.Context, product entities.Product, changedBy string) (entities.Product, error) {
+	created, err := r.ProductRepository.Create(ctx, product, changedBy)
+	if err == nil {
+		r.Invalidate(ctx)
+	}
+
+	return created, err
+}
+
+func (r *cachedProductRepository) Update(ctx context.Context, product entities.Product, changedBy string) (entities.Product, error) {
+	updated, err := r.ProductRepository.Update(ctx, product, changedBy)
+	if err == nil {
+		r.Invalidate(ctx)
+	}
+
+	return updated, err
+}
+
+func (r *cachedProductRepository) Delete(ctx context.Context, id int) error {
+	err := r.ProductRepository.Delete(ctx, id)
+	if err == nil {
+		r.Invalidate(ctx)
+	}
+
+	return err
+}
+
+func (r *cachedProductRepository) Restore(ctx context.Context, id int) (entities.Product, error) {
+	product, err := r.ProductRepository.Restore(ctx, id)
+	if err == nil {
+		r.Invalidate(ctx)
+	}
+
+	return product, err
+}
+
+func (r *cachedProductRepository) Purge(ctx context.Context, id int) ([]entities.ProductImage, error) {
+	images, err := r.ProductRepository.Purge(ctx, id)
+	if err == nil {
+		r.Invalidate(ctx)
+	}
+
+	return images, err
+}
+
+func (r *cachedProductRepository) UpdateStock(ctx context.Context, id int, stock entities.ProductStock) (entities.Product, error) {
+	product, err := r.ProductRepository.UpdateStock(ctx, id, stock)
+	if err == nil {
+		r.Invalidate(ctx)
+	}
+
+	return product, err
+}
+
+func (r *cachedProductRepository) AddImage(ctx context.Context, productID int, image entities.ProductImage) (entities.ProductImage, error) {
+	added, err := r.ProductRepository.AddImage(ctx, productID, image)
+	if err == nil {
+		r.Invalidate(ctx)
+	}
+
+	return added, err
+}
+
+func (r *cachedProductRepository) DeleteImage(ctx context.Context, productID int, imageID int) error {
+	err := r.ProductRepository.DeleteImage(ctx, productID, imageID)
+	if err == nil {
+		r.Invalidate(ctx)
+	}
+
+	return err
+}
+
+func (r *cachedProductRepository) ImportCatalog(ctx context.Context, products []entities.Product, changedBy string, dryRun bool) (ports.CatalogImportResult, error) {
+	result, err := r.ProductRepository.ImportCatalog(ctx, products, changedBy, dryRun)
+	if err == nil && !dryRun {
+		r.Invalidate(ctx)
+	}
+
+	return result, err
+}
+
+func encode(value any) ([]byte, error) {
+	var buffer bytes.Buffer
+	if err := gob.NewEncoder(&buffer).Encode(value); err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
+
+// copyValue stores loaded in value through the cache encoding, so both paths return the same thing.
+func copyValue(loaded any, value any) error {
+	encoded, err := encode(loaded)
+	if err != nil {
+		return err
+	}
+
+	return gob.NewDecoder(bytes.NewReader(encoded)).Decode(value)
+}
@@ -0,0 +1,145 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+	domainError "github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/error"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+)
+
+type availabilityRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewAvailabilityRepository(db *pgxpool.Pool) ports.AvailabilityRepository {
+	return &availabilityRepository{db: db}
+}
+
+// availabilityWindowMinutes selects the start and end of the window w as minutes since midnight.
+const availabilityWindowMinutes = `(EXTRACT(EPOCH FROM w.start_time) / 60)::int, (EXTRACT(EPOCH FROM w.end_time) / 60)::int`
+
+// availableAtCondition filters products p of category c by their windows, the same rules as
+// entities.AvailabilitySchedule. The arguments hold the weekday, the weekday before it and the
+// time of day, in the store timezone.
+func availableAtCondition(weekdayArg, previousWeekdayArg, clockArg int) string {
+	matches := fmt.Sprintf(`(
+		(w.weekday = $%[1]d AND w.start_time <= $%[3]d::time AND (w.end_time <= w.start_time OR $%[3]d::time < w.end_time))
+		OR (w.weekday = $%[2]d AND w.end_time <= w.start_time AND $%[3]d::time < w.end_time)
+	)`, weekdayArg, previousWeekdayArg, clockArg)
+
+	return `(CASE
+		WHEN EXISTS (SELECT 1 FROM availability_windows w WHERE w.product_id = p.id)
+			THEN EXISTS (SELECT 1 FROM availability_windows w WHERE w.product_id = p.id AND ` + matches + `)
+		WHEN EXISTS (SELECT 1 FROM availability_windows w WHERE w.category_id = p.category_id)
+			THEN EXISTS (SELECT 1 FROM availability_windows w WHERE w.category_id = p.category_id AND ` + matches + `)
+		ELSE TRUE
+	END)`
+}
+
+// availableAtArgs are the arguments of availableAtCondition for t.
+func availableAtArgs(t time.Time) []any {
+	return []any{int(t.Weekday()), int((t.Weekday() + 6) % 7), t.Format("15:04:05")}
+}
+
+func (r *availabilityRepository) GetByProduct(ctx context.Context, productID int) (entities.AvailabilitySchedule, error) {
+	if err := r.ensureExists(ctx, "products", "product", productID); err != nil {
+		return nil, err
+	}
+
+	return r.getWindows(ctx, "product_id", productID)
+}
+
+func (r *availabilityRepository) ReplaceForProduct(ctx context.Context, productID int, schedule entities.AvailabilitySchedule) error {
+	return r.replaceWindows(ctx, "products", "product", "product_id", productID, schedule)
+}
+
+func (r *availabilityRepository) GetByCategory(ctx context.Context, categoryID int) (entities.AvailabilitySchedule, error) {
+	if err := r.ensureExists(ctx, "categories", "category", categoryID); err != nil {
+		return nil, err
+	}
+
+	return r.getWindows(ctx, "category_id", categoryID)
+}
+
+func (r *availabilityRepository) ReplaceForCategory(ctx context.Context, categoryID int, schedule entities.AvailabilitySchedule) error {
+	return r.replaceWindows(ctx, "categories", "category", "category_id", categoryID, schedule)
+}
+
+func (r *availabilityRepository) ensureExists(ctx context.Context, table string, entity string, id int) error {
+	var exists bool
+	query := `SELECT EXISTS (SELECT 1 FROM ` + table + ` WHERE id = $1 AND deleted_at IS NULL)`
+	if err := r.db.QueryRow(ctx, query, id).Scan(&exists); err != nil {
+		return err
+	}
+
+	if !exists {
+		return domainError.ErrNotFound(entity)
+	}
+
+	return nil
+}
+
+func (r *availabilityRepository) getWindows(ctx context.Context, column string, id int) (entities.AvailabilitySchedule, error) {
+	query := `SELECT w.weekday, ` + availabilityWindowMinutes + ` FROM availability_windows w WHERE w.` + column + ` = $1 ORDER BY w.weekday, w.start_time`
+	rows, err := r.db.Query(ctx, query, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	schedule := make(entities.AvailabilitySchedule, 0)
+	for rows.Next() {
+		var weekday int
+		var window entities.AvailabilityWindow
+		if err := rows.Scan(&weekday, &window.StartMinute, &window.EndMinute); err != nil {
+			return nil, err
+		}
+		window.Weekday = time.Weekday(weekday)
+		schedule = append(schedule, window)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return schedule, nil
+}
+
+// replaceWindows swaps every window of the owner, locking it so it is not deleted meanwhile. The
+// owner is touched so its updated_at, which the catalog Last-Modified comes from, follows the windows.
+func (r *availabilityRepository) replaceWindows(ctx context.Context, table string, entity string, column string, id int, schedule entities.AvailabilitySchedule) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	var ownerID int
+	query := `UPDATE ` + table + ` SET updated_at = NOW() WHERE id = $1 AND deleted_at IS NULL RETURNING id`
+	err = tx.QueryRow(ctx, query, id).Scan(&ownerID)
+	if err == pgx.ErrNoRows {
+		return domainError.ErrNotFound(entity)
+	} else if err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM availability_windows WHERE `+column+` = $1`, id); err != nil {
+		return err
+	}
+
+	query = `INSERT INTO availability_windows (` + column + `, weekday, start_time, end_time) VALUES ($1, $2, $3::time, $4::time)`
+	for _, window := range schedule {
+		_, err := tx.Exec(ctx, query, id, int(window.Weekday),
+			entities.FormatClockMinute(window.StartMinute), entities.FormatClockMinute(window.EndMinute))
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
@@ -0,0 +1,217 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+	domainError "github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/error"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+)
+
+type categoryRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewCategoryRepository(db *pgxpool.Pool) ports.CategoryRepository {
+	return &categoryRepository{db: db}
+}
+
+const categorySelect = `
+	SELECT c.id, c.name, c.handle, c.description, c.image, c.display_order, c.active, c.created_at, c.updated_at,
+	       (SELECT COUNT(*) FROM products p WHERE p.category_id = c.id AND p.deleted_at IS NULL)
+	FROM categories c
+`
+
+func (r *categoryRepository) GetAll(ctx context.Context, onlyActive bool) ([]entities.Category, error) {
+	rows, err := r.db.Query(ctx, categorySelect+` WHERE c.deleted_at IS NULL AND (c.active OR NOT $1) ORDER BY c.display_order, c.name`, onlyActive)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	categories := make([]entities.Category, 0)
+	for rows.Next() {
+		category, err := scanCategory(rows)
+		if err != nil {
+			return nil, err
+		}
+		categories = append(categories, category)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return categories, nil
+}
+
+func (r *categoryRepository) GetByID(ctx context.Context, id int) (entities.Category, error) {
+	return scanCategory(r.db.QueryRow(ctx, categorySelect+` WHERE c.id = $1 AND c.deleted_at IS NULL`, id))
+}
+
+func (r *categoryRepository) GetByName(ctx context.Context, name string) (entities.Category, error) {
+	return scanCategory(r.db.QueryRow(ctx, categorySelect+` WHERE LOWER(c.name) = LOWER($1) AND c.deleted_at IS NULL`, name))
+}
+
+// Create stores the category with a handle generated from its name, numbered when the name
+// slugifies like an existing category.
+func (r *categoryRepository) Create(ctx context.Context, category entities.Category) (entities.Category, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return entities.Category{}, err
+	}
+	defer tx.Rollback(ctx)
+
+	// Serializes category creation, two categories cannot pick the same free handle
+	if _, err := tx.Exec(ctx, `SELECT pg_advisory_xact_lock(hashtext('categories.handle'))`); err != nil {
+		return entities.Category{}, err
+	}
+
+	handle := entities.CategoryHandle(category.Name)
+	rows, err := tx.Query(ctx, `SELECT handle FROM categories WHERE deleted_at IS NULL AND (handle = $1 OR handle LIKE $1 || '-%')`, handle)
+	if err != nil {
+		return entities.Category{}, err
+	}
+	taken, err := scanStrings(rows)
+	if err != nil {
+		return entities.Category{}, err
+	}
+	category.Handle = entities.UniqueCategoryHandle(handle, taken)
+
+	query := `
+		INSERT INTO categories (name, handle, description, image, display_order, active)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id, created_at, updated_at
+	`
+	err = tx.QueryRow(ctx, query, category.Name, category.Handle, category.Description, category.Image, category.DisplayOrder, category.Active).
+		Scan(&category.ID, &category.CreatedAt, &category.UpdatedAt)
+	if err != nil {
+		return entities.Category{}, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return entities.Category{}, err
+	}
+
+	return category, nil
+}
+
+// Update replaces the category settings. The handle is kept, clients filter products by it.
+func (r *categoryRepository) Update(ctx context.Context, category entities.Category) (entities.Category, error) {
+	query := `
+		UPDATE categories
+		SET name = $2, description = $3, image = $4, display_order = $5, active = $6, updated_at = NOW()
+		WHERE id = $1 AND deleted_at IS NULL
+		RETURNING handle, created_at, updated_at, (SELECT COUNT(*) FROM products p WHERE p.category_id = $1 AND p.deleted_at IS NULL)
+	`
+	err := r.db.QueryRow(ctx, query, category.ID, category.Name, category.Description, category.Image, category.DisplayOrder, category.Active).
+		Scan(&category.Handle, &category.CreatedAt, &category.UpdatedAt, &category.ProductCount)
+	if err == pgx.ErrNoRows {
+		return entities.Category{}, domainError.ErrNotFound("category")
+	} else if err != nil {
+		return entities.Category{}, err
+	}
+
+	return category, nil
+}
+
+// Delete soft deletes the category when no product is left in it. Products lock the category row
+// when they are assigned to it, so a product cannot be added while the category is deleted.
+func (r *categoryRepository) Delete(ctx context.Context, id int) error {
+	query := `
+		UPDATE categories SET deleted_at = NOW()
+		WHERE id = $1 AND deleted_at IS NULL
+		  AND NOT EXISTS (SELECT 1 FROM products WHERE category_id = $1 AND deleted_at IS NULL)
+	`
+	tag, err := r.db.Exec(ctx, query, id)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() > 0 {
+		return nil
+	}
+
+	category, err := r.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return domainError.NewEntityNotProcessableError("category", fmt.Sprintf("category still has %d products, reassign them before deleting it", category.ProductCount))
+}
+
+func (r *categoryRepository) ReassignProducts(ctx context.Context, fromID, toID int, productIDs []int) (int, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	// Locks the target like product creation does, it cannot be deleted while products move in
+	var targetID int
+	err = tx.QueryRow(ctx, `SELECT id FROM categories WHERE id = $1 AND deleted_at IS NULL FOR SHARE`, toID).Scan(&targetID)
+	if err == pgx.ErrNoRows {
+		return 0, domainError.ErrNotFound("target category")
+	} else if err != nil {
+		return 0, err
+	}
+
+	query := `UPDATE products SET category_id = $2, updated_at = NOW() WHERE category_id = $1 AND deleted_at IS NULL`
+	args := []any{fromID, toID}
+	if len(productIDs) > 0 {
+		query += ` AND id = ANY($3)`
+		args = append(args, productIDs)
+	}
+
+	tag, err := tx.Exec(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, err
+	}
+
+	return int(tag.RowsAffected()), nil
+}
+
+func scanCategory(row pgx.Row) (entities.Category, error) {
+	var category entities.Category
+	err := row.Scan(
+		&category.ID,
+		&category.Name,
+		&category.Handle,
+		&category.Description,
+		&category.Image,
+		&category.DisplayOrder,
+		&category.Active,
+		&category.CreatedAt,
+		&category.UpdatedAt,
+		&category.ProductCount,
+	)
+	if err == pgx.ErrNoRows {
+		return entities.Category{}, domainError.ErrNotFound("category")
+	} else if err != nil {
+		return entities.Category{}, err
+	}
+
+	return category, nil
+}
+
+func scanStrings(rows pgx.Rows) ([]string, error) {
+	defer rows.Close()
+
+	values := make([]string, 0)
+	for rows.Next() {
+		var value string
+		if err := rows.Scan(&value); err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+
+	return values, rows.Err()
+}
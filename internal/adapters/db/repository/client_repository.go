@@ -28,6 +28,21 @@ func (r *clientRepository) Create(ctx context.Context, client entities.Client) (
 	return client, nil
 }
 
+func (r *clientRepository) GetByID(ctx context.Context, id int) (entities.Client, error) {
+	query := `SELECT id, name, cpf, created_at, updated_at FROM clients WHERE id = $1`
+
+	var client entities.Client
+	err := r.db.QueryRow(ctx, query, id).Scan(&client.ID, &client.Name, &client.CPF, &client.CreatedAt, &client.UpdatedAt)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return entities.Client{}, domainError.ErrNotFound("client")
+		}
+		return entities.Client{}, err
+	}
+
+	return client, nil
+}
+
 func (r *clientRepository) GetByCpf(ctx context.Context, cpf string) (entities.Client, error) {
 	query := `SELECT id, name, cpf, created_at, updated_at FROM clients WHERE cpf = $1 AND deleted_at IS NULL`
 
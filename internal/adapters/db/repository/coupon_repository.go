@@ -0,0 +1,139 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+	domainError "github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/error"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+)
+
+type couponRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewCouponRepository(db *pgxpool.Pool) ports.CouponRepository {
+	return &couponRepository{db: db}
+}
+
+const couponSelect = `
+	SELECT id, code, COALESCE(description, ''), amount_type, amount_value, min_order_amount_cents, starts_at, ends_at,
+	       max_redemptions, max_redemptions_per_client, redemptions_count, product_ids, category_ids, active, created_at, updated_at
+	FROM coupons
+`
+
+func (r *couponRepository) GetAll(ctx context.Context) ([]entities.Coupon, error) {
+	rows, err := r.db.Query(ctx, couponSelect+` WHERE deleted_at IS NULL ORDER BY id`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	coupons := make([]entities.Coupon, 0)
+	for rows.Next() {
+		coupon, err := scanCoupon(rows)
+		if err != nil {
+			return nil, err
+		}
+		coupons = append(coupons, coupon)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return coupons, nil
+}
+
+func (r *couponRepository) GetByID(ctx context.Context, id int) (entities.Coupon, error) {
+	return scanCoupon(r.db.QueryRow(ctx, couponSelect+` WHERE id = $1 AND deleted_at IS NULL`, id))
+}
+
+func (r *couponRepository) GetByCode(ctx context.Context, code string) (entities.Coupon, error) {
+	return scanCoupon(r.db.QueryRow(ctx, couponSelect+` WHERE UPPER(code) = $1 AND deleted_at IS NULL`, entities.NormalizeCouponCode(code)))
+}
+
+func (r *couponRepository) Create(ctx context.Context, coupon entities.Coupon) (entities.Coupon, error) {
+	query := `
+		INSERT INTO coupons (code, description, amount_type, amount_value, min_order_amount_cents, starts_at, ends_at,
+		                     max_redemptions, max_redemptions_per_client, product_ids, category_ids, active)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		RETURNING id, created_at, updated_at
+	`
+	err := r.db.QueryRow(ctx, query, coupon.Code, coupon.Description, coupon.AmountType, coupon.AmountValue, coupon.MinOrderAmount.Cents,
+		coupon.StartsAt, coupon.EndsAt, coupon.MaxRedemptions, coupon.MaxRedemptionsPerClient, coupon.ProductIDs, coupon.CategoryIDs, coupon.Active).
+		Scan(&coupon.ID, &coupon.CreatedAt, &coupon.UpdatedAt)
+	if err != nil {
+		return entities.Coupon{}, err
+	}
+
+	return coupon, nil
+}
+
+// Update replaces the coupon settings. The redemption counter is owned by checkout and is kept.
+func (r *couponRepository) Update(ctx context.Context, coupon entities.Coupon) (entities.Coupon, error) {
+	query := `
+		UPDATE coupons
+		SET code = $2, description = $3, amount_type = $4, amount_value = $5, min_order_amount_cents = $6, starts_at = $7, ends_at = $8,
+		    max_redemptions = $9, max_redemptions_per_client = $10, product_ids = $11, category_ids = $12, active = $13
+		WHERE id = $1 AND deleted_at IS NULL
+		RETURNING redemptions_count, created_at, updated_at
+	`
+	err := r.db.QueryRow(ctx, query, coupon.ID, coupon.Code, coupon.Description, coupon.AmountType, coupon.AmountValue, coupon.MinOrderAmount.Cents,
+		coupon.StartsAt, coupon.EndsAt, coupon.MaxRedemptions, coupon.MaxRedemptionsPerClient, coupon.ProductIDs, coupon.CategoryIDs, coupon.Active).
+		Scan(&coupon.RedemptionsCount, &coupon.CreatedAt, &coupon.UpdatedAt)
+	if err == pgx.ErrNoRows {
+		return entities.Coupon{}, domainError.ErrNotFound("coupon")
+	} else if err != nil {
+		return entities.Coupon{}, err
+	}
+
+	return coupon, nil
+}
+
+// Delete soft deletes the coupon, discounts already granted keep their snapshot in order_discounts.
+func (r *couponRepository) Delete(ctx context.Context, id int) error {
+	tag, err := r.db.Exec(ctx, `UPDATE coupons SET deleted_at = NOW() WHERE id = $1 AND deleted_at IS NULL`, id)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return domainError.ErrNotFound("coupon")
+	}
+
+	return nil
+}
+
+func scanCoupon(row pgx.Row) (entities.Coupon, error) {
+	var coupon entities.Coupon
+	err := row.Scan(
+		&coupon.ID,
+		&coupon.Code,
+		&coupon.Description,
+		&coupon.AmountType,
+		&coupon.AmountValue,
+		&coupon.MinOrderAmount.Cents,
+		&coupon.StartsAt,
+		&coupon.EndsAt,
+		&coupon.MaxRedemptions,
+		&coupon.MaxRedemptionsPerClient,
+		&coupon.RedemptionsCount,
+		&coupon.ProductIDs,
+		&coupon.CategoryIDs,
+		&coupon.Active,
+		&coupon.CreatedAt,
+		&coupon.UpdatedAt,
+	)
+	if err == pgx.ErrNoRows {
+		return entities.Coupon{}, domainError.ErrNotFound("coupon")
+	} else if err != nil {
+		return entities.Coupon{}, err
+	}
+
+	coupon.MinOrderAmount.Currency = entities.DefaultCurrency
+
+	return coupon, nil
+}
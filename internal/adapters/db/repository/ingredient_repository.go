@@ -0,0 +1,454 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+	domainError "github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/error"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+)
+
+type ingredientRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewIngredientRepository(db *pgxpool.Pool) ports.IngredientRepository {
+	return &ingredientRepository{db: db}
+}
+
+// postOrderConsumptionQuery takes the ingredients of the order items from stock, following the
+// product recipes. It runs in the transaction that moves the order to preparing and skips the
+// ingredients the order already consumed, so a payment confirmed twice consumes once.
+const postOrderConsumptionQuery = `
+	WITH consumption AS (
+		INSERT INTO ingredient_movements (ingredient_id, order_id, movement_type, quantity, description)
+		SELECT pi.ingredient_id, oi.order_id, 'consumption', -SUM(pi.quantity * oi.quantity), 'Order #' || oi.order_id
+		FROM order_items oi
+		JOIN product_ingredients pi ON pi.product_id = oi.product_id
+		WHERE oi.order_id = $1 AND oi.deleted_at IS NULL
+		GROUP BY pi.ingredient_id, oi.order_id
+		ON CONFLICT (order_id, ingredient_id) WHERE movement_type = 'consumption' DO NOTHING
+		RETURNING ingredient_id, quantity
+	)
+	UPDATE ingredients i
+	SET stock_quantity = i.stock_quantity + c.quantity, updated_at = NOW()
+	FROM consumption c
+	WHERE i.id = c.ingredient_id
+`
+
+// refreshIngredientsAvailabilityQuery disables the products with an ingredient short of what one
+// unit takes and enables them again once every ingredient is back. Products without a recipe are
+// always available.
+const refreshIngredientsAvailabilityQuery = `
+	UPDATE products p
+	SET ingredients_available = s.available, updated_at = NOW()
+	FROM (
+		SELECT pr.id, COALESCE(BOOL_AND(i.stock_quantity >= pi.quantity), TRUE) AS available
+		FROM products pr
+		LEFT JOIN product_ingredients pi ON pi.product_id = pr.id
+		LEFT JOIN ingredients i ON i.id = pi.ingredient_id
+		WHERE pr.deleted_at IS NULL
+		GROUP BY pr.id
+	) s
+	WHERE p.id = s.id AND p.ingredients_available <> s.available
+`
+
+const ingredientSelect = `SELECT id, name, unit, stock_quantity, created_at, updated_at FROM ingredients`
+
+func (r *ingredientRepository) GetAll(ctx context.Context) ([]entities.Ingredient, error) {
+	rows, err := r.db.Query(ctx, ingredientSelect+` WHERE deleted_at IS NULL ORDER BY name`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ingredients := make([]entities.Ingredient, 0)
+	for rows.Next() {
+		ingredient, err := scanIngredient(rows)
+		if err != nil {
+			return nil, err
+		}
+		ingredients = append(ingredients, ingredient)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return ingredients, nil
+}
+
+func (r *ingredientRepository) GetByID(ctx context.Context, id int) (entities.Ingredient, error) {
+	return scanIngredient(r.db.QueryRow(ctx, ingredientSelect+` WHERE id = $1 AND deleted_at IS NULL`, id))
+}
+
+func (r *ingredientRepository) GetByName(ctx context.Context, name string) (entities.Ingredient, error) {
+	return scanIngredient(r.db.QueryRow(ctx, ingredientSelect+` WHERE LOWER(name) = LOWER($1) AND deleted_at IS NULL`, name))
+}
+
+func (r *ingredientRepository) Create(ctx context.Context, ingredient entities.Ingredient) (entities.Ingredient, error) {
+	query := `
+		INSERT INTO ingredients (name, unit)
+		VALUES ($1, $2)
+		RETURNING id, stock_quantity, created_at, updated_at
+	`
+	err := r.db.QueryRow(ctx, query, ingredient.Name, ingredient.Unit).
+		Scan(&ingredient.ID, &ingredient.StockQuantity, &ingredient.CreatedAt, &ingredient.UpdatedAt)
+	if err != nil {
+		return entities.Ingredient{}, err
+	}
+
+	return ingredient, nil
+}
+
+func (r *ingredientRepository) Update(ctx context.Context, ingredient entities.Ingredient) (entities.Ingredient, error) {
+	query := `
+		UPDATE ingredients
+		SET name = $2, unit = $3, updated_at = NOW()
+		WHERE id = $1 AND deleted_at IS NULL
+		  AND (unit = $3 OR (
+		      NOT EXISTS (SELECT 1 FROM product_ingredients WHERE ingredient_id = $1)
+		      AND NOT EXISTS (SELECT 1 FROM ingredient_movements WHERE ingredient_id = $1)
+		  ))
+		RETURNING stock_quantity, created_at, updated_at
+	`
+	err := r.db.QueryRow(ctx, query, ingredient.ID, ingredient.Name, ingredient.Unit).
+		Scan(&ingredient.StockQuantity, &ingredient.CreatedAt, &ingredient.UpdatedAt)
+	if err == pgx.ErrNoRows {
+		if _, err := r.GetByID(ctx, ingredient.ID); err != nil {
+			return entities.Ingredient{}, err
+		}
+
+		return entities.Ingredient{}, domainError.NewEntityNotProcessableError("ingredient", "unit cannot change once the ingredient is used by recipes or has stock entries")
+	} else if err != nil {
+		return entities.Ingredient{}, err
+	}
+
+	return ingredient, nil
+}
+
+// Delete soft deletes the ingredient when no recipe uses it anymore.
+func (r *ingredientRepository) Delete(ctx context.Context, id int) error {
+	query := `
+		UPDATE ingredients SET deleted_at = NOW()
+		WHERE id = $1 AND deleted_at IS NULL
+		  AND NOT EXISTS (
+		      SELECT 1 FROM product_ingredients pi
+		      JOIN products p ON p.id = pi.product_id
+		      WHERE pi.ingredient_id = $1 AND p.deleted_at IS NULL
+		  )
+	`
+	tag, err := r.db.Exec(ctx, query, id)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() > 0 {
+		return nil
+	}
+
+	if _, err := r.GetByID(ctx, id); err != nil {
+		return err
+	}
+
+	return domainError.NewEntityNotProcessableError("ingredient", "ingredient is used by product recipes, remove it from them before deleting it")
+}
+
+func (r *ingredientRepository) Receive(ctx context.Context, movement entities.IngredientMovement) (entities.IngredientMovement, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return entities.IngredientMovement{}, err
+	}
+	defer tx.Rollback(ctx)
+
+	query := `UPDATE ingredients SET stock_quantity = stock_quantity + $2, updated_at = NOW() WHERE id = $1 AND deleted_at IS NULL`
+	tag, err := tx.Exec(ctx, query, movement.IngredientID, movement.Quantity)
+	if err != nil {
+		return entities.IngredientMovement{}, err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return entities.IngredientMovement{}, domainError.ErrNotFound("ingredient")
+	}
+
+	movement.Type = entities.IngredientMovementReceipt
+	if err := createIngredientMovement(ctx, tx, &movement); err != nil {
+		return entities.IngredientMovement{}, err
+	}
+
+	if _, err := tx.Exec(ctx, refreshIngredientsAvailabilityQuery); err != nil {
+		return entities.IngredientMovement{}, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return entities.IngredientMovement{}, err
+	}
+
+	return movement, nil
+}
+
+// Count keeps the theoretical stock next to the counted one and posts the difference as a
+// count_adjustment, so the ledger still adds up to the stock.
+func (r *ingredientRepository) Count(ctx context.Context, count entities.IngredientCount) (entities.IngredientCount, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return entities.IngredientCount{}, err
+	}
+	defer tx.Rollback(ctx)
+
+	query := `SELECT stock_quantity FROM ingredients WHERE id = $1 AND deleted_at IS NULL FOR UPDATE`
+	err = tx.QueryRow(ctx, query, count.IngredientID).Scan(&count.TheoreticalQuantity)
+	if err == pgx.ErrNoRows {
+		return entities.IngredientCount{}, domainError.ErrNotFound("ingredient")
+	} else if err != nil {
+		return entities.IngredientCount{}, err
+	}
+
+	query = `
+		INSERT INTO ingredient_counts (ingredient_id, counted_quantity, theoretical_quantity, description)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, counted_at
+	`
+	err = tx.QueryRow(ctx, query, count.IngredientID, count.CountedQuantity, count.TheoreticalQuantity, count.Description).
+		Scan(&count.ID, &count.CountedAt)
+	if err != nil {
+		return entities.IngredientCount{}, err
+	}
+
+	if variance := count.Variance(); variance != 0 {
+		adjustment := entities.IngredientMovement{
+			IngredientID: count.IngredientID,
+			Type:         entities.IngredientMovementCountAdjustment,
+			Quantity:     variance,
+			Description:  fmt.Sprintf("Count #%d", count.ID),
+		}
+		if err := createIngredientMovement(ctx, tx, &adjustment); err != nil {
+			return entities.IngredientCount{}, err
+		}
+
+		query = `UPDATE ingredients SET stock_quantity = $2, updated_at = NOW() WHERE id = $1`
+		if _, err := tx.Exec(ctx, query, count.IngredientID, count.CountedQuantity); err != nil {
+			return entities.IngredientCount{}, err
+		}
+
+		if _, err := tx.Exec(ctx, refreshIngredientsAvailabilityQuery); err != nil {
+			return entities.IngredientCount{}, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return entities.IngredientCount{}, err
+	}
+
+	return count, nil
+}
+
+func (r *ingredientRepository) GetMovements(ctx context.Context, ingredientID int, limit int) ([]entities.IngredientMovement, error) {
+	query := `
+		SELECT id, ingredient_id, order_id, movement_type, quantity, description, created_at
+		FROM ingredient_movements
+		WHERE ingredient_id = $1
+		ORDER BY created_at DESC, id DESC
+		LIMIT $2
+	`
+	rows, err := r.db.Query(ctx, query, ingredientID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	movements := make([]entities.IngredientMovement, 0)
+	for rows.Next() {
+		var movement entities.IngredientMovement
+		err := rows.Scan(&movement.ID, &movement.IngredientID, &movement.OrderID, &movement.Type, &movement.Quantity, &movement.Description, &movement.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		movements = append(movements, movement)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return movements, nil
+}
+
+func (r *ingredientRepository) GetInventoryReport(ctx context.Context) ([]entities.InventoryReportLine, error) {
+	query := `
+		SELECT i.id, i.name, i.unit, i.stock_quantity, i.created_at, i.updated_at,
+		       c.id, c.counted_quantity, c.theoretical_quantity, c.description, c.counted_at
+		FROM ingredients i
+		LEFT JOIN LATERAL (
+			SELECT id, counted_quantity, theoretical_quantity, description, counted_at
+			FROM ingredient_counts
+			WHERE ingredient_id = i.id
+			ORDER BY counted_at DESC, id DESC
+			LIMIT 1
+		) c ON TRUE
+		WHERE i.deleted_at IS NULL
+		ORDER BY i.name
+	`
+	rows, err := r.db.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	lines := make([]entities.InventoryReportLine, 0)
+	for rows.Next() {
+		var line entities.InventoryReportLine
+		var countID *int
+		var counted, theoretical *float64
+		var description *string
+		var countedAt *time.Time
+		err := rows.Scan(
+			&line.Ingredient.ID,
+			&line.Ingredient.Name,
+			&line.Ingredient.Unit,
+			&line.Ingredient.StockQuantity,
+			&line.Ingredient.CreatedAt,
+			&line.Ingredient.UpdatedAt,
+			&countID,
+			&counted,
+			&theoretical,
+			&description,
+			&countedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if countID != nil {
+			line.LastCount = &entities.IngredientCount{
+				ID:                  *countID,
+				IngredientID:        line.Ingredient.ID,
+				CountedQuantity:     *counted,
+				TheoreticalQuantity: *theoretical,
+				Description:         *description,
+				CountedAt:           *countedAt,
+			}
+		}
+		lines = append(lines, line)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return lines, nil
+}
+
+func (r *ingredientRepository) GetRecipe(ctx context.Context, productID int) ([]entities.RecipeItem, error) {
+	query := `
+		SELECT pi.product_id, pi.ingredient_id, i.name, i.unit, pi.quantity
+		FROM product_ingredients pi
+		JOIN ingredients i ON i.id = pi.ingredient_id
+		WHERE pi.product_id = $1
+		ORDER BY i.name
+	`
+	rows, err := r.db.Query(ctx, query, productID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	items := make([]entities.RecipeItem, 0)
+	for rows.Next() {
+		var item entities.RecipeItem
+		if err := rows.Scan(&item.ProductID, &item.IngredientID, &item.IngredientName, &item.Unit, &item.Quantity); err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+func (r *ingredientRepository) ReplaceRecipe(ctx context.Context, productID int, items []entities.RecipeItem) ([]entities.RecipeItem, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	var id int
+	err = tx.QueryRow(ctx, `SELECT id FROM products WHERE id = $1 AND deleted_at IS NULL FOR UPDATE`, productID).Scan(&id)
+	if err == pgx.ErrNoRows {
+		return nil, domainError.ErrNotFound("product")
+	} else if err != nil {
+		return nil, err
+	}
+
+	// Locks the ingredients, they cannot be deleted while the recipe starts using them
+	ingredientIDs := make([]int, len(items))
+	for idx, item := range items {
+		ingredientIDs[idx] = item.IngredientID
+	}
+	var found int
+	query := `SELECT COUNT(*) FROM (SELECT id FROM ingredients WHERE id = ANY($1) AND deleted_at IS NULL FOR SHARE) i`
+	if err := tx.QueryRow(ctx, query, ingredientIDs).Scan(&found); err != nil {
+		return nil, err
+	}
+	if found != len(ingredientIDs) {
+		return nil, domainError.ErrNotFound("ingredient")
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM product_ingredients WHERE product_id = $1`, productID); err != nil {
+		return nil, err
+	}
+
+	query = `INSERT INTO product_ingredients (product_id, ingredient_id, quantity) VALUES ($1, $2, $3)`
+	for _, item := range items {
+		if _, err := tx.Exec(ctx, query, productID, item.IngredientID, item.Quantity); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, err := tx.Exec(ctx, refreshIngredientsAvailabilityQuery); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return r.GetRecipe(ctx, productID)
+}
+
+func createIngredientMovement(ctx context.Context, tx pgx.Tx, movement *entities.IngredientMovement) error {
+	query := `
+		INSERT INTO ingredient_movements (ingredient_id, order_id, movement_type, quantity, description)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, created_at
+	`
+	return tx.QueryRow(ctx, query, movement.IngredientID, movement.OrderID, movement.Type, movement.Quantity, movement.Description).
+		Scan(&movement.ID, &movement.CreatedAt)
+}
+
+func scanIngredient(row pgx.Row) (entities.Ingredient, error) {
+	var ingredient entities.Ingredient
+	err := row.Scan(
+		&ingredient.ID,
+		&ingredient.Name,
+		&ingredient.Unit,
+		&ingredient.StockQuantity,
+		&ingredient.CreatedAt,
+		&ingredient.UpdatedAt,
+	)
+	if err == pgx.ErrNoRows {
+		return entities.Ingredient{}, domainError.ErrNotFound("ingredient")
+	} else if err != nil {
+		return entities.Ingredient{}, err
+	}
+
+	return ingredient, nil
+}
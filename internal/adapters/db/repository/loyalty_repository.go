@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+)
+
+type loyaltyRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewLoyaltyRepository(db *pgxpool.Pool) ports.LoyaltyRepository {
+	return &loyaltyRepository{db: db}
+}
+
+const loyaltyEntrySelect = `
+	SELECT id, client_id, order_id, entry_type, points, COALESCE(description, ''), created_at
+	FROM loyalty_ledger
+`
+
+func (r *loyaltyRepository) GetByClientID(ctx context.Context, clientID int) ([]entities.LoyaltyEntry, error) {
+	return r.query(ctx, loyaltyEntrySelect+` WHERE client_id = $1 ORDER BY id`, clientID)
+}
+
+func (r *loyaltyRepository) GetByOrderID(ctx context.Context, orderID int) ([]entities.LoyaltyEntry, error) {
+	return r.query(ctx, loyaltyEntrySelect+` WHERE order_id = $1 ORDER BY id`, orderID)
+}
+
+func (r *loyaltyRepository) Append(ctx context.Context, entries []entities.LoyaltyEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	for _, entry := range entries {
+		if err := appendLoyaltyEntry(ctx, tx, entry); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+// appendLoyaltyEntry inserts a ledger entry. The unique index on (order_id, entry_type) turns a
+// second earn, redeem or redeem reversal of the same order into a no-op.
+func appendLoyaltyEntry(ctx context.Context, tx pgx.Tx, entry entities.LoyaltyEntry) error {
+	query := `
+		INSERT INTO loyalty_ledger (client_id, order_id, entry_type, points, description)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT DO NOTHING
+	`
+	_, err := tx.Exec(ctx, query, entry.ClientID, entry.OrderID, entry.Type, entry.Points, entry.Description)
+	return err
+}
+
+func (r *loyaltyRepository) query(ctx context.Context, query string, args ...any) ([]entities.LoyaltyEntry, error) {
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	entries := make([]entities.LoyaltyEntry, 0)
+	for rows.Next() {
+		var entry entities.LoyaltyEntry
+		err := rows.Scan(&entry.ID, &entry.ClientID, &entry.OrderID, &entry.Type, &entry.Points, &entry.Description, &entry.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
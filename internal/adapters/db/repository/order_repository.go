@@ -3,12 +3,16 @@ package repository
 import (
 	"context"
 	"errors"
+	"fmt"
+	"slices"
+	"sort"
 	"time"
 
 	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/pgxpool"
 	sqlcDB "github.com/tupizz/restaurant-food-golang-api-fiap/database/sqlc"
 	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+	domainError "github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/error"
 	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
 )
 
@@ -50,26 +54,62 @@ func (r *orderRepository) Create(ctx context.Context, order entities.Order) (ent
 
 	// Create Order
 	query := `
-		INSERT INTO orders (client_id, status, created_at, updated_at)
-		VALUES ($1, $2, $3, $4)
+		INSERT INTO orders (client_id, status, delivery, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5)
 		RETURNING id, created_at, updated_at
 	`
-	err = tx.QueryRow(ctx, query, order.ClientID, order.Status, time.Now(), time.Now()).
+	err = tx.QueryRow(ctx, query, order.ClientID, order.Status, order.Delivery, time.Now(), time.Now()).
 		Scan(&order.ID, &order.CreatedAt, &order.UpdatedAt)
 	if err != nil {
 		return entities.Order{}, err
 	}
 
+	// Reserve Stock, before any item is written so a shortage leaves nothing behind
+	reserved, err := r.reserveStock(ctx, tx, order.Items)
+	if err != nil {
+		return entities.Order{}, err
+	}
+
 	// Create Order Items
 	for idx, item := range order.Items {
 		item.OrderID = order.ID
-		createdItem, err := r.createOrderItem(ctx, tx, &item)
+		createdItem, err := r.createOrderItem(ctx, tx, &item, reserved[newStockKey(item)])
 		if err != nil {
 			return entities.Order{}, err
 		}
 		order.Items[idx] = *createdItem
 	}
 
+	// Create Order Fees
+	for idx, fee := range order.Fees {
+		fee.OrderID = order.ID
+		createdFee, err := r.createOrderFee(ctx, tx, &fee)
+		if err != nil {
+			return entities.Order{}, err
+		}
+		order.Fees[idx] = *createdFee
+	}
+
+	// Redeem coupons and loyalty points and create Order Discounts
+	for idx, discount := range order.Discounts {
+		discount.OrderID = order.ID
+		if discount.CouponID != 0 {
+			if err := r.redeemCoupon(ctx, tx, order, discount.CouponID); err != nil {
+				return entities.Order{}, err
+			}
+		}
+		if discount.LoyaltyPoints > 0 {
+			if err := r.redeemLoyaltyPoints(ctx, tx, order, discount.LoyaltyPoints); err != nil {
+				return entities.Order{}, err
+			}
+		}
+		createdDiscount, err := r.createOrderDiscount(ctx, tx, &discount)
+		if err != nil {
+			return entities.Order{}, err
+		}
+		order.Discounts[idx] = *createdDiscount
+	}
+
 	// Create Payment
 	order.Payment.OrderID = order.ID
 	_, err = r.createPayment(ctx, tx, &order.Payment)
@@ -88,13 +128,13 @@ func (r *orderRepository) Create(ctx context.Context, order entities.Order) (ent
 func (r *orderRepository) GetByID(ctx context.Context, id int) (entities.Order, error) {
 	// Fetch Order
 	query := `
-		SELECT id, client_id, status, created_at, updated_at, deleted_at
+		SELECT id, client_id, status, delivery, payment_failed_at, created_at, updated_at, deleted_at
 		FROM orders
 		WHERE id = $1 AND deleted_at IS NULL
 	`
 	var order entities.Order
 	err := r.db.QueryRow(ctx, query, id).
-		Scan(&order.ID, &order.ClientID, &order.Status, &order.CreatedAt, &order.UpdatedAt, &order.DeletedAt)
+		Scan(&order.ID, &order.ClientID, &order.Status, &order.Delivery, &order.PaymentFailedAt, &order.CreatedAt, &order.UpdatedAt, &order.DeletedAt)
 	if err == pgx.ErrNoRows {
 		return entities.Order{}, ErrOrderNotFound
 	} else if err != nil {
@@ -107,12 +147,35 @@ func (r *orderRepository) GetByID(ctx context.Context, id int) (entities.Order,
 		return entities.Order{}, err
 	}
 
+	// Fetch Order Fees
+	order.Fees, err = r.getOrderFeesByOrderID(ctx, order.ID)
+	if err != nil {
+		return entities.Order{}, err
+	}
+
+	// Fetch Order Discounts
+	order.Discounts, err = r.getOrderDiscountsByOrderID(ctx, order.ID)
+	if err != nil {
+		return entities.Order{}, err
+	}
+
 	// Fetch Payment
 	order.Payment, err = r.getPaymentByOrderID(ctx, order.ID)
 	if err != nil {
 		return entities.Order{}, err
 	}
 
+	// Items, fees and discounts are charged in the currency of the payment
+	for idx := range order.Items {
+		order.Items[idx].Price.Currency = order.Payment.Amount.Currency
+	}
+	for idx := range order.Fees {
+		order.Fees[idx].Amount.Currency = order.Payment.Amount.Currency
+	}
+	for idx := range order.Discounts {
+		order.Discounts[idx].Amount.Currency = order.Payment.Amount.Currency
+	}
+
 	return order, nil
 }
 
@@ -174,6 +237,127 @@ func (r *orderRepository) Update(ctx context.Context, order entities.Order) erro
 	return nil
 }
 
+// RetryPayment replaces the failed payment of the order with a new attempt and its fees, moving
+// the order back to pending. The order only changes if it entered payment_failed after
+// failedAfter, so a retry racing the expiry worker or another retry is rejected. Every attempt is
+// stored as its own payment, the failed ones are kept soft deleted.
+func (r *orderRepository) RetryPayment(ctx context.Context, order entities.Order, failedAfter time.Time) (entities.Order, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return entities.Order{}, err
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+		UPDATE orders
+		SET status = $2, payment_failed_at = NULL, updated_at = $3
+		WHERE id = $1 AND status = $4 AND payment_failed_at > $5 AND deleted_at IS NULL
+		RETURNING updated_at
+	`
+	err = tx.QueryRow(ctx, query, order.ID, entities.OrderStatusPending, time.Now(), entities.OrderStatusPaymentFailed, failedAfter).
+		Scan(&order.UpdatedAt)
+	if err == pgx.ErrNoRows {
+		return entities.Order{}, domainError.NewEntityNotProcessableError("order", "the payment of the order can no longer be retried")
+	} else if err != nil {
+		return entities.Order{}, err
+	}
+	order.Status = entities.OrderStatusPending
+	order.PaymentFailedAt = nil
+
+	// Replace Order Fees, they depend on the payment method
+	_, err = tx.Exec(ctx, `UPDATE order_fees SET deleted_at = $1 WHERE order_id = $2 AND deleted_at IS NULL`, time.Now(), order.ID)
+	if err != nil {
+		return entities.Order{}, err
+	}
+
+	for idx, fee := range order.Fees {
+		fee.OrderID = order.ID
+		createdFee, err := r.createOrderFee(ctx, tx, &fee)
+		if err != nil {
+			return entities.Order{}, err
+		}
+		order.Fees[idx] = *createdFee
+	}
+
+	// Replace Payment, the failed attempt is kept apart so late notifications about it still find it
+	if err := r.deletePaymentByOrderID(ctx, tx, order.ID); err != nil {
+		return entities.Order{}, err
+	}
+
+	order.Payment.OrderID = order.ID
+	createdPayment, err := r.createPayment(ctx, tx, &order.Payment)
+	if err != nil {
+		return entities.Order{}, err
+	}
+	order.Payment = *createdPayment
+
+	// Commit Transaction
+	if err := tx.Commit(ctx); err != nil {
+		return entities.Order{}, err
+	}
+
+	return order, nil
+}
+
+// CancelPaymentFailedBefore cancels up to limit orders that entered payment_failed before the
+// given time, returning their stock and coupon redemptions, and returns their IDs. Rows locked by a concurrent retry are
+// skipped.
+func (r *orderRepository) CancelPaymentFailedBefore(ctx context.Context, before time.Time, limit int) ([]int, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+		UPDATE orders
+		SET status = $1, updated_at = $2
+		WHERE id IN (
+			SELECT id
+			FROM orders
+			WHERE status = $3 AND payment_failed_at <= $4 AND deleted_at IS NULL
+			ORDER BY payment_failed_at
+			LIMIT $5
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING id
+	`
+	rows, err := tx.Query(ctx, query, entities.OrderStatusCanceled, time.Now(), entities.OrderStatusPaymentFailed, before, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int, 0)
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	rows.Close()
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := r.restoreStock(ctx, tx, ids); err != nil {
+		return nil, err
+	}
+
+	if err := r.releaseCoupons(ctx, tx, ids); err != nil {
+		return nil, err
+	}
+
+	// Commit Transaction
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
 func (r *orderRepository) Delete(ctx context.Context, id int) error {
 	tx, err := r.db.Begin(ctx)
 	if err != nil {
@@ -192,6 +376,15 @@ func (r *orderRepository) Delete(ctx context.Context, id int) error {
 		return err
 	}
 
+	// Return the stock still reserved by the order and the coupon it redeemed
+	if err := r.restoreStock(ctx, tx, []int{id}); err != nil {
+		return err
+	}
+
+	if err := r.releaseCoupons(ctx, tx, []int{id}); err != nil {
+		return err
+	}
+
 	// Soft delete order items
 	err = r.deleteOrderItemsByOrderID(ctx, tx, id)
 	if err != nil {
@@ -212,13 +405,13 @@ func (r *orderRepository) Delete(ctx context.Context, id int) error {
 	return nil
 }
 
-func (r *orderRepository) createOrderItem(ctx context.Context, tx pgx.Tx, item *entities.OrderItem) (*entities.OrderItem, error) {
+func (r *orderRepository) createOrderItem(ctx context.Context, tx pgx.Tx, item *entities.OrderItem, stockReserved bool) (*entities.OrderItem, error) {
 	query := `
-		INSERT INTO order_items (order_id, product_id, quantity, price, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6)
+		INSERT INTO order_items (order_id, product_id, variant_id, quantity, price_cents, stock_reserved, created_at, updated_at)
+		VALUES ($1, $2, NULLIF($3, 0), $4, $5, $6, $7, $8)
 		RETURNING id, created_at, updated_at
 	`
-	err := tx.QueryRow(ctx, query, item.OrderID, item.ProductID, item.Quantity, item.Price, time.Now(), time.Now()).
+	err := tx.QueryRow(ctx, query, item.OrderID, item.ProductID, item.VariantID, item.Quantity, item.Price.Cents, stockReserved, time.Now(), time.Now()).
 		Scan(&item.ID, &item.CreatedAt, &item.UpdatedAt)
 	if err != nil {
 		return nil, err
@@ -229,11 +422,11 @@ func (r *orderRepository) createOrderItem(ctx context.Context, tx pgx.Tx, item *
 func (r *orderRepository) updateOrderItem(ctx context.Context, tx pgx.Tx, item entities.OrderItem) (entities.OrderItem, error) {
 	query := `
 		UPDATE order_items
-		SET quantity = $1, price = $2, updated_at = $3
+		SET quantity = $1, price_cents = $2, updated_at = $3
 		WHERE id = $4 AND order_id = $5
 		RETURNING id, updated_at
 	`
-	err := tx.QueryRow(ctx, query, item.Quantity, item.Price, time.Now(), item.ID, item.OrderID).
+	err := tx.QueryRow(ctx, query, item.Quantity, item.Price.Cents, time.Now(), item.ID, item.OrderID).
 		Scan(&item.ID, &item.UpdatedAt)
 	if err != nil {
 		return entities.OrderItem{}, err
@@ -253,9 +446,12 @@ func (r *orderRepository) deleteOrderItemsByOrderID(ctx context.Context, tx pgx.
 
 func (r *orderRepository) getOrderItemsByOrderID(ctx context.Context, orderID int) ([]entities.OrderItem, error) {
 	query := `
-		SELECT id, order_id, product_id, quantity, price, created_at, updated_at, deleted_at
-		FROM order_items
-		WHERE order_id = $1 AND deleted_at IS NULL
+		SELECT oi.id, oi.order_id, oi.product_id, COALESCE(oi.variant_id, 0), COALESCE(pv.name, ''), oi.quantity, oi.price_cents,
+			oi.created_at, oi.updated_at, oi.deleted_at
+		FROM order_items oi
+		LEFT JOIN product_variants pv ON pv.id = oi.variant_id
+		WHERE oi.order_id = $1 AND oi.deleted_at IS NULL
+		ORDER BY oi.id
 	`
 	rows, err := r.db.Query(ctx, query, orderID)
 	if err != nil {
@@ -266,7 +462,8 @@ func (r *orderRepository) getOrderItemsByOrderID(ctx context.Context, orderID in
 	var items []entities.OrderItem
 	for rows.Next() {
 		var item entities.OrderItem
-		err := rows.Scan(&item.ID, &item.OrderID, &item.ProductID, &item.Quantity, &item.Price, &item.CreatedAt, &item.UpdatedAt, &item.DeletedAt)
+		err := rows.Scan(&item.ID, &item.OrderID, &item.ProductID, &item.VariantID, &item.VariantName, &item.Quantity, &item.Price.Cents,
+			&item.CreatedAt, &item.UpdatedAt, &item.DeletedAt)
 		if err != nil {
 			return nil, err
 		}
@@ -276,13 +473,335 @@ func (r *orderRepository) getOrderItemsByOrderID(ctx context.Context, orderID in
 	return items, nil
 }
 
+func (r *orderRepository) createOrderFee(ctx context.Context, tx pgx.Tx, fee *entities.OrderFee) (*entities.OrderFee, error) {
+	query := `
+		INSERT INTO order_fees (order_id, payment_tax_setting_id, name, amount_type, amount_value, applicable_to, amount_cents, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		RETURNING id, created_at, updated_at
+	`
+	err := tx.QueryRow(ctx, query, fee.OrderID, fee.PaymentTaxSettingID, fee.Name, fee.AmountType, fee.AmountValue, fee.ApplicableTo, fee.Amount.Cents, time.Now(), time.Now()).
+		Scan(&fee.ID, &fee.CreatedAt, &fee.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return fee, nil
+}
+
+func (r *orderRepository) getOrderFeesByOrderID(ctx context.Context, orderID int) ([]entities.OrderFee, error) {
+	query := `
+		SELECT id, order_id, COALESCE(payment_tax_setting_id, 0), name, amount_type, amount_value, applicable_to, amount_cents, created_at, updated_at
+		FROM order_fees
+		WHERE order_id = $1 AND deleted_at IS NULL
+		ORDER BY id
+	`
+	rows, err := r.db.Query(ctx, query, orderID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	fees := make([]entities.OrderFee, 0)
+	for rows.Next() {
+		var fee entities.OrderFee
+		err := rows.Scan(&fee.ID, &fee.OrderID, &fee.PaymentTaxSettingID, &fee.Name, &fee.AmountType, &fee.AmountValue, &fee.ApplicableTo, &fee.Amount.Cents, &fee.CreatedAt, &fee.UpdatedAt)
+		if err != nil {
+			return nil, err
+		}
+		fees = append(fees, fee)
+	}
+
+	return fees, nil
+}
+
+// redeemCoupon counts a redemption of the coupon for the order client. The conditional update
+// locks the coupon row until the order transaction ends, so concurrent checkouts with the same
+// coupon are serialized and neither the global nor the per-client limit can be exceeded.
+func (r *orderRepository) redeemCoupon(ctx context.Context, tx pgx.Tx, order entities.Order, couponID int) error {
+	query := `
+		UPDATE coupons
+		SET redemptions_count = redemptions_count + 1
+		WHERE id = $1 AND active AND deleted_at IS NULL
+		  AND (starts_at IS NULL OR starts_at <= NOW())
+		  AND (ends_at IS NULL OR ends_at > NOW())
+		  AND (max_redemptions IS NULL OR redemptions_count < max_redemptions)
+		RETURNING max_redemptions_per_client
+	`
+	var maxRedemptionsPerClient *int
+	err := tx.QueryRow(ctx, query, couponID).Scan(&maxRedemptionsPerClient)
+	if err == pgx.ErrNoRows {
+		return domainError.NewEntityNotProcessableError("coupon", "coupon is no longer available")
+	} else if err != nil {
+		return err
+	}
+
+	if maxRedemptionsPerClient != nil {
+		var clientRedemptions int
+		query = `SELECT COUNT(*) FROM coupon_redemptions WHERE coupon_id = $1 AND client_id = $2`
+		if err := tx.QueryRow(ctx, query, couponID, order.ClientID).Scan(&clientRedemptions); err != nil {
+			return err
+		}
+
+		if clientRedemptions >= *maxRedemptionsPerClient {
+			return domainError.NewEntityNotProcessableError("coupon", fmt.Sprintf("coupon can be used at most %d time(s) per client", *maxRedemptionsPerClient))
+		}
+	}
+
+	query = `INSERT INTO coupon_redemptions (coupon_id, order_id, client_id) VALUES ($1, $2, $3)`
+	_, err = tx.Exec(ctx, query, couponID, order.ID, order.ClientID)
+	return err
+}
+
+// releaseCoupons gives back the coupon redemptions of the orders, to the global count and to the
+// client. The redemption rows are deleted along, so an order is never released twice.
+func (r *orderRepository) releaseCoupons(ctx context.Context, tx pgx.Tx, orderIDs []int) error {
+	if len(orderIDs) == 0 {
+		return nil
+	}
+
+	query := `
+		WITH released AS (
+			DELETE FROM coupon_redemptions
+			WHERE order_id = ANY($1)
+			RETURNING coupon_id
+		)
+		UPDATE coupons c
+		SET redemptions_count = GREATEST(c.redemptions_count - r.count, 0)
+		FROM (SELECT coupon_id, COUNT(*) AS count FROM released GROUP BY coupon_id) r
+		WHERE c.id = r.coupon_id
+	`
+	_, err := tx.Exec(ctx, query, orderIDs)
+	return err
+}
+
+// stockKey identifies the stock an item is taken from, the variant ordered or, without one, the product.
+type stockKey struct {
+	productID int
+	variantID int
+}
+
+func newStockKey(item entities.OrderItem) stockKey {
+	return stockKey{productID: item.ProductID, variantID: item.VariantID}
+}
+
+// reserveStock takes the quantities of the items from the stock of their tracked products and
+// variants and returns which stocks were reserved. Products are locked in ID order, then variants
+// in ID order, so concurrent checkouts never deadlock, and every shortage is collected before
+// failing with an InsufficientStockError.
+func (r *orderRepository) reserveStock(ctx context.Context, tx pgx.Tx, items []entities.OrderItem) (map[stockKey]bool, error) {
+	requested := make(map[stockKey]int)
+	productIDs := make([]int, 0)
+	variantKeys := make([]stockKey, 0)
+	for _, item := range items {
+		key := newStockKey(item)
+		if _, ok := requested[key]; !ok && key.variantID != 0 {
+			variantKeys = append(variantKeys, key)
+		}
+		if !slices.Contains(productIDs, item.ProductID) {
+			productIDs = append(productIDs, item.ProductID)
+		}
+		requested[key] += item.Quantity
+	}
+	sort.Ints(productIDs)
+	sort.Slice(variantKeys, func(i, j int) bool { return variantKeys[i].variantID < variantKeys[j].variantID })
+
+	productNames := make(map[int]string)
+	reserved := make(map[stockKey]bool)
+	shortages := make(map[stockKey]entities.StockShortage)
+	for _, productID := range productIDs {
+		var name string
+		var tracked, ingredientsAvailable bool
+		var quantity int
+		query := `SELECT name, track_stock, stock_quantity, ingredients_available FROM products WHERE id = $1 AND deleted_at IS NULL FOR UPDATE`
+		err := tx.QueryRow(ctx, query, productID).Scan(&name, &tracked, &quantity, &ingredientsAvailable)
+		if err == pgx.ErrNoRows {
+			return nil, domainError.ErrNotFound("product")
+		} else if err != nil {
+			return nil, err
+		}
+		productNames[productID] = name
+
+		if !ingredientsAvailable {
+			return nil, domainError.NewEntityNotProcessableError("order", "product "+name+" is unavailable, its ingredients ran out")
+		}
+
+		// Items with a variant take from the variant stock, only the others use the product stock
+		key := stockKey{productID: productID}
+		if _, ok := requested[key]; !ok || !tracked {
+			continue
+		}
+
+		if quantity < requested[key] {
+			shortages[key] = entities.StockShortage{
+				ProductID:   productID,
+				ProductName: name,
+				Requested:   requested[key],
+				Available:   quantity,
+			}
+			continue
+		}
+
+		query = `UPDATE products SET stock_quantity = stock_quantity - $2, updated_at = NOW() WHERE id = $1`
+		if _, err := tx.Exec(ctx, query, productID, requested[key]); err != nil {
+			return nil, err
+		}
+		reserved[key] = true
+	}
+
+	for _, key := range variantKeys {
+		var name string
+		var tracked bool
+		var quantity int
+		query := `SELECT name, track_stock, stock_quantity FROM product_variants WHERE id = $1 AND product_id = $2 AND deleted_at IS NULL FOR UPDATE`
+		err := tx.QueryRow(ctx, query, key.variantID, key.productID).Scan(&name, &tracked, &quantity)
+		if err == pgx.ErrNoRows {
+			return nil, domainError.ErrNotFound("product variant")
+		} else if err != nil {
+			return nil, err
+		}
+
+		if !tracked {
+			continue
+		}
+
+		if quantity < requested[key] {
+			shortages[key] = entities.StockShortage{
+				ProductID:   key.productID,
+				VariantID:   key.variantID,
+				ProductName: productNames[key.productID] + " " + name,
+				Requested:   requested[key],
+				Available:   quantity,
+			}
+			continue
+		}
+
+		query = `UPDATE product_variants SET stock_quantity = stock_quantity - $2 WHERE id = $1`
+		if _, err := tx.Exec(ctx, query, key.variantID, requested[key]); err != nil {
+			return nil, err
+		}
+		reserved[key] = true
+	}
+
+	if len(shortages) > 0 {
+		// Report the shortages in item order, like the checkout request listed them
+		ordered := make([]entities.StockShortage, 0, len(shortages))
+		for _, item := range items {
+			if shortage, ok := shortages[newStockKey(item)]; ok {
+				ordered = append(ordered, shortage)
+				delete(shortages, newStockKey(item))
+			}
+		}
+		return nil, domainError.NewInsufficientStockError(ordered)
+	}
+
+	return reserved, nil
+}
+
+// restoreStock gives back the stock reserved by the items of the orders, to the variant ordered or
+// to the product, and clears the reservation, so an order is never restored twice.
+func (r *orderRepository) restoreStock(ctx context.Context, tx pgx.Tx, orderIDs []int) error {
+	if len(orderIDs) == 0 {
+		return nil
+	}
+
+	query := `
+		WITH released AS (
+			UPDATE order_items
+			SET stock_reserved = FALSE, updated_at = NOW()
+			WHERE order_id = ANY($1) AND stock_reserved
+			RETURNING product_id, variant_id, quantity
+		), restored_variants AS (
+			UPDATE product_variants v
+			SET stock_quantity = v.stock_quantity + r.quantity
+			FROM (SELECT variant_id, SUM(quantity) AS quantity FROM released WHERE variant_id IS NOT NULL GROUP BY variant_id) r
+			WHERE v.id = r.variant_id
+		)
+		UPDATE products p
+		SET stock_quantity = p.stock_quantity + r.quantity, updated_at = NOW()
+		FROM (SELECT product_id, SUM(quantity) AS quantity FROM released WHERE variant_id IS NULL GROUP BY product_id) r
+		WHERE p.id = r.product_id
+	`
+	_, err := tx.Exec(ctx, query, orderIDs)
+	return err
+}
+
+// redeemLoyaltyPoints debits the points from the client ledger. The client row is locked while
+// the balance is checked, so concurrent checkouts can never spend the same points twice.
+func (r *orderRepository) redeemLoyaltyPoints(ctx context.Context, tx pgx.Tx, order entities.Order, points int) error {
+	var clientID int
+	err := tx.QueryRow(ctx, `SELECT id FROM clients WHERE id = $1 AND deleted_at IS NULL FOR UPDATE`, order.ClientID).Scan(&clientID)
+	if err == pgx.ErrNoRows {
+		return domainError.ErrNotFound("client")
+	} else if err != nil {
+		return err
+	}
+
+	var balance int
+	if err := tx.QueryRow(ctx, `SELECT COALESCE(SUM(points), 0) FROM loyalty_ledger WHERE client_id = $1`, clientID).Scan(&balance); err != nil {
+		return err
+	}
+
+	if balance < points {
+		return domainError.NewEntityNotProcessableError("loyalty", fmt.Sprintf("client has %d points, %d requested", balance, points))
+	}
+
+	orderID := order.ID
+	return appendLoyaltyEntry(ctx, tx, entities.LoyaltyEntry{
+		ClientID:    clientID,
+		OrderID:     &orderID,
+		Type:        entities.LoyaltyEntryRedeem,
+		Points:      -points,
+		Description: fmt.Sprintf("Points redeemed on order %d", order.ID),
+	})
+}
+
+func (r *orderRepository) createOrderDiscount(ctx context.Context, tx pgx.Tx, discount *entities.OrderDiscount) (*entities.OrderDiscount, error) {
+	query := `
+		INSERT INTO order_discounts (order_id, coupon_id, code, amount_type, amount_value, amount_cents, loyalty_points, created_at, updated_at)
+		VALUES ($1, NULLIF($2, 0), $3, $4, $5, $6, $7, $8, $9)
+		RETURNING id, created_at, updated_at
+	`
+	err := tx.QueryRow(ctx, query, discount.OrderID, discount.CouponID, discount.Code, discount.AmountType, discount.AmountValue, discount.Amount.Cents, discount.LoyaltyPoints, time.Now(), time.Now()).
+		Scan(&discount.ID, &discount.CreatedAt, &discount.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return discount, nil
+}
+
+func (r *orderRepository) getOrderDiscountsByOrderID(ctx context.Context, orderID int) ([]entities.OrderDiscount, error) {
+	query := `
+		SELECT id, order_id, COALESCE(coupon_id, 0), code, amount_type, amount_value, amount_cents, loyalty_points, created_at, updated_at
+		FROM order_discounts
+		WHERE order_id = $1 AND deleted_at IS NULL
+		ORDER BY id
+	`
+	rows, err := r.db.Query(ctx, query, orderID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	discounts := make([]entities.OrderDiscount, 0)
+	for rows.Next() {
+		var discount entities.OrderDiscount
+		err := rows.Scan(&discount.ID, &discount.OrderID, &discount.CouponID, &discount.Code, &discount.AmountType, &discount.AmountValue, &discount.Amount.Cents, &discount.LoyaltyPoints, &discount.CreatedAt, &discount.UpdatedAt)
+		if err != nil {
+			return nil, err
+		}
+		discounts = append(discounts, discount)
+	}
+
+	return discounts, nil
+}
+
 func (r *orderRepository) createPayment(ctx context.Context, tx pgx.Tx, payment *entities.Payment) (*entities.Payment, error) {
 	query := `
-		INSERT INTO payments (order_id, status, method, amount, external_reference, qr_data ,created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		INSERT INTO payments (order_id, status, method, amount_cents, currency, external_reference, qr_data, card_token, card_brand, card_last4, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
 		RETURNING id, created_at, updated_at
 	`
-	err := tx.QueryRow(ctx, query, payment.OrderID, payment.Status, payment.Method, payment.Amount, payment.ExternalReference, payment.QRData, time.Now(), time.Now()).
+	cardToken, cardBrand, cardLast4 := paymentCardColumns(*payment)
+	err := tx.QueryRow(ctx, query, payment.OrderID, payment.Status, payment.Method, payment.Amount.Cents, payment.Amount.Currency, payment.ExternalReference, payment.QRData, cardToken, cardBrand, cardLast4, time.Now(), time.Now()).
 		Scan(&payment.ID, &payment.CreatedAt, &payment.UpdatedAt)
 	if err != nil {
 		return nil, err
@@ -293,10 +812,10 @@ func (r *orderRepository) createPayment(ctx context.Context, tx pgx.Tx, payment
 func (r *orderRepository) updatePayment(ctx context.Context, tx pgx.Tx, payment entities.Payment) error {
 	query := `
 		UPDATE payments
-		SET status = $1, method = $2, amount = $3, updated_at = $4
+		SET status = $1, method = $2, amount_cents = $3, updated_at = $4
 		WHERE id = $5 AND order_id = $6
 	`
-	_, err := tx.Exec(ctx, query, payment.Status, payment.Method, payment.Amount, time.Now(), payment.ID, payment.OrderID)
+	_, err := tx.Exec(ctx, query, payment.Status, payment.Method, payment.Amount.Cents, time.Now(), payment.ID, payment.OrderID)
 	return err
 }
 
@@ -312,18 +831,34 @@ func (r *orderRepository) deletePaymentByOrderID(ctx context.Context, tx pgx.Tx,
 
 func (r *orderRepository) getPaymentByOrderID(ctx context.Context, orderID int) (entities.Payment, error) {
 	query := `
-		SELECT id, order_id, status, method, amount, created_at, updated_at, deleted_at
+		SELECT id, order_id, status, method, amount_cents, refunded_amount_cents, currency, COALESCE(external_reference, ''),
+			COALESCE(card_token, ''), COALESCE(card_brand, ''), COALESCE(card_last4, ''), created_at, updated_at, deleted_at
 		FROM payments
 		WHERE order_id = $1 AND deleted_at IS NULL
 	`
 	var payment entities.Payment
+	var card entities.PaymentCard
 	err := r.db.QueryRow(ctx, query, orderID).
-		Scan(&payment.ID, &payment.OrderID, &payment.Status, &payment.Method, &payment.Amount, &payment.CreatedAt, &payment.UpdatedAt, &payment.DeletedAt)
+		Scan(&payment.ID, &payment.OrderID, &payment.Status, &payment.Method, &payment.Amount.Cents, &payment.RefundedAmount.Cents, &payment.Amount.Currency, &payment.ExternalReference,
+			&card.Token, &card.Brand, &card.Last4, &payment.CreatedAt, &payment.UpdatedAt, &payment.DeletedAt)
 	if err == pgx.ErrNoRows {
 		return entities.Payment{}, ErrOrderNotFound
 	} else if err != nil {
 		return entities.Payment{}, err
 	}
+	payment.RefundedAmount.Currency = payment.Amount.Currency
+	if card.Token != "" {
+		payment.Card = &card
+	}
 
 	return payment, nil
 }
+
+// paymentCardColumns returns the card columns of the payment, NULL for payments without a card.
+func paymentCardColumns(payment entities.Payment) (*string, *string, *string) {
+	if payment.Card == nil {
+		return nil, nil, nil
+	}
+
+	return &payment.Card.Token, &payment.Card.Brand, &payment.Card.Last4
+}
@@ -2,13 +2,16 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"log/slog"
+	"time"
 
 	pgx "github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 	sqlcDB "github.com/tupizz/restaurant-food-golang-api-fiap/database/sqlc"
 	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+	domainError "github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/error"
 	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
 )
 
@@ -24,11 +27,16 @@ func NewPaymentRepository(sqlcDb *sqlcDB.Queries, dbPool *pgxpool.Pool) ports.Pa
 	}
 }
 
-func (r *paymentRepository) UpdateOrderPaymentStatus(ctx context.Context, externalReference string, paymentMethod string, status entities.PaymentStatus) error {
+// UpdateOrderPaymentStatus stores the gateway outcome of the payment and moves the order to
+// preparing, posting the consumption of its ingredients, or to payment_failed so the client can
+// retry with another method, returning the ID of the order. Only pending payments are settled, a
+// repeated or late notification never overwrites an approved, failed or refunded payment, and
+// only orders still pending or in payment_failed are moved.
+func (r *paymentRepository) UpdateOrderPaymentStatus(ctx context.Context, externalReference string, paymentMethod string, status entities.PaymentStatus) (int, error) {
 	tx, err := r.dbPool.BeginTx(ctx, pgx.TxOptions{})
 	if err != nil {
 		slog.Error("Error starting transaction", "error", err)
-		return err
+		return 0, err
 	}
 
 	defer func() {
@@ -48,7 +56,7 @@ func (r *paymentRepository) UpdateOrderPaymentStatus(ctx context.Context, extern
 
 	qtx := r.sqlcDb.WithTx(tx)
 
-	err = qtx.UpdateOrderPaymentStatus(ctx, sqlcDB.UpdateOrderPaymentStatusParams{
+	updated, err := qtx.UpdateOrderPaymentStatus(ctx, sqlcDB.UpdateOrderPaymentStatusParams{
 		ExternalReference: pgtype.Text{
 			String: externalReference,
 			Valid:  true,
@@ -60,14 +68,7 @@ func (r *paymentRepository) UpdateOrderPaymentStatus(ctx context.Context, extern
 		},
 	})
 	if err != nil {
-		return err
-	}
-
-	var orderStatusToUpdate entities.OrderStatus
-	if status == entities.PaymentStatusApproved {
-		orderStatusToUpdate = entities.OrderStatusPreparing
-	} else {
-		orderStatusToUpdate = entities.OrderStatusCanceled
+		return 0, err
 	}
 
 	orderId, err := qtx.GetOrderIdByExternalReferenceAndMethod(ctx, sqlcDB.GetOrderIdByExternalReferenceAndMethodParams{
@@ -77,21 +78,72 @@ func (r *paymentRepository) UpdateOrderPaymentStatus(ctx context.Context, extern
 		},
 		Method: paymentMethod,
 	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, domainError.ErrNotFound("payment")
+	} else if err != nil {
+		return 0, err
+	}
+
+	if updated == 0 {
+		slog.Warn("Payment already settled, notification ignored", "externalReference", externalReference, "paymentMethod", paymentMethod, "status", status)
+		return int(orderId), nil
+	}
+
+	// Orders only move while waiting for a payment, a pending one or one retrying after a failure.
+	// A canceled order, like one whose retry window expired, is left alone.
+	var moved int64
+	if status == entities.PaymentStatusApproved {
+		moved, err = qtx.MarkOrderPaid(ctx, orderId)
+		if err != nil {
+			return 0, err
+		}
+
+		if moved > 0 {
+			// The kitchen starts on the order, its ingredients leave the stock
+			if _, err = tx.Exec(ctx, postOrderConsumptionQuery, orderId); err != nil {
+				return 0, err
+			}
+			_, err = tx.Exec(ctx, refreshIngredientsAvailabilityQuery)
+		}
+	} else {
+		moved, err = qtx.MarkOrderPaymentFailed(ctx, orderId)
+	}
+
 	if err != nil {
-		return err
+		return 0, err
 	}
 
-	err = qtx.UpdateOrderStatus(ctx, sqlcDB.UpdateOrderStatusParams{
-		ID: int32(orderId),
-		Status: pgtype.Text{
-			String: string(orderStatusToUpdate),
-			Valid:  true,
+	if moved == 0 {
+		slog.Warn("Order no longer waits for a payment, its status was kept", "order_id", orderId, "externalReference", externalReference, "status", status)
+	}
+
+	return int(orderId), nil
+}
+
+func (r *paymentRepository) GetPendingCreatedBefore(ctx context.Context, createdBefore time.Time, limit int) ([]entities.Payment, error) {
+	rows, err := r.sqlcDb.GetPendingPaymentsCreatedBefore(ctx, sqlcDB.GetPendingPaymentsCreatedBeforeParams{
+		CreatedAt: pgtype.Timestamp{
+			Time:  createdBefore,
+			Valid: true,
 		},
+		Limit: int32(limit),
 	})
-
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	payments := make([]entities.Payment, 0, len(rows))
+	for _, row := range rows {
+		payments = append(payments, entities.Payment{
+			ID:                int(row.ID),
+			OrderID:           int(row.OrderID),
+			Status:            entities.PaymentStatus(row.Status.String),
+			Method:            entities.PaymentMethod(row.Method),
+			Amount:            entities.Money{Cents: row.AmountCents, Currency: row.Currency},
+			ExternalReference: row.ExternalReference.String,
+			CreatedAt:         row.CreatedAt.Time,
+		})
 	}
 
-	return nil
+	return payments, nil
 }
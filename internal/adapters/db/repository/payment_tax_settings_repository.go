@@ -0,0 +1,128 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+	domainError "github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/error"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+)
+
+type paymentTaxSettingsRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewPaymentTaxSettingsRepository(db *pgxpool.Pool) ports.PaymentTaxSettingsRepository {
+	return &paymentTaxSettingsRepository{db: db}
+}
+
+const paymentTaxSettingsSelect = `
+	SELECT id, name, COALESCE(description, ''), amount_type, amount_value, applicable_to, active, created_at, updated_at
+	FROM payment_tax_settings
+`
+
+func (r *paymentTaxSettingsRepository) GetAll(ctx context.Context) ([]entities.PaymentTaxSettings, error) {
+	return r.query(ctx, paymentTaxSettingsSelect+` WHERE deleted_at IS NULL ORDER BY id`)
+}
+
+func (r *paymentTaxSettingsRepository) GetActive(ctx context.Context) ([]entities.PaymentTaxSettings, error) {
+	return r.query(ctx, paymentTaxSettingsSelect+` WHERE deleted_at IS NULL AND active ORDER BY id`)
+}
+
+func (r *paymentTaxSettingsRepository) GetByID(ctx context.Context, id int) (entities.PaymentTaxSettings, error) {
+	return scanPaymentTaxSettings(r.db.QueryRow(ctx, paymentTaxSettingsSelect+` WHERE id = $1 AND deleted_at IS NULL`, id))
+}
+
+func (r *paymentTaxSettingsRepository) Create(ctx context.Context, setting entities.PaymentTaxSettings) (entities.PaymentTaxSettings, error) {
+	query := `
+		INSERT INTO payment_tax_settings (name, description, amount_type, amount_value, applicable_to, active)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id, created_at, updated_at
+	`
+	err := r.db.QueryRow(ctx, query, setting.Name, setting.Description, setting.AmountType, setting.AmountValue, setting.ApplicableTo, setting.Active).
+		Scan(&setting.ID, &setting.CreatedAt, &setting.UpdatedAt)
+	if err != nil {
+		return entities.PaymentTaxSettings{}, err
+	}
+
+	return setting, nil
+}
+
+func (r *paymentTaxSettingsRepository) Update(ctx context.Context, setting entities.PaymentTaxSettings) (entities.PaymentTaxSettings, error) {
+	query := `
+		UPDATE payment_tax_settings
+		SET name = $2, description = $3, amount_type = $4, amount_value = $5, applicable_to = $6, active = $7
+		WHERE id = $1 AND deleted_at IS NULL
+		RETURNING created_at, updated_at
+	`
+	err := r.db.QueryRow(ctx, query, setting.ID, setting.Name, setting.Description, setting.AmountType, setting.AmountValue, setting.ApplicableTo, setting.Active).
+		Scan(&setting.CreatedAt, &setting.UpdatedAt)
+	if err == pgx.ErrNoRows {
+		return entities.PaymentTaxSettings{}, domainError.ErrNotFound("payment tax setting")
+	} else if err != nil {
+		return entities.PaymentTaxSettings{}, err
+	}
+
+	return setting, nil
+}
+
+// Delete soft deletes the setting, fees already charged keep their snapshot in order_fees.
+func (r *paymentTaxSettingsRepository) Delete(ctx context.Context, id int) error {
+	tag, err := r.db.Exec(ctx, `UPDATE payment_tax_settings SET deleted_at = NOW() WHERE id = $1 AND deleted_at IS NULL`, id)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return domainError.ErrNotFound("payment tax setting")
+	}
+
+	return nil
+}
+
+func (r *paymentTaxSettingsRepository) query(ctx context.Context, query string, args ...any) ([]entities.PaymentTaxSettings, error) {
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	settings := make([]entities.PaymentTaxSettings, 0)
+	for rows.Next() {
+		setting, err := scanPaymentTaxSettings(rows)
+		if err != nil {
+			return nil, err
+		}
+		settings = append(settings, setting)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return settings, nil
+}
+
+func scanPaymentTaxSettings(row pgx.Row) (entities.PaymentTaxSettings, error) {
+	var setting entities.PaymentTaxSettings
+	err := row.Scan(
+		&setting.ID,
+		&setting.Name,
+		&setting.Description,
+		&setting.AmountType,
+		&setting.AmountValue,
+		&setting.ApplicableTo,
+		&setting.Active,
+		&setting.CreatedAt,
+		&setting.UpdatedAt,
+	)
+	if err == pgx.ErrNoRows {
+		return entities.PaymentTaxSettings{}, domainError.ErrNotFound("payment tax setting")
+	} else if err != nil {
+		return entities.PaymentTaxSettings{}, err
+	}
+
+	return setting, nil
+}
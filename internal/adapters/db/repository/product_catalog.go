@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+	domainError "github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/error"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+
+	"github.com/jackc/pgx/v4"
+)
+
+func (r *productRepository) ImportCatalog(ctx context.Context, products []entities.Product, changedBy string, dryRun bool) (ports.CatalogImportResult, error) {
+	var result ports.CatalogImportResult
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return result, err
+	}
+	// Rolling back after the commit does nothing, dry runs end here
+	defer tx.Rollback(ctx)
+
+	// xmax is only zero on rows this statement inserted, updated rows tell the two apart
+	query := `
+		INSERT INTO products (sku, name, description, price_cents, currency, category_id, allergens, dietary_tags)
+		SELECT $1, $2, $3, $4, $5, c.id, $7, $8
+		FROM categories c
+		WHERE c.handle = $6 AND c.deleted_at IS NULL
+		ON CONFLICT (sku) WHERE deleted_at IS NULL DO UPDATE SET
+			name = EXCLUDED.name,
+			description = EXCLUDED.description,
+			price_cents = EXCLUDED.price_cents,
+			currency = EXCLUDED.currency,
+			category_id = EXCLUDED.category_id,
+			allergens = EXCLUDED.allergens,
+			dietary_tags = EXCLUDED.dietary_tags
+		RETURNING id, xmax = 0
+	`
+	for _, product := range products {
+		var inserted bool
+		err = tx.QueryRow(ctx, query, product.SKU, product.Name, product.Description, product.Price.Cents, product.Price.Currency,
+			product.Category.Handle, fromAllergens(product.Allergens), fromDietaryTags(product.DietaryTags)).
+			Scan(&product.ID, &inserted)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return result, domainError.ErrNotFound("category " + product.Category.Handle)
+		} else if err != nil {
+			return result, err
+		}
+
+		if inserted {
+			result.Created++
+		} else {
+			result.Updated++
+		}
+
+		if _, err = tx.Exec(ctx, recordPriceChangeQuery, product.ID, changedBy); err != nil {
+			return result, err
+		}
+
+		if product.Nutrition != nil {
+			err = saveProductNutrition(ctx, tx, product.ID, *product.Nutrition)
+		} else {
+			_, err = tx.Exec(ctx, `DELETE FROM product_nutrition WHERE product_id = $1`, product.ID)
+		}
+		if err != nil {
+			return result, err
+		}
+
+		if err = syncProductImages(ctx, tx, product.ID, product.Images); err != nil {
+			return result, err
+		}
+	}
+
+	if dryRun {
+		return result, nil
+	}
+
+	return result, tx.Commit(ctx)
+}
+
+// syncProductImages keeps the images whose URL is still listed, uploaded ones keep their thumbnails,
+// deletes the others and adds the new URLs.
+func syncProductImages(ctx context.Context, tx pgx.Tx, productID int, images []entities.ProductImage) error {
+	urls := make([]string, 0, len(images))
+	for _, image := range images {
+		urls = append(urls, image.ImageURL)
+	}
+
+	query := `UPDATE products_images SET deleted_at = NOW() WHERE product_id = $1 AND deleted_at IS NULL AND NOT (image = ANY($2))`
+	if _, err := tx.Exec(ctx, query, productID, urls); err != nil {
+		return err
+	}
+
+	query = `
+		INSERT INTO products_images (product_id, image)
+		SELECT $1, url
+		FROM (SELECT DISTINCT UNNEST($2::text[]) AS url) urls
+		WHERE NOT EXISTS (
+			SELECT 1 FROM products_images pi WHERE pi.product_id = $1 AND pi.image = url AND pi.deleted_at IS NULL
+		)
+	`
+	_, err := tx.Exec(ctx, query, productID, urls)
+
+	return err
+}
@@ -0,0 +1,174 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+	domainError "github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/error"
+)
+
+// Restore brings a soft-deleted product back to the menu, with the images and variants deleted
+// along with it. Those removed on their own before stay deleted.
+func (r *productRepository) Restore(ctx context.Context, id int) (entities.Product, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return entities.Product{}, err
+	}
+	defer tx.Rollback(ctx)
+
+	var deleted, categoryDeleted bool
+	var sku string
+	query := `
+		SELECT p.deleted_at IS NOT NULL, p.sku, c.deleted_at IS NOT NULL
+		FROM products p
+		JOIN categories c ON c.id = p.category_id
+		WHERE p.id = $1
+		FOR UPDATE OF p
+	`
+	err = tx.QueryRow(ctx, query, id).Scan(&deleted, &sku, &categoryDeleted)
+	if err == pgx.ErrNoRows {
+		return entities.Product{}, domainError.ErrNotFound("product")
+	} else if err != nil {
+		return entities.Product{}, err
+	}
+
+	if !deleted {
+		return entities.Product{}, domainError.NewEntityNotProcessableError("product", "product is not deleted")
+	}
+
+	if categoryDeleted {
+		return entities.Product{}, domainError.NewEntityNotProcessableError("product", "the category of the product was deleted, move it to another one first")
+	}
+
+	// The images and variants go first, while the product still tells which were deleted with it
+	_, err = tx.Exec(ctx, `
+		UPDATE products_images SET deleted_at = NULL
+		WHERE product_id = $1 AND deleted_at = (SELECT deleted_at FROM products WHERE id = $1)
+	`, id)
+	if err != nil {
+		return entities.Product{}, err
+	}
+
+	_, err = tx.Exec(ctx, `
+		UPDATE product_variants SET deleted_at = NULL
+		WHERE product_id = $1 AND deleted_at = (SELECT deleted_at FROM products WHERE id = $1)
+	`, id)
+	if isUniqueViolation(err) {
+		return entities.Product{}, domainError.NewEntityNotProcessableError("product", "the sku of a variant of the product is in use by another product")
+	} else if err != nil {
+		return entities.Product{}, err
+	}
+
+	_, err = tx.Exec(ctx, `UPDATE products SET deleted_at = NULL WHERE id = $1`, id)
+	if isUniqueViolation(err) {
+		return entities.Product{}, domainError.NewEntityNotProcessableError("product", "sku "+sku+" is in use by another product")
+	} else if err != nil {
+		return entities.Product{}, err
+	}
+
+	product, err := getOneProductWithExecutor(ctx, tx, id)
+	if err != nil {
+		return entities.Product{}, err
+	}
+
+	return product, tx.Commit(ctx)
+}
+
+// productOwnedTables hold rows that only exist for the product they reference, in the order they
+// are purged. Order items are not among them, ordered products are never purged.
+var productOwnedTables = []string{
+	"availability_windows",
+	"product_ingredients",
+	"product_nutrition",
+	"product_prices",
+	"product_variants",
+	"products_images",
+}
+
+// Purge deletes the product and the rows owned by it for good. Only products already soft deleted
+// and never ordered can be purged, the images are returned so their files can be removed too.
+func (r *productRepository) Purge(ctx context.Context, id int) ([]entities.ProductImage, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	var deleted, ordered bool
+	query := `
+		SELECT deleted_at IS NOT NULL, EXISTS (SELECT 1 FROM order_items WHERE product_id = p.id)
+		FROM products p
+		WHERE p.id = $1
+		FOR UPDATE
+	`
+	err = tx.QueryRow(ctx, query, id).Scan(&deleted, &ordered)
+	if err == pgx.ErrNoRows {
+		return nil, domainError.ErrNotFound("product")
+	} else if err != nil {
+		return nil, err
+	}
+
+	if !deleted {
+		return nil, domainError.NewEntityNotProcessableError("product", "delete the product before purging it")
+	}
+
+	if ordered {
+		return nil, domainError.NewEntityNotProcessableError("product", "product was ordered, it can only be soft deleted")
+	}
+
+	images, err := productImagesForPurge(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, table := range productOwnedTables {
+		if _, err := tx.Exec(ctx, `DELETE FROM `+table+` WHERE product_id = $1`, id); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM products WHERE id = $1`, id); err != nil {
+		return nil, err
+	}
+
+	return images, tx.Commit(ctx)
+}
+
+// productImagesForPurge lists every image of the product, deleted or not, since all of them
+// still have files in the storage.
+func productImagesForPurge(ctx context.Context, tx pgx.Tx, productID int) ([]entities.ProductImage, error) {
+	query := `
+		SELECT id, image, storage_key, thumbnails, created_at, updated_at
+		FROM products_images
+		WHERE product_id = $1
+	`
+	rows, err := tx.Query(ctx, query, productID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var images []entities.ProductImage
+	for rows.Next() {
+		var image entities.ProductImage
+		var storageKey sql.NullString
+		var thumbnails []byte
+		if err := rows.Scan(&image.ID, &image.ImageURL, &storageKey, &thumbnails, &image.CreatedAt, &image.UpdatedAt); err != nil {
+			return nil, err
+		}
+
+		image.StorageKey = storageKey.String
+		if len(thumbnails) > 0 {
+			if err := json.Unmarshal(thumbnails, &image.Thumbnails); err != nil {
+				return nil, err
+			}
+		}
+
+		images = append(images, image)
+	}
+
+	return images, rows.Err()
+}
@@ -0,0 +1,107 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+
+	"github.com/jackc/pgx/v4"
+)
+
+// loadProductNutrition fills the nutrition facts of the products that have them informed.
+func loadProductNutrition(ctx context.Context, querier productQuerier, products []entities.Product) error {
+	if len(products) == 0 {
+		return nil
+	}
+
+	ids := make([]int, 0, len(products))
+	index := make(map[int]int, len(products))
+	for i, product := range products {
+		ids = append(ids, product.ID)
+		index[product.ID] = i
+	}
+
+	query := `
+		SELECT product_id, serving_size_g::float8, energy_kcal::float8, carbohydrates_g::float8, sugars_g::float8,
+			protein_g::float8, fat_g::float8, saturated_fat_g::float8, fiber_g::float8, sodium_mg::float8
+		FROM product_nutrition
+		WHERE product_id = ANY($1)
+	`
+	rows, err := querier.Query(ctx, query, ids)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var productID int
+		var nutrition entities.NutritionFacts
+		err := rows.Scan(&productID, &nutrition.ServingSizeG, &nutrition.EnergyKcal, &nutrition.CarbohydratesG, &nutrition.SugarsG,
+			&nutrition.ProteinG, &nutrition.FatG, &nutrition.SaturatedFatG, &nutrition.FiberG, &nutrition.SodiumMg)
+		if err != nil {
+			return err
+		}
+
+		products[index[productID]].Nutrition = &nutrition
+	}
+
+	return rows.Err()
+}
+
+func saveProductNutrition(ctx context.Context, tx pgx.Tx, productID int, nutrition entities.NutritionFacts) error {
+	query := `
+		INSERT INTO product_nutrition (product_id, serving_size_g, energy_kcal, carbohydrates_g, sugars_g, protein_g, fat_g,
+			saturated_fat_g, fiber_g, sodium_mg)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		ON CONFLICT (product_id) DO UPDATE SET
+			serving_size_g = EXCLUDED.serving_size_g,
+			energy_kcal = EXCLUDED.energy_kcal,
+			carbohydrates_g = EXCLUDED.carbohydrates_g,
+			sugars_g = EXCLUDED.sugars_g,
+			protein_g = EXCLUDED.protein_g,
+			fat_g = EXCLUDED.fat_g,
+			saturated_fat_g = EXCLUDED.saturated_fat_g,
+			fiber_g = EXCLUDED.fiber_g,
+			sodium_mg = EXCLUDED.sodium_mg
+	`
+	_, err := tx.Exec(ctx, query, productID, nutrition.ServingSizeG, nutrition.EnergyKcal, nutrition.CarbohydratesG,
+		nutrition.SugarsG, nutrition.ProteinG, nutrition.FatG, nutrition.SaturatedFatG, nutrition.FiberG, nutrition.SodiumMg)
+
+	return err
+}
+
+func toAllergens(values []string) []entities.Allergen {
+	allergens := make([]entities.Allergen, 0, len(values))
+	for _, value := range values {
+		allergens = append(allergens, entities.Allergen(value))
+	}
+
+	return allergens
+}
+
+func fromAllergens(allergens []entities.Allergen) []string {
+	values := make([]string, 0, len(allergens))
+	for _, allergen := range allergens {
+		values = append(values, string(allergen))
+	}
+
+	return values
+}
+
+func toDietaryTags(values []string) []entities.DietaryTag {
+	tags := make([]entities.DietaryTag, 0, len(values))
+	for _, value := range values {
+		tags = append(tags, entities.DietaryTag(value))
+	}
+
+	return tags
+}
+
+func fromDietaryTags(tags []entities.DietaryTag) []string {
+	values := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		values = append(values, string(tag))
+	}
+
+	return values
+}
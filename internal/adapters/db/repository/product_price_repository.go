@@ -0,0 +1,227 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+	domainError "github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/error"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+)
+
+type productPriceRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewProductPriceRepository(db *pgxpool.Pool) ports.ProductPriceRepository {
+	return &productPriceRepository{db: db}
+}
+
+// recordPriceChangeQuery adds the current price of the product to its history, unless it is the
+// price last applied. It runs in the transaction that changed the product, after the change.
+const recordPriceChangeQuery = `
+	INSERT INTO product_prices (product_id, price_cents, currency, effective_at, applied_at, changed_by)
+	SELECT p.id, p.price_cents, p.currency, NOW(), NOW(), NULLIF($2, '')
+	FROM products p
+	LEFT JOIN LATERAL (
+		SELECT pp.price_cents, pp.currency
+		FROM product_prices pp
+		WHERE pp.product_id = p.id AND pp.applied_at IS NOT NULL
+		ORDER BY pp.applied_at DESC, pp.id DESC
+		LIMIT 1
+	) last ON TRUE
+	WHERE p.id = $1
+		AND (last.price_cents IS DISTINCT FROM p.price_cents OR last.currency IS DISTINCT FROM p.currency)
+`
+
+const productPriceSelect = `
+	SELECT id, product_id, price_cents, currency, effective_at, applied_at, canceled_at, COALESCE(changed_by, ''), created_at
+	FROM product_prices
+`
+
+func (r *productPriceRepository) GetByProduct(ctx context.Context, productID int) ([]entities.ProductPrice, error) {
+	rows, err := r.db.Query(ctx, productPriceSelect+` WHERE product_id = $1 ORDER BY effective_at DESC, id DESC`, productID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	prices := make([]entities.ProductPrice, 0)
+	for rows.Next() {
+		price, err := scanProductPrice(rows)
+		if err != nil {
+			return nil, err
+		}
+		prices = append(prices, price)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return prices, nil
+}
+
+func (r *productPriceRepository) Apply(ctx context.Context, price entities.ProductPrice) (entities.ProductPrice, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return entities.ProductPrice{}, err
+	}
+	defer tx.Rollback(ctx)
+
+	query := `UPDATE products SET price_cents = $2, currency = $3 WHERE id = $1 AND deleted_at IS NULL`
+	tag, err := tx.Exec(ctx, query, price.ProductID, price.Price.Cents, price.Price.Currency)
+	if err != nil {
+		return entities.ProductPrice{}, err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return entities.ProductPrice{}, domainError.ErrNotFound("product")
+	}
+
+	if _, err := tx.Exec(ctx, recordPriceChangeQuery, price.ProductID, price.ChangedBy); err != nil {
+		return entities.ProductPrice{}, err
+	}
+
+	// Setting the price it already has records nothing, the entry that set it is returned
+	query = productPriceSelect + ` WHERE product_id = $1 AND applied_at IS NOT NULL ORDER BY applied_at DESC, id DESC LIMIT 1`
+	applied, err := scanProductPrice(tx.QueryRow(ctx, query, price.ProductID))
+	if err != nil {
+		return entities.ProductPrice{}, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return entities.ProductPrice{}, err
+	}
+
+	return applied, nil
+}
+
+func (r *productPriceRepository) Schedule(ctx context.Context, price entities.ProductPrice) (entities.ProductPrice, error) {
+	query := `
+		INSERT INTO product_prices (product_id, price_cents, currency, effective_at, changed_by)
+		SELECT id, $2, $3, $4, NULLIF($5, '') FROM products WHERE id = $1 AND deleted_at IS NULL
+		RETURNING id, created_at
+	`
+	err := r.db.QueryRow(ctx, query, price.ProductID, price.Price.Cents, price.Price.Currency, price.EffectiveAt, price.ChangedBy).
+		Scan(&price.ID, &price.CreatedAt)
+	if err == pgx.ErrNoRows {
+		return entities.ProductPrice{}, domainError.ErrNotFound("product")
+	} else if err != nil {
+		return entities.ProductPrice{}, err
+	}
+
+	return price, nil
+}
+
+// Cancel drops a scheduled price before it is applied, the entry stays in the history.
+func (r *productPriceRepository) Cancel(ctx context.Context, productID int, priceID int) error {
+	query := `
+		UPDATE product_prices SET canceled_at = NOW()
+		WHERE id = $1 AND product_id = $2 AND applied_at IS NULL AND canceled_at IS NULL
+	`
+	tag, err := r.db.Exec(ctx, query, priceID, productID)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() > 0 {
+		return nil
+	}
+
+	var exists bool
+	query = `SELECT EXISTS (SELECT 1 FROM product_prices WHERE id = $1 AND product_id = $2)`
+	if err := r.db.QueryRow(ctx, query, priceID, productID).Scan(&exists); err != nil {
+		return err
+	}
+
+	if !exists {
+		return domainError.ErrNotFound("product price")
+	}
+
+	return domainError.NewEntityNotProcessableError("product price", "only scheduled prices can be canceled")
+}
+
+// ApplyDue locks the due prices with SKIP LOCKED, so API instances running the scheduler side by
+// side never apply the same price twice. Prices of deleted products are canceled instead.
+func (r *productPriceRepository) ApplyDue(ctx context.Context, now time.Time, limit int) ([]entities.ProductPrice, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	query := productPriceSelect + `
+		WHERE applied_at IS NULL AND canceled_at IS NULL AND effective_at <= $1
+		ORDER BY effective_at, id
+		LIMIT $2
+		FOR UPDATE SKIP LOCKED
+	`
+	rows, err := tx.Query(ctx, query, now, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	var due []entities.ProductPrice
+	for rows.Next() {
+		price, err := scanProductPrice(rows)
+		if err != nil {
+			rows.Close()
+			return nil, err
+		}
+		due = append(due, price)
+	}
+	rows.Close()
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	applied := make([]entities.ProductPrice, 0, len(due))
+	for _, price := range due {
+		query := `UPDATE products SET price_cents = $2, currency = $3 WHERE id = $1 AND deleted_at IS NULL`
+		tag, err := tx.Exec(ctx, query, price.ProductID, price.Price.Cents, price.Price.Currency)
+		if err != nil {
+			return nil, err
+		}
+
+		if tag.RowsAffected() == 0 {
+			if _, err := tx.Exec(ctx, `UPDATE product_prices SET canceled_at = NOW() WHERE id = $1`, price.ID); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		err = tx.QueryRow(ctx, `UPDATE product_prices SET applied_at = NOW() WHERE id = $1 RETURNING applied_at`, price.ID).
+			Scan(&price.AppliedAt)
+		if err != nil {
+			return nil, err
+		}
+		applied = append(applied, price)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return applied, nil
+}
+
+func scanProductPrice(row pgx.Row) (entities.ProductPrice, error) {
+	var price entities.ProductPrice
+	err := row.Scan(
+		&price.ID,
+		&price.ProductID,
+		&price.Price.Cents,
+		&price.Price.Currency,
+		&price.EffectiveAt,
+		&price.AppliedAt,
+		&price.CanceledAt,
+		&price.ChangedBy,
+		&price.CreatedAt,
+	)
+
+	return price, err
+}
@@ -0,0 +1,109 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+)
+
+type productRecommendationRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewProductRecommendationRepository(db *pgxpool.Pool) ports.ProductRecommendationRepository {
+	return &productRecommendationRepository{db: db}
+}
+
+// Orders count as paid once a payment is approved, partially refunded ones included. A product
+// ordered twice in the same order is counted once.
+const rebuildProductRecommendationsQuery = `
+	WITH paid_items AS (
+		SELECT DISTINCT oi.order_id, oi.product_id
+		FROM order_items oi
+		JOIN orders o ON o.id = oi.order_id
+		WHERE oi.deleted_at IS NULL
+			AND o.deleted_at IS NULL
+			AND o.status <> 'canceled'
+			AND o.created_at >= NOW() - make_interval(days => $1)
+			AND EXISTS (
+				SELECT 1 FROM payments pay
+				WHERE pay.order_id = o.id AND pay.deleted_at IS NULL AND pay.status IN ('approved', 'partially_refunded')
+			)
+	)
+	INSERT INTO product_recommendations (product_id, recommended_product_id, orders_count)
+	SELECT a.product_id, b.product_id, COUNT(*)
+	FROM paid_items a
+	JOIN paid_items b ON b.order_id = a.order_id AND b.product_id <> a.product_id
+	GROUP BY a.product_id, b.product_id
+	HAVING COUNT(*) >= $2
+`
+
+func (r *productRecommendationRepository) Rebuild(ctx context.Context, lookbackDays int, minOrders int) (int, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	// Concurrent rebuilds, from other instances, wait for this one. Reads keep seeing the previous
+	// pairs until the commit.
+	if _, err := tx.Exec(ctx, `LOCK TABLE product_recommendations IN EXCLUSIVE MODE`); err != nil {
+		return 0, err
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM product_recommendations`); err != nil {
+		return 0, err
+	}
+
+	tag, err := tx.Exec(ctx, rebuildProductRecommendationsQuery, lookbackDays, max(minOrders, 1))
+	if err != nil {
+		return 0, err
+	}
+
+	return int(tag.RowsAffected()), tx.Commit(ctx)
+}
+
+// GetForProducts ranks by the orders shared with any of the given products, so a cart with a burger
+// and fries favors what goes with both.
+func (r *productRecommendationRepository) GetForProducts(ctx context.Context, productIDs []int, availableAt time.Time, limit int) ([]int, error) {
+	if len(productIDs) == 0 {
+		return nil, nil
+	}
+
+	args := append([]any{productIDs, limit}, availableAtArgs(availableAt)...)
+	query := `
+		SELECT r.recommended_product_id
+		FROM product_recommendations r
+		JOIN products p ON p.id = r.recommended_product_id
+		JOIN categories c ON c.id = p.category_id
+		WHERE r.product_id = ANY($1)
+			AND NOT (r.recommended_product_id = ANY($1))
+			AND p.deleted_at IS NULL
+			AND c.active AND c.deleted_at IS NULL
+			AND p.ingredients_available
+			AND (NOT p.track_stock OR p.stock_quantity > 0)
+			AND ` + availableAtCondition(3, 4, 5) + `
+		GROUP BY r.recommended_product_id
+		ORDER BY SUM(r.orders_count) DESC, r.recommended_product_id
+		LIMIT $2
+	`
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []int
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, rows.Err()
+}
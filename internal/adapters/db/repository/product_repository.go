@@ -3,9 +3,12 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
 	"strings"
+	"time"
 
 	sqlcDB "github.com/tupizz/restaurant-food-golang-api-fiap/database/sqlc"
 	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
@@ -22,6 +25,11 @@ type productRepository struct {
 	sqlcDb *sqlcDB.Queries
 }
 
+// productQuerier runs queries on the pool or inside a transaction.
+type productQuerier interface {
+	Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error)
+}
+
 func NewProductRepository(db *pgxpool.Pool, sqlcDb *sqlcDB.Queries) ports.ProductRepository {
 	return &productRepository{db: db, sqlcDb: sqlcDb}
 }
@@ -34,22 +42,34 @@ func (r *productRepository) GetByIds(ctx context.Context, ids []int) ([]entities
 	query := `
         SELECT 
             p.id, 
+            p.sku,
             p.name, 
             p.description, 
-            p.price, 
+            p.price_cents, 
+            p.currency, 
 			p.created_at,
 			p.updated_at,
+			p.track_stock,
+			p.stock_quantity,
+			p.low_stock_threshold,
+			p.ingredients_available,
+			p.allergens,
+			p.dietary_tags,
             c.id AS category_id, 
             c.name AS category_name,
+            c.handle AS category_handle,
+            c.active AS category_active,
 			c.created_at AS category_created_at,
 			c.updated_at AS category_updated_at,
             pi.id AS image_id, 
             pi.image,
+            pi.storage_key AS image_storage_key,
+            pi.thumbnails AS image_thumbnails,
 			pi.created_at AS image_created_at,
 			pi.updated_at AS image_updated_at
         FROM products p
         LEFT JOIN categories c ON p.category_id = c.id
-        LEFT JOIN products_images pi ON p.id = pi.product_id
+        LEFT JOIN products_images pi ON p.id = pi.product_id AND pi.deleted_at IS NULL
         WHERE p.deleted_at IS NULL AND p.id = ANY($1)
     `
 
@@ -59,29 +79,44 @@ func (r *productRepository) GetByIds(ctx context.Context, ids []int) ([]entities
 	}
 	defer rows.Close()
 
-	productMap := make(map[int]*entities.Product)
+	productIndex := make(map[int]int)
 	var products []entities.Product
 
 	for rows.Next() {
 		var product entities.Product
+		var allergens, dietaryTags []string
 		var imageID sql.NullInt64
 		var imageURL sql.NullString
+		var imageStorageKey sql.NullString
+		var imageThumbnails []byte
 		var imageCreatedAt sql.NullTime
 		var imageUpdatedAt sql.NullTime
 
 		err = rows.Scan(
 			&product.ID,
+			&product.SKU,
 			&product.Name,
 			&product.Description,
-			&product.Price,
+			&product.Price.Cents,
+			&product.Price.Currency,
 			&product.CreatedAt,
 			&product.UpdatedAt,
+			&product.Stock.Tracked,
+			&product.Stock.Quantity,
+			&product.Stock.LowStockThreshold,
+			&product.IngredientsAvailable,
+			&allergens,
+			&dietaryTags,
 			&product.Category.ID,
 			&product.Category.Name,
+			&product.Category.Handle,
+			&product.Category.Active,
 			&product.Category.CreatedAt,
 			&product.Category.UpdatedAt,
 			&imageID,
 			&imageURL,
+			&imageStorageKey,
+			&imageThumbnails,
 			&imageCreatedAt,
 			&imageUpdatedAt,
 		)
@@ -89,38 +124,42 @@ func (r *productRepository) GetByIds(ctx context.Context, ids []int) ([]entities
 		if err != nil {
 			return nil, 0, err
 		}
-
-		if existingProduct, ok := productMap[product.ID]; ok {
-			if imageID.Valid && imageURL.Valid {
-				existingProduct.Images = append(existingProduct.Images, entities.ProductImage{
-					ID:        int(imageID.Int64),
-					ImageURL:  imageURL.String,
-					CreatedAt: imageCreatedAt.Time,
-					UpdatedAt: imageUpdatedAt.Time,
-				})
-			}
-		} else {
-			if imageID.Valid && imageURL.Valid {
-				product.Images = []entities.ProductImage{{
-					ID:        int(imageID.Int64),
-					ImageURL:  imageURL.String,
-					CreatedAt: imageCreatedAt.Time,
-					UpdatedAt: imageUpdatedAt.Time,
-				}}
-			}
-			productMap[product.ID] = &product
+		product.Allergens = toAllergens(allergens)
+		product.DietaryTags = toDietaryTags(dietaryTags)
+
+		// Products repeat once per image, the index keeps appending them to the same product
+		index, ok := productIndex[product.ID]
+		if !ok {
+			index = len(products)
+			productIndex[product.ID] = index
 			products = append(products, product)
 		}
+
+		if imageID.Valid && imageURL.Valid {
+			products[index].Images = append(products[index].Images, newProductImage(imageID, imageURL, imageStorageKey, imageThumbnails, imageCreatedAt, imageUpdatedAt))
+		}
 	}
 
 	if err = rows.Err(); err != nil {
 		return nil, 0, err
 	}
 
+	if err := loadProductAvailability(ctx, r.db, products); err != nil {
+		return nil, 0, err
+	}
+
+	if err := loadProductNutrition(ctx, r.db, products); err != nil {
+		return nil, 0, err
+	}
+
+	if err := loadProductVariants(ctx, r.db, products); err != nil {
+		return nil, 0, err
+	}
+
 	return products, len(products), nil
 }
 
-func (r *productRepository) Update(ctx context.Context, product entities.Product) (entities.Product, error) {
+func (r *productRepository) Update(ctx context.Context, product entities.Product, changedBy string) (entities.Product, error) {
 	slog.Info("Updating product", "product", shared.ToJSON(product))
 
 	tx, err := r.db.Begin(ctx)
@@ -147,7 +186,7 @@ func (r *productRepository) Update(ctx context.Context, product entities.Product
 	argIndex := 1
 
 	if product.Category.Handle != "" {
-		query := `SELECT id FROM categories WHERE handle = $1`
+		query := `SELECT id FROM categories WHERE handle = $1 AND deleted_at IS NULL FOR SHARE`
 		err = tx.QueryRow(ctx, query, product.Category.Handle).Scan(&product.Category.ID)
 		if err != nil {
 			slog.Error("Error searching for category", "error", err)
@@ -164,6 +203,12 @@ func (r *productRepository) Update(ctx context.Context, product entities.Product
 		argIndex++
 	}
 
+	if product.SKU != "" {
+		columns = append(columns, fmt.Sprintf("sku = $%d", argIndex))
+		args = append(args, product.SKU)
+		argIndex++
+	}
+
 	if product.Name != "" {
 		columns = append(columns, fmt.Sprintf("name = $%d", argIndex))
 		args = append(args, product.Name)
@@ -176,9 +221,27 @@ func (r *productRepository) Update(ctx context.Context, product entities.Product
 		argIndex++
 	}
 
-	if product.Price != 0 {
-		columns = append(columns, fmt.Sprintf("price = $%d", argIndex))
-		args = append(args, product.Price)
+	if product.Price.Cents != 0 {
+		columns = append(columns, fmt.Sprintf("price_cents = $%d", argIndex))
+		args = append(args, product.Price.Cents)
+		argIndex++
+	}
+
+	if product.Price.Currency != "" {
+		columns = append(columns, fmt.Sprintf("currency = $%d", argIndex))
+		args = append(args, product.Price.Currency)
+		argIndex++
+	}
+
+	if product.Allergens != nil {
+		columns = append(columns, fmt.Sprintf("allergens = $%d", argIndex))
+		args = append(args, fromAllergens(product.Allergens))
+		argIndex++
+	}
+
+	if product.DietaryTags != nil {
+		columns = append(columns, fmt.Sprintf("dietary_tags = $%d", argIndex))
+		args = append(args, fromDietaryTags(product.DietaryTags))
 		argIndex++
 	}
 
@@ -188,10 +251,27 @@ func (r *productRepository) Update(ctx context.Context, product entities.Product
 		args = append(args, product.ID)
 		slog.Info("Updating product", "args", args)
 		_, err = tx.Exec(ctx, query, args...)
-		if err != nil {
+		if isUniqueViolation(err) {
+			err = domainError.NewEntityNotProcessableError("product", "sku "+product.SKU+" is already in use")
+			return entities.Product{}, err
+		} else if err != nil {
 			slog.Error("Error updating product", "error", err)
 			return entities.Product{}, err
 		}
+
+		_, err = tx.Exec(ctx, recordPriceChangeQuery, product.ID, changedBy)
+		if err != nil {
+			slog.Error("Error recording product price", "error", err)
+			return entities.Product{}, err
+		}
+	}
+
+	if product.Nutrition != nil {
+		err = saveProductNutrition(ctx, tx, product.ID, *product.Nutrition)
+		if err != nil {
+			slog.Error("Error saving product nutrition", "error", err)
+			return entities.Product{}, err
+		}
 	}
 
 	if len(product.Images) > 0 {
@@ -220,16 +300,78 @@ func (r *productRepository) Update(ctx context.Context, product entities.Product
 	return product, nil
 }
 
+// UpdateStock replaces the stock settings of the product, used when counting or restocking it.
+func (r *productRepository) UpdateStock(ctx context.Context, id int, stock entities.ProductStock) (entities.Product, error) {
+	query := `
+		UPDATE products
+		SET track_stock = $2, stock_quantity = $3, low_stock_threshold = $4, updated_at = NOW()
+		WHERE id = $1 AND deleted_at IS NULL
+	`
+	tag, err := r.db.Exec(ctx, query, id, stock.Tracked, stock.Quantity, stock.LowStockThreshold)
+	if err != nil {
+		return entities.Product{}, err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return entities.Product{}, domainError.ErrNotFound("product")
+	}
+
+	return getOneProductWithExecutor(ctx, r.db, id)
+}
+
+// Delete soft deletes the product along with its images and variants, all with the same
+// deleted_at, so Restore brings back exactly what went away with it.
 func (r *productRepository) Delete(ctx context.Context, id int) error {
 	slog.Info("Deleting product", "id", id)
-	_, err := r.db.Exec(ctx, "UPDATE products SET deleted_at = NOW() WHERE id = $1", id)
+
+	tx, err := r.db.Begin(ctx)
 	if err != nil {
 		return err
 	}
-	return nil
+	defer tx.Rollback(ctx)
+
+	// NOW() is the start of the transaction, so all of them get the same deleted_at
+	tag, err := tx.Exec(ctx, "UPDATE products SET deleted_at = NOW() WHERE id = $1 AND deleted_at IS NULL", id)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return domainError.ErrNotFound("product")
+	}
+
+	for _, table := range []string{"products_images", "product_variants"} {
+		query := `UPDATE ` + table + ` SET deleted_at = NOW() WHERE product_id = $1 AND deleted_at IS NULL`
+		if _, err := tx.Exec(ctx, query, id); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+// LastModified relies on the triggers that keep updated_at, soft deletes included, so a product
+// leaving the menu also counts as a change.
+func (r *productRepository) LastModified(ctx context.Context) (time.Time, error) {
+	query := `
+		SELECT GREATEST(
+			(SELECT MAX(updated_at) FROM products),
+			(SELECT MAX(updated_at) FROM categories),
+			(SELECT MAX(updated_at) FROM products_images),
+			(SELECT MAX(updated_at) FROM product_nutrition),
+			(SELECT MAX(updated_at) FROM product_variants)
+		)
+	`
+
+	var lastModified sql.NullTime
+	if err := r.db.QueryRow(ctx, query).Scan(&lastModified); err != nil {
+		return time.Time{}, err
+	}
+
+	return lastModified.Time, nil
 }
 
-func (r *productRepository) Create(ctx context.Context, product entities.Product) (entities.Product, error) {
+func (r *productRepository) Create(ctx context.Context, product entities.Product, changedBy string) (entities.Product, error) {
 	slog.Info("Creating product", "product", shared.ToJSON(product))
 
 	tx, err := r.db.Begin(ctx)
@@ -250,7 +392,8 @@ func (r *productRepository) Create(ctx context.Context, product entities.Product
 		}
 	}()
 
-	query := `SELECT id FROM categories WHERE handle = $1`
+	// The row lock keeps the category from being deleted until the product is stored
+	query := `SELECT id FROM categories WHERE handle = $1 AND deleted_at IS NULL FOR SHARE`
 	err = tx.QueryRow(ctx, query, product.Category.Handle).Scan(&product.Category.ID)
 	if err != nil {
 		return entities.Product{}, domainError.ErrNotFound("category")
@@ -260,8 +403,33 @@ func (r *productRepository) Create(ctx context.Context, product entities.Product
 		return entities.Product{}, domainError.ErrNotFound("category")
 	}
 
-	query = `INSERT INTO products (name, description, price, category_id) VALUES ($1, $2, $3, $4) RETURNING id`
-	err = tx.QueryRow(ctx, query, product.Name, product.Description, product.Price, product.Category.ID).Scan(&product.ID)
+	if product.Price.Currency == "" {
+		product.Price.Currency = entities.DefaultCurrency
+	}
+
+	query = `
+		INSERT INTO products (name, description, price_cents, currency, category_id, track_stock, stock_quantity, low_stock_threshold,
+			allergens, dietary_tags, sku)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, NULLIF($11, ''))
+		RETURNING id
+	`
+	err = tx.QueryRow(ctx, query, product.Name, product.Description, product.Price.Cents, product.Price.Currency, product.Category.ID,
+		product.Stock.Tracked, product.Stock.Quantity, product.Stock.LowStockThreshold,
+		fromAllergens(product.Allergens), fromDietaryTags(product.DietaryTags), product.SKU).Scan(&product.ID)
+	if isUniqueViolation(err) {
+		err = domainError.NewEntityNotProcessableError("product", "sku "+product.SKU+" is already in use")
+		return entities.Product{}, err
+	} else if err != nil {
+		return entities.Product{}, err
+	}
+
+	if product.Nutrition != nil {
+		if err = saveProductNutrition(ctx, tx, product.ID, *product.Nutrition); err != nil {
+			return entities.Product{}, err
+		}
+	}
+
+	_, err = tx.Exec(ctx, recordPriceChangeQuery, product.ID, changedBy)
 	if err != nil {
 		return entities.Product{}, err
 	}
@@ -295,18 +463,29 @@ func getOneProductWithExecutor(ctx context.Context, executor interface{}, id int
 	query := `
 		SELECT 
 			p.id, 
+            p.sku,
             p.name, 
             p.description, 
-            p.price, 
+            p.price_cents, 
+            p.currency, 
 			p.created_at,
 			p.updated_at,
+			p.track_stock,
+			p.stock_quantity,
+			p.low_stock_threshold,
+			p.ingredients_available,
+			p.allergens,
+			p.dietary_tags,
             c.id AS category_id, 
             c.name AS category_name,
 			c.handle AS category_handle,
+			c.active AS category_active,
 			c.created_at AS category_created_at,
 			c.updated_at AS category_updated_at,
             pi.id AS image_id, 
             pi.image,
+            pi.storage_key AS image_storage_key,
+            pi.thumbnails AS image_thumbnails,
 			pi.created_at AS image_created_at,
 			pi.updated_at AS image_updated_at
 		FROM products p
@@ -335,49 +514,77 @@ func getOneProductWithExecutor(ctx context.Context, executor interface{}, id int
 	var result_product entities.Product
 	for rows.Next() {
 		var product entities.Product
+		var allergens, dietaryTags []string
 		var imageID sql.NullInt64
 		var imageURL sql.NullString
+		var imageStorageKey sql.NullString
+		var imageThumbnails []byte
 		var imageCreatedAt sql.NullTime
 		var imageUpdatedAt sql.NullTime
 
 		err = rows.Scan(
 			&product.ID,
+			&product.SKU,
 			&product.Name,
 			&product.Description,
-			&product.Price,
+			&product.Price.Cents,
+			&product.Price.Currency,
 			&product.CreatedAt,
 			&product.UpdatedAt,
+			&product.Stock.Tracked,
+			&product.Stock.Quantity,
+			&product.Stock.LowStockThreshold,
+			&product.IngredientsAvailable,
+			&allergens,
+			&dietaryTags,
 			&product.Category.ID,
 			&product.Category.Name,
 			&product.Category.Handle,
+			&product.Category.Active,
 			&product.Category.CreatedAt,
 			&product.Category.UpdatedAt,
 			&imageID,
 			&imageURL,
+			&imageStorageKey,
+			&imageThumbnails,
 			&imageCreatedAt,
 			&imageUpdatedAt,
 		)
 		if err != nil {
 			return entities.Product{}, err
 		}
+		product.Allergens = toAllergens(allergens)
+		product.DietaryTags = toDietaryTags(dietaryTags)
 
 		if result_product.ID == 0 {
 			result_product = product
 		}
 
 		if imageID.Valid && imageURL.Valid {
-			result_product.Images = append(result_product.Images, entities.ProductImage{
-				ID:        int(imageID.Int64),
-				ImageURL:  imageURL.String,
-				CreatedAt: imageCreatedAt.Time,
-				UpdatedAt: imageUpdatedAt.Time,
-			})
+			result_product.Images = append(result_product.Images, newProductImage(imageID, imageURL, imageStorageKey, imageThumbnails, imageCreatedAt, imageUpdatedAt))
 		}
 	}
 
 	if err = rows.Err(); err != nil {
 		return entities.Product{}, err
 	}
+	rows.Close()
+
+	if result_product.ID != 0 {
+		products := []entities.Product{result_product}
+		if err := loadProductAvailability(ctx, executor.(productQuerier), products); err != nil {
+			return entities.Product{}, err
+		}
+
+		if err := loadProductNutrition(ctx, executor.(productQuerier), products); err != nil {
+			return entities.Product{}, err
+		}
+
+		if err := loadProductVariants(ctx, executor.(productQuerier), products); err != nil {
+			return entities.Product{}, err
+		}
+		result_product = products[0]
+	}
 
 	return result_product, nil
 }
@@ -393,72 +600,143 @@ func (r *productRepository) GetAll(ctx context.Context, filter *ports.ProductFil
 
 	offset := (filter.Page - 1) * filter.PageSize
 
-	categoryFilter := ""
+	// Filters are applied before paginating, so every page is full and total counts the same products
+	var conditions []string
+	switch {
+	case filter.OnlyDeleted:
+		conditions = append(conditions, "p.deleted_at IS NOT NULL")
+	case !filter.IncludeDeleted:
+		conditions = append(conditions, "p.deleted_at IS NULL")
+	}
+	if !filter.IncludeInactiveCategories {
+		conditions = append(conditions, "c.active")
+	}
+	args := []any{}
 	if filter.Category != "" {
-		categoryFilter = fmt.Sprintf("AND LOWER(c.handle) = LOWER('%s')", filter.Category)
+		args = append(args, filter.Category)
+		conditions = append(conditions, fmt.Sprintf("LOWER(c.handle) = LOWER($%d)", len(args)))
+	}
+
+	if filter.AvailableAt != nil {
+		args = append(args, availableAtArgs(*filter.AvailableAt)...)
+		conditions = append(conditions, availableAtCondition(len(args)-2, len(args)-1, len(args)))
+	}
+
+	if len(filter.ExcludeAllergens) > 0 {
+		args = append(args, filter.ExcludeAllergens)
+		conditions = append(conditions, fmt.Sprintf("NOT (p.allergens && $%d::text[])", len(args)))
 	}
 
-	query := fmt.Sprintf(`
+	if len(filter.DietaryTags) > 0 {
+		args = append(args, filter.DietaryTags)
+		conditions = append(conditions, fmt.Sprintf("p.dietary_tags @> $%d::text[]", len(args)))
+	}
+
+	// Products match the Portuguese full-text query, or a name close enough to tolerate typos.
+	// Full-text matches rank first, then the closest names.
+	rank, similarity := "0", "0"
+	if filter.Query != "" {
+		args = append(args, filter.Query)
+		tsQuery := fmt.Sprintf("websearch_to_tsquery('portuguese_unaccent', $%d)", len(args))
+		term := fmt.Sprintf("immutable_unaccent(LOWER($%d))", len(args))
+		conditions = append(conditions, "(p.search_vector @@ "+tsQuery+" OR "+term+" <% immutable_unaccent(LOWER(p.name)))")
+		rank = "ts_rank_cd(p.search_vector, " + tsQuery + ")"
+		similarity = "word_similarity(" + term + ", immutable_unaccent(LOWER(p.name)))"
+	}
+	where := "TRUE"
+	if len(conditions) > 0 {
+		where = strings.Join(conditions, " AND ")
+	}
+
+	query := `
 			WITH paginated_products AS (
-				SELECT DISTINCT ON (p.id) p.id
+				SELECT p.id, ` + rank + ` AS rank, ` + similarity + ` AS similarity
 				FROM products p
-				WHERE p.deleted_at IS NULL
-				ORDER BY p.id
-				LIMIT $1 OFFSET $2
+				LEFT JOIN categories c ON p.category_id = c.id
+				WHERE ` + where + `
+				ORDER BY rank DESC, similarity DESC, p.id
+				` + fmt.Sprintf("LIMIT $%d OFFSET $%d", len(args)+1, len(args)+2) + `
 			)
 			SELECT 
 				p.id, 
+				p.sku,
 				p.name, 
 				p.description, 
-				p.price, 
+				p.price_cents, 
+				p.currency, 
 				p.created_at,
 				p.updated_at,
+				p.track_stock,
+				p.stock_quantity,
+				p.low_stock_threshold,
+				p.ingredients_available,
+				p.allergens,
+				p.dietary_tags,
+				p.deleted_at,
 				c.id AS category_id, 
 				c.name AS category_name,
+				c.handle AS category_handle,
+				c.active AS category_active,
 				c.created_at AS category_created_at,
 				c.updated_at AS category_updated_at,
 				pi.id AS image_id, 
 				pi.image,
+				pi.storage_key AS image_storage_key,
+				pi.thumbnails AS image_thumbnails,
 				pi.created_at AS image_created_at,
 				pi.updated_at AS image_updated_at
 			FROM paginated_products pp
 			JOIN products p ON pp.id = p.id
 			LEFT JOIN categories c ON p.category_id = c.id
-			LEFT JOIN products_images pi ON p.id = pi.product_id
-			WHERE p.deleted_at IS NULL
-			%s
-			ORDER BY p.id
-		`, categoryFilter)
+			LEFT JOIN products_images pi ON p.id = pi.product_id AND (pi.deleted_at IS NULL OR pi.deleted_at = p.deleted_at)
+			ORDER BY pp.rank DESC, pp.similarity DESC, p.id
+		`
 
-	rows, err := r.db.Query(ctx, query, filter.PageSize, offset)
+	rows, err := r.db.Query(ctx, query, append(args, filter.PageSize, offset)...)
 	if err != nil {
 		return nil, 0, err
 	}
 	defer rows.Close()
 
-	productMap := make(map[int]*entities.Product)
+	productIndex := make(map[int]int)
 	var products []entities.Product
 
 	for rows.Next() {
 		var product entities.Product
+		var allergens, dietaryTags []string
 		var imageID sql.NullInt64
 		var imageURL sql.NullString
+		var imageStorageKey sql.NullString
+		var imageThumbnails []byte
 		var imageCreatedAt sql.NullTime
 		var imageUpdatedAt sql.NullTime
 
 		err = rows.Scan(
 			&product.ID,
+			&product.SKU,
 			&product.Name,
 			&product.Description,
-			&product.Price,
+			&product.Price.Cents,
+			&product.Price.Currency,
 			&product.CreatedAt,
 			&product.UpdatedAt,
+			&product.Stock.Tracked,
+			&product.Stock.Quantity,
+			&product.Stock.LowStockThreshold,
+			&product.IngredientsAvailable,
+			&allergens,
+			&dietaryTags,
+			&product.DeletedAt,
 			&product.Category.ID,
 			&product.Category.Name,
+			&product.Category.Handle,
+			&product.Category.Active,
 			&product.Category.CreatedAt,
 			&product.Category.UpdatedAt,
 			&imageID,
 			&imageURL,
+			&imageStorageKey,
+			&imageThumbnails,
 			&imageCreatedAt,
 			&imageUpdatedAt,
 		)
@@ -466,40 +744,176 @@ func (r *productRepository) GetAll(ctx context.Context, filter *ports.ProductFil
 		if err != nil {
 			return nil, 0, err
 		}
-
-		if existingProduct, ok := productMap[product.ID]; ok {
-			if imageID.Valid && imageURL.Valid {
-				existingProduct.Images = append(existingProduct.Images, entities.ProductImage{
-					ID:        int(imageID.Int64),
-					ImageURL:  imageURL.String,
-					CreatedAt: imageCreatedAt.Time,
-					UpdatedAt: imageUpdatedAt.Time,
-				})
-			}
-		} else {
-			if imageID.Valid && imageURL.Valid {
-				product.Images = []entities.ProductImage{{
-					ID:        int(imageID.Int64),
-					ImageURL:  imageURL.String,
-					CreatedAt: imageCreatedAt.Time,
-					UpdatedAt: imageUpdatedAt.Time,
-				}}
-			}
-			productMap[product.ID] = &product
+		product.Allergens = toAllergens(allergens)
+		product.DietaryTags = toDietaryTags(dietaryTags)
+
+		// Products repeat once per image, the index keeps appending them to the same product
+		index, ok := productIndex[product.ID]
+		if !ok {
+			index = len(products)
+			productIndex[product.ID] = index
 			products = append(products, product)
 		}
+
+		if imageID.Valid && imageURL.Valid {
+			products[index].Images = append(products[index].Images, newProductImage(imageID, imageURL, imageStorageKey, imageThumbnails, imageCreatedAt, imageUpdatedAt))
+		}
 	}
 
 	if err = rows.Err(); err != nil {
 		return nil, 0, err
 	}
 
+	if err := loadProductAvailability(ctx, r.db, products); err != nil {
+		return nil, 0, err
+	}
+
+	if err := loadProductNutrition(ctx, r.db, products); err != nil {
+		return nil, 0, err
+	}
+
+	if err := loadProductVariants(ctx, r.db, products); err != nil {
+		return nil, 0, err
+	}
+
 	var totalCount int
-	countQuery := `SELECT COUNT(DISTINCT id) FROM products`
-	err = r.db.QueryRow(ctx, countQuery).Scan(&totalCount)
+	countQuery := `SELECT COUNT(*) FROM products p LEFT JOIN categories c ON p.category_id = c.id WHERE ` + where
+	err = r.db.QueryRow(ctx, countQuery, args...).Scan(&totalCount)
 	if err != nil {
 		return nil, 0, err
 	}
 
 	return products, totalCount, nil
 }
+
+func newProductImage(id sql.NullInt64, url sql.NullString, storageKey sql.NullString, thumbnails []byte, createdAt sql.NullTime, updatedAt sql.NullTime) entities.ProductImage {
+	image := entities.ProductImage{
+		ID:         int(id.Int64),
+		ImageURL:   url.String,
+		StorageKey: storageKey.String,
+		CreatedAt:  createdAt.Time,
+		UpdatedAt:  updatedAt.Time,
+	}
+
+	if len(thumbnails) > 0 {
+		if err := json.Unmarshal(thumbnails, &image.Thumbnails); err != nil {
+			slog.Error("Invalid product image thumbnails", "image_id", image.ID, "error", err)
+		}
+	}
+
+	return image
+}
+
+// AddImage stores an uploaded image, after its files were written to the storage.
+func (r *productRepository) AddImage(ctx context.Context, productID int, image entities.ProductImage) (entities.ProductImage, error) {
+	thumbnails, err := json.Marshal(image.Thumbnails)
+	if err != nil {
+		return entities.ProductImage{}, err
+	}
+
+	query := `
+		INSERT INTO products_images (product_id, image, storage_key, content_type, thumbnails)
+		SELECT id, $2, $3, $4, $5 FROM products WHERE id = $1 AND deleted_at IS NULL
+		RETURNING id, created_at, updated_at
+	`
+	err = r.db.QueryRow(ctx, query, productID, image.ImageURL, image.StorageKey, image.ContentType, thumbnails).
+		Scan(&image.ID, &image.CreatedAt, &image.UpdatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return entities.ProductImage{}, domainError.ErrNotFound("product")
+	} else if err != nil {
+		return entities.ProductImage{}, err
+	}
+
+	return image, nil
+}
+
+func (r *productRepository) GetImage(ctx context.Context, productID int, imageID int) (entities.ProductImage, error) {
+	query := `
+		SELECT id, image, storage_key, content_type, thumbnails, created_at, updated_at
+		FROM products_images
+		WHERE id = $1 AND product_id = $2 AND deleted_at IS NULL
+	`
+	var image entities.ProductImage
+	var storageKey, contentType sql.NullString
+	var thumbnails []byte
+	err := r.db.QueryRow(ctx, query, imageID, productID).
+		Scan(&image.ID, &image.ImageURL, &storageKey, &contentType, &thumbnails, &image.CreatedAt, &image.UpdatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return entities.ProductImage{}, domainError.ErrNotFound("product image")
+	} else if err != nil {
+		return entities.ProductImage{}, err
+	}
+
+	image.StorageKey = storageKey.String
+	image.ContentType = contentType.String
+	if err := json.Unmarshal(thumbnails, &image.Thumbnails); err != nil {
+		return entities.ProductImage{}, err
+	}
+
+	return image, nil
+}
+
+func (r *productRepository) DeleteImage(ctx context.Context, productID int, imageID int) error {
+	query := `UPDATE products_images SET deleted_at = NOW() WHERE id = $1 AND product_id = $2 AND deleted_at IS NULL`
+	tag, err := r.db.Exec(ctx, query, imageID, productID)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return domainError.ErrNotFound("product image")
+	}
+
+	return nil
+}
+
+// loadProductAvailability fills the availability windows of the products, their own or, for
+// products without any, those of their category.
+func loadProductAvailability(ctx context.Context, querier productQuerier, products []entities.Product) error {
+	if len(products) == 0 {
+		return nil
+	}
+
+	ids := make([]int, 0, len(products))
+	index := make(map[int]int, len(products))
+	for i, product := range products {
+		ids = append(ids, product.ID)
+		index[product.ID] = i
+	}
+
+	query := `
+		SELECT p.id, w.weekday, ` + availabilityWindowMinutes + `
+		FROM products p
+		JOIN availability_windows w ON w.product_id = p.id
+			OR (w.category_id = p.category_id AND NOT EXISTS (
+				SELECT 1 FROM availability_windows own WHERE own.product_id = p.id
+			))
+		WHERE p.id = ANY($1)
+		ORDER BY p.id, w.weekday, w.start_time
+	`
+	rows, err := querier.Query(ctx, query, ids)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var productID, weekday int
+		var window entities.AvailabilityWindow
+		if err := rows.Scan(&productID, &weekday, &window.StartMinute, &window.EndMinute); err != nil {
+			return err
+		}
+		window.Weekday = time.Weekday(weekday)
+
+		i := index[productID]
+		products[i].Availability = append(products[i].Availability, window)
+	}
+
+	return rows.Err()
+}
+
+// isUniqueViolation tells whether err is a unique constraint violation, like a repeated SKU.
+func isUniqueViolation(err error) bool {
+	var pgErr interface{ SQLState() string }
+	return errors.As(err, &pgErr) && pgErr.SQLState() == "23505"
+}
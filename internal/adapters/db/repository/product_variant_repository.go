@@ -0,0 +1,170 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+	domainError "github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/error"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+)
+
+type productVariantRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewProductVariantRepository(db *pgxpool.Pool) ports.ProductVariantRepository {
+	return &productVariantRepository{db: db}
+}
+
+// Variants are priced in the currency of their product, so a price change of the product to another
+// currency carries them along.
+const productVariantSelect = `
+	SELECT v.id, v.product_id, v.name, v.sku, v.price_cents, p.currency, v.track_stock, v.stock_quantity,
+		v.low_stock_threshold, v.display_order, v.created_at, v.updated_at
+	FROM product_variants v
+	JOIN products p ON p.id = v.product_id
+`
+
+func (r *productVariantRepository) GetByProduct(ctx context.Context, productID int) ([]entities.ProductVariant, error) {
+	var exists bool
+	query := `SELECT EXISTS (SELECT 1 FROM products WHERE id = $1 AND deleted_at IS NULL)`
+	if err := r.db.QueryRow(ctx, query, productID).Scan(&exists); err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		return nil, domainError.ErrNotFound("product")
+	}
+
+	products := []entities.Product{{ID: productID}}
+	if err := loadProductVariants(ctx, r.db, products); err != nil {
+		return nil, err
+	}
+
+	return products[0].Variants, nil
+}
+
+func (r *productVariantRepository) Create(ctx context.Context, variant entities.ProductVariant) (entities.ProductVariant, error) {
+	query := `
+		INSERT INTO product_variants (product_id, name, sku, price_cents, track_stock, stock_quantity, low_stock_threshold, display_order)
+		SELECT id, $2, $3, $4, $5, $6, $7, $8
+		FROM products
+		WHERE id = $1 AND deleted_at IS NULL
+		RETURNING id
+	`
+	err := r.db.QueryRow(ctx, query, variant.ProductID, variant.Name, variant.SKU, variant.Price.Cents,
+		variant.Stock.Tracked, variant.Stock.Quantity, variant.Stock.LowStockThreshold, variant.DisplayOrder).Scan(&variant.ID)
+	if err == pgx.ErrNoRows {
+		return entities.ProductVariant{}, domainError.ErrNotFound("product")
+	} else if err != nil {
+		return entities.ProductVariant{}, variantUniqueViolation(err, variant)
+	}
+
+	return r.getVariant(ctx, variant.ProductID, variant.ID)
+}
+
+func (r *productVariantRepository) Update(ctx context.Context, variant entities.ProductVariant) (entities.ProductVariant, error) {
+	query := `
+		UPDATE product_variants
+		SET name = $3, sku = $4, price_cents = $5, track_stock = $6, stock_quantity = $7, low_stock_threshold = $8, display_order = $9
+		WHERE id = $1 AND product_id = $2 AND deleted_at IS NULL
+	`
+	tag, err := r.db.Exec(ctx, query, variant.ID, variant.ProductID, variant.Name, variant.SKU, variant.Price.Cents,
+		variant.Stock.Tracked, variant.Stock.Quantity, variant.Stock.LowStockThreshold, variant.DisplayOrder)
+	if err != nil {
+		return entities.ProductVariant{}, variantUniqueViolation(err, variant)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return entities.ProductVariant{}, domainError.ErrNotFound("product variant")
+	}
+
+	return r.getVariant(ctx, variant.ProductID, variant.ID)
+}
+
+// Delete soft deletes the variant, order items keep referencing it for their history.
+func (r *productVariantRepository) Delete(ctx context.Context, productID int, variantID int) error {
+	query := `UPDATE product_variants SET deleted_at = NOW() WHERE id = $1 AND product_id = $2 AND deleted_at IS NULL`
+	tag, err := r.db.Exec(ctx, query, variantID, productID)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return domainError.ErrNotFound("product variant")
+	}
+
+	return nil
+}
+
+func (r *productVariantRepository) getVariant(ctx context.Context, productID int, variantID int) (entities.ProductVariant, error) {
+	row := r.db.QueryRow(ctx, productVariantSelect+` WHERE v.id = $1 AND v.product_id = $2 AND v.deleted_at IS NULL`, variantID, productID)
+	variant, err := scanProductVariant(row)
+	if err == pgx.ErrNoRows {
+		return entities.ProductVariant{}, domainError.ErrNotFound("product variant")
+	}
+
+	return variant, err
+}
+
+// loadProductVariants fills the variants of the products, in display order.
+func loadProductVariants(ctx context.Context, querier productQuerier, products []entities.Product) error {
+	if len(products) == 0 {
+		return nil
+	}
+
+	ids := make([]int, 0, len(products))
+	index := make(map[int]int, len(products))
+	for i, product := range products {
+		ids = append(ids, product.ID)
+		index[product.ID] = i
+	}
+
+	// Deleted products keep showing the variants deleted along with them
+	query := productVariantSelect + ` WHERE v.product_id = ANY($1) AND (v.deleted_at IS NULL OR v.deleted_at = p.deleted_at)
+		ORDER BY v.display_order, v.id`
+	rows, err := querier.Query(ctx, query, ids)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		variant, err := scanProductVariant(rows)
+		if err != nil {
+			return err
+		}
+
+		product := &products[index[variant.ProductID]]
+		product.Variants = append(product.Variants, variant)
+	}
+
+	return rows.Err()
+}
+
+func scanProductVariant(row pgx.Row) (entities.ProductVariant, error) {
+	var variant entities.ProductVariant
+	err := row.Scan(&variant.ID, &variant.ProductID, &variant.Name, &variant.SKU, &variant.Price.Cents, &variant.Price.Currency,
+		&variant.Stock.Tracked, &variant.Stock.Quantity, &variant.Stock.LowStockThreshold, &variant.DisplayOrder,
+		&variant.CreatedAt, &variant.UpdatedAt)
+
+	return variant, err
+}
+
+// variantUniqueViolation tells a repeated SKU apart from a name the product already has.
+func variantUniqueViolation(err error, variant entities.ProductVariant) error {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) || pgErr.Code != "23505" {
+		return err
+	}
+
+	if pgErr.ConstraintName == "idx_product_variants_name" {
+		return domainError.NewEntityNotProcessableError("product variant", "the product already has a variant named "+variant.Name)
+	}
+
+	return domainError.NewEntityNotProcessableError("product variant", "sku "+variant.SKU+" is already in use")
+}
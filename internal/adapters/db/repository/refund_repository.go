@@ -0,0 +1,193 @@
+package repository
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+	domainError "github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/error"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+)
+
+type refundRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewRefundRepository(db *pgxpool.Pool) ports.RefundRepository {
+	return &refundRepository{db: db}
+}
+
+// Create stores a pending refund. The payment row is locked while the amount is validated,
+// so concurrent refund requests can never return more than what was captured.
+func (r *refundRepository) Create(ctx context.Context, refund entities.Refund) (entities.Refund, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return entities.Refund{}, err
+	}
+	defer tx.Rollback(ctx)
+
+	var payment entities.Payment
+	query := `
+		SELECT id, order_id, status, amount_cents, refunded_amount_cents, currency
+		FROM payments
+		WHERE id = $1 AND deleted_at IS NULL
+		FOR UPDATE
+	`
+	err = tx.QueryRow(ctx, query, refund.PaymentID).
+		Scan(&payment.ID, &payment.OrderID, &payment.Status, &payment.Amount.Cents, &payment.RefundedAmount.Cents, &payment.Amount.Currency)
+	if err == pgx.ErrNoRows {
+		return entities.Refund{}, domainError.ErrNotFound("payment")
+	} else if err != nil {
+		return entities.Refund{}, err
+	}
+
+	payment.RefundedAmount.Currency = payment.Amount.Currency
+
+	reservedAmount := entities.Money{Currency: payment.Amount.Currency}
+	query = `
+		SELECT COALESCE(SUM(amount_cents), 0)::BIGINT
+		FROM refunds
+		WHERE payment_id = $1 AND status = 'pending' AND deleted_at IS NULL
+	`
+	if err = tx.QueryRow(ctx, query, payment.ID).Scan(&reservedAmount.Cents); err != nil {
+		return entities.Refund{}, err
+	}
+
+	if err = entities.ValidateRefundAmount(payment, refund.Amount, reservedAmount); err != nil {
+		return entities.Refund{}, domainError.NewEntityNotProcessableError("refund", err.Error())
+	}
+
+	refund.OrderID = payment.OrderID
+	refund.Status = entities.RefundStatusPending
+
+	query = `
+		INSERT INTO refunds (payment_id, order_id, amount_cents, reason, status)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, created_at, updated_at
+	`
+	err = tx.QueryRow(ctx, query, refund.PaymentID, refund.OrderID, refund.Amount.Cents, refund.Reason, refund.Status).
+		Scan(&refund.ID, &refund.CreatedAt, &refund.UpdatedAt)
+	if err != nil {
+		return entities.Refund{}, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return entities.Refund{}, err
+	}
+
+	return refund, nil
+}
+
+// Update stores the gateway outcome of a pending refund. Approved refunds are added to the
+// payment refunded_amount_cents in the same transaction, flipping the payment to (partially) refunded.
+func (r *refundRepository) Update(ctx context.Context, refund entities.Refund) (entities.Refund, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return entities.Refund{}, err
+	}
+	defer tx.Rollback(ctx)
+
+	current, err := scanRefund(tx.QueryRow(ctx, refundSelect+` WHERE r.id = $1 AND r.deleted_at IS NULL FOR UPDATE OF r`, refund.ID))
+	if err != nil {
+		return entities.Refund{}, err
+	}
+
+	if current.Status != entities.RefundStatusPending {
+		return entities.Refund{}, domainError.NewEntityNotProcessableError("refund", "refund already settled as "+string(current.Status))
+	}
+
+	if refund.ExternalReference == "" {
+		refund.ExternalReference = current.ExternalReference
+	}
+
+	query := `
+		UPDATE refunds
+		SET status = $2, external_reference = $3
+		WHERE id = $1
+	`
+	if _, err = tx.Exec(ctx, query, current.ID, refund.Status, refund.ExternalReference); err != nil {
+		return entities.Refund{}, err
+	}
+
+	if refund.Status == entities.RefundStatusApproved {
+		query = `
+			UPDATE payments
+			SET refunded_amount_cents = refunded_amount_cents + $2,
+			    status = CASE WHEN refunded_amount_cents + $2 >= amount_cents THEN 'refunded' ELSE 'partially_refunded' END,
+			    updated_at = NOW()
+			WHERE id = $1
+		`
+		if _, err = tx.Exec(ctx, query, current.PaymentID, current.Amount.Cents); err != nil {
+			return entities.Refund{}, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return entities.Refund{}, err
+	}
+
+	slog.Info("Refund updated", "refund_id", current.ID, "status", refund.Status)
+
+	current.Status = refund.Status
+	current.ExternalReference = refund.ExternalReference
+
+	return current, nil
+}
+
+func (r *refundRepository) GetByExternalReference(ctx context.Context, externalReference string) (entities.Refund, error) {
+	return scanRefund(r.db.QueryRow(ctx, refundSelect+` WHERE r.external_reference = $1 AND r.deleted_at IS NULL`, externalReference))
+}
+
+func (r *refundRepository) GetByOrderID(ctx context.Context, orderID int) ([]entities.Refund, error) {
+	rows, err := r.db.Query(ctx, refundSelect+` WHERE r.order_id = $1 AND r.deleted_at IS NULL ORDER BY r.id`, orderID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	refunds := make([]entities.Refund, 0)
+	for rows.Next() {
+		refund, err := scanRefund(rows)
+		if err != nil {
+			return nil, err
+		}
+		refunds = append(refunds, refund)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return refunds, nil
+}
+
+const refundSelect = `
+	SELECT r.id, r.payment_id, r.order_id, r.amount_cents, p.currency, COALESCE(r.reason, ''), r.status, COALESCE(r.external_reference, ''), r.created_at, r.updated_at
+	FROM refunds r
+	JOIN payments p ON p.id = r.payment_id
+`
+
+func scanRefund(row pgx.Row) (entities.Refund, error) {
+	var refund entities.Refund
+	err := row.Scan(
+		&refund.ID,
+		&refund.PaymentID,
+		&refund.OrderID,
+		&refund.Amount.Cents,
+		&refund.Amount.Currency,
+		&refund.Reason,
+		&refund.Status,
+		&refund.ExternalReference,
+		&refund.CreatedAt,
+		&refund.UpdatedAt,
+	)
+	if err == pgx.ErrNoRows {
+		return entities.Refund{}, domainError.ErrNotFound("refund")
+	} else if err != nil {
+		return entities.Refund{}, err
+	}
+
+	return refund, nil
+}
@@ -1,7 +1,13 @@
 package gateways
 
-import "github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+import (
+	"context"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+)
 
 type PaymentGateway interface {
-	Authorize(payment *entities.Payment) error
+	Authorize(ctx context.Context, payment *entities.Payment) error
+	Refund(ctx context.Context, payment *entities.Payment, refund *entities.Refund) error
+	GetStatus(ctx context.Context, payment *entities.Payment) (entities.PaymentStatus, error)
 }
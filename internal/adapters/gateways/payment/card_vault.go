@@ -0,0 +1,72 @@
+package gateways
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+)
+
+// cardTokenTTL bounds how long an unused token is kept, checkout authorizes right after tokenizing.
+const cardTokenTTL = 10 * time.Minute
+
+type vaultedCard struct {
+	scenario  cardScenario
+	createdAt time.Time
+}
+
+// MockCardVault stands in for the acquirer's card vault. Tokens are single use and live in
+// memory: the card number is reduced to its test scenario when tokenized and then discarded.
+type MockCardVault struct {
+	mu    sync.Mutex
+	cards map[string]vaultedCard
+}
+
+func NewMockCardVault() *MockCardVault {
+	return &MockCardVault{cards: make(map[string]vaultedCard)}
+}
+
+// NewCardTokenizer exposes the vault to the use cases, which only ever see tokens.
+func NewCardTokenizer(vault *MockCardVault) ports.CardTokenizer {
+	return vault
+}
+
+func (v *MockCardVault) Tokenize(ctx context.Context, card entities.CardData) (entities.PaymentCard, error) {
+	token := "tok_" + uuid.New().String()
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	for key, vaulted := range v.cards {
+		if time.Since(vaulted.createdAt) > cardTokenTTL {
+			delete(v.cards, key)
+		}
+	}
+	v.cards[token] = vaultedCard{scenario: testCardScenario(card.NormalizedNumber()), createdAt: time.Now()}
+
+	return entities.PaymentCard{Token: token, Brand: card.Brand(), Last4: card.Last4()}, nil
+}
+
+// scenario returns the test scenario of a token that has not expired yet.
+func (v *MockCardVault) scenario(token string) (cardScenario, bool) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	vaulted, ok := v.cards[token]
+	if !ok || time.Since(vaulted.createdAt) > cardTokenTTL {
+		return "", false
+	}
+
+	return vaulted.scenario, true
+}
+
+// consume forgets the token once the acquirer answered, a token is good for a single authorization.
+func (v *MockCardVault) consume(token string) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	delete(v.cards, token)
+}
@@ -1,18 +1,91 @@
 package gateways
 
 import (
+	"context"
+
 	"github.com/google/uuid"
 	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+	domainError "github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/error"
 )
 
-type creditCardMock struct{}
+type cardScenario string
+
+const (
+	cardScenarioApprove           cardScenario = "approve"
+	cardScenarioInsufficientFunds cardScenario = "insufficient_funds"
+	cardScenarioExpiredCard       cardScenario = "expired_card"
+	cardScenarioSuspectedFraud    cardScenario = "suspected_fraud"
+	cardScenarioTimeout           cardScenario = "timeout"
+)
+
+// Test card numbers with a deterministic outcome, any other valid card is approved.
+var testCardScenarios = map[string]cardScenario{
+	"4000000000009995": cardScenarioInsufficientFunds,
+	"4000000000000069": cardScenarioExpiredCard,
+	"4100000000000019": cardScenarioSuspectedFraud,
+	"4000000000000119": cardScenarioTimeout,
+}
+
+var declineReasons = map[cardScenario]string{
+	cardScenarioInsufficientFunds: "Saldo insuficiente. Tente outro cartão ou forma de pagamento.",
+	cardScenarioExpiredCard:       "Cartão vencido. Confira a validade ou use outro cartão.",
+	cardScenarioSuspectedFraud:    "Pagamento recusado pelo emissor. Entre em contato com o banco do cartão.",
+}
+
+const invalidCardCode = "invalid_card"
+
+func testCardScenario(number string) cardScenario {
+	if scenario, ok := testCardScenarios[number]; ok {
+		return scenario
+	}
+
+	return cardScenarioApprove
+}
+
+type creditCardMock struct {
+	vault *MockCardVault
+}
+
+func NewCreditCardMockGateway(vault *MockCardVault) PaymentGateway {
+	return &creditCardMock{vault: vault}
+}
 
-func NewCreditCardMockGateway() PaymentGateway {
-	return &creditCardMock{}
+func (g *creditCardMock) Authorize(ctx context.Context, payment *entities.Payment) error {
+	if payment.Card == nil {
+		return domainError.NewPaymentDeclinedError(invalidCardCode, "Cartão não informado.")
+	}
+
+	scenario, ok := g.vault.scenario(payment.Card.Token)
+	if !ok {
+		return domainError.NewPaymentDeclinedError(invalidCardCode, "Cartão inválido ou expirado. Informe os dados do cartão novamente.")
+	}
+
+	// The acquirer never answers, the call only ends when the caller gives up. The token is kept,
+	// so retries time out as well.
+	if scenario == cardScenarioTimeout {
+		<-ctx.Done()
+		return ctx.Err()
+	}
+	g.vault.consume(payment.Card.Token)
+
+	switch scenario {
+	case cardScenarioApprove:
+		payment.ExternalReference = uuid.New().String()
+		return nil
+	default:
+		return domainError.NewPaymentDeclinedError(string(scenario), declineReasons[scenario])
+	}
 }
 
-func (g *creditCardMock) Authorize(payment *entities.Payment) error {
-	payment.ExternalReference = uuid.New().String()
+// Refund settles card refunds synchronously, the acquirer confirms them in the same call.
+func (g *creditCardMock) Refund(ctx context.Context, payment *entities.Payment, refund *entities.Refund) error {
+	refund.ExternalReference = uuid.New().String()
+	refund.Status = entities.RefundStatusApproved
 
 	return nil
 }
+
+// GetStatus reports card payments as approved, the mock captures every authorization it accepts.
+func (g *creditCardMock) GetStatus(ctx context.Context, payment *entities.Payment) (entities.PaymentStatus, error) {
+	return entities.PaymentStatusApproved, nil
+}
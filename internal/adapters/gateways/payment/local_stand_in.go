@@ -0,0 +1,71 @@
+package gateways
+
+import (
+	"context"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+)
+
+// LocalStandInGateway is an in-memory gateway used to exercise the payment flows offline.
+// Statuses default to pending and can be driven with SetStatus, SetError makes status queries fail.
+type LocalStandInGateway struct {
+	mu       sync.RWMutex
+	statuses map[string]entities.PaymentStatus
+	errors   map[string]error
+}
+
+func NewLocalStandInGateway() *LocalStandInGateway {
+	return &LocalStandInGateway{
+		statuses: make(map[string]entities.PaymentStatus),
+		errors:   make(map[string]error),
+	}
+}
+
+func (g *LocalStandInGateway) Authorize(ctx context.Context, payment *entities.Payment) error {
+	payment.ExternalReference = uuid.New().String()
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.statuses[payment.ExternalReference] = entities.PaymentStatusPending
+
+	return nil
+}
+
+func (g *LocalStandInGateway) Refund(ctx context.Context, payment *entities.Payment, refund *entities.Refund) error {
+	refund.ExternalReference = uuid.New().String()
+	refund.Status = entities.RefundStatusApproved
+
+	return nil
+}
+
+func (g *LocalStandInGateway) GetStatus(ctx context.Context, payment *entities.Payment) (entities.PaymentStatus, error) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	if err := g.errors[payment.ExternalReference]; err != nil {
+		return "", err
+	}
+
+	if status, ok := g.statuses[payment.ExternalReference]; ok {
+		return status, nil
+	}
+
+	return entities.PaymentStatusPending, nil
+}
+
+// SetStatus overrides the status the gateway reports for an external reference.
+func (g *LocalStandInGateway) SetStatus(externalReference string, status entities.PaymentStatus) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.statuses[externalReference] = status
+}
+
+// SetError makes the status queries of an external reference fail with err, like an unreachable
+// gateway. A nil err clears it.
+func (g *LocalStandInGateway) SetError(externalReference string, err error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.errors[externalReference] = err
+}
@@ -13,6 +13,14 @@ import (
 
 const QrCodeTTL = 10 * time.Minute
 
+// qrCodeStatusKeyPrefix holds the status of QR code payments settled in the sandbox, reported by
+// the mock like Mercado Pago does before its webhook arrives. It outlives the QR code, so the
+// reconciler still finds it when the webhook is lost.
+const (
+	qrCodeStatusKeyPrefix = "qrcode:status:"
+	qrCodeStatusTTL       = 24 * time.Hour
+)
+
 type mercadoPagoQRCodeMock struct {
 	redisClient *redis.Client
 }
@@ -23,10 +31,9 @@ func NewQRCodePaymentGateway(redisClient *redis.Client) PaymentGateway {
 	}
 }
 
-func (g *mercadoPagoQRCodeMock) Authorize(payment *entities.Payment) error {
+func (g *mercadoPagoQRCodeMock) Authorize(ctx context.Context, payment *entities.Payment) error {
 	payment.ExternalReference = uuid.New().String()
 
-	ctx := context.Background()
 	redisKey := "qrcode:" + payment.ExternalReference
 
 	cachedQR, err := g.redisClient.Get(ctx, redisKey).Result()
@@ -47,3 +54,33 @@ func (g *mercadoPagoQRCodeMock) Authorize(payment *entities.Payment) error {
 
 	return nil
 }
+
+// Refund only registers the refund request, Mercado Pago confirms it later through the refunds webhook.
+func (g *mercadoPagoQRCodeMock) Refund(ctx context.Context, payment *entities.Payment, refund *entities.Refund) error {
+	refund.ExternalReference = uuid.New().String()
+	refund.Status = entities.RefundStatusPending
+
+	return nil
+}
+
+// GetStatus returns the status recorded for the QR code payment. Without a recorded status the
+// payment is pending while its QR code is still valid and failed once the code has expired.
+func (g *mercadoPagoQRCodeMock) GetStatus(ctx context.Context, payment *entities.Payment) (entities.PaymentStatus, error) {
+	status, err := g.redisClient.Get(ctx, qrCodeStatusKeyPrefix+payment.ExternalReference).Result()
+	if err == nil {
+		return entities.PaymentStatus(status), nil
+	} else if err != redis.Nil {
+		return "", err
+	}
+
+	exists, err := g.redisClient.Exists(ctx, "qrcode:"+payment.ExternalReference).Result()
+	if err != nil {
+		return "", err
+	}
+
+	if exists > 0 {
+		return entities.PaymentStatusPending, nil
+	}
+
+	return entities.PaymentStatusFailed, nil
+}
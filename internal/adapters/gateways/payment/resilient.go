@@ -0,0 +1,130 @@
+package gateways
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"time"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/config"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+	domainError "github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/error"
+)
+
+type resilientGateway struct {
+	gateway        PaymentGateway
+	breaker        *CircuitBreaker
+	timeout        time.Duration
+	maxAttempts    int
+	retryBaseDelay time.Duration
+}
+
+// NewResilientGateway wraps gateway calls with a per-call timeout, bounded retries with jittered
+// exponential backoff and the circuit breaker shared by every call to the same gateway. Calls
+// rejected by an open breaker or still failing after the last attempt return a
+// ServiceUnavailableError, so callers can fail fast instead of waiting on the gateway. Declined
+// payments are returned as they are.
+func NewResilientGateway(gateway PaymentGateway, breaker *CircuitBreaker, cfg config.PaymentGateway) PaymentGateway {
+	return &resilientGateway{
+		gateway:        gateway,
+		breaker:        breaker,
+		timeout:        cfg.Timeout,
+		maxAttempts:    max(cfg.MaxAttempts, 1),
+		retryBaseDelay: cfg.RetryBaseDelay,
+	}
+}
+
+func (g *resilientGateway) Authorize(ctx context.Context, payment *entities.Payment) error {
+	return g.call(ctx, g.maxAttempts, func(ctx context.Context) error {
+		return g.gateway.Authorize(ctx, payment)
+	})
+}
+
+// Refund is attempted once: a refund that timed out may still be processed by the gateway, and
+// sending it again could return the money twice.
+func (g *resilientGateway) Refund(ctx context.Context, payment *entities.Payment, refund *entities.Refund) error {
+	return g.call(ctx, 1, func(ctx context.Context) error {
+		return g.gateway.Refund(ctx, payment, refund)
+	})
+}
+
+func (g *resilientGateway) GetStatus(ctx context.Context, payment *entities.Payment) (entities.PaymentStatus, error) {
+	var status entities.PaymentStatus
+	err := g.call(ctx, g.maxAttempts, func(ctx context.Context) error {
+		var err error
+		status, err = g.gateway.GetStatus(ctx, payment)
+		return err
+	})
+
+	return status, err
+}
+
+func (g *resilientGateway) call(ctx context.Context, attempts int, fn func(ctx context.Context) error) error {
+	var err error
+	for attempt := 0; attempt < attempts; attempt++ {
+		if attempt > 0 {
+			if waitErr := sleep(ctx, g.backoff(attempt)); waitErr != nil {
+				return waitErr
+			}
+		}
+
+		if !g.breaker.Allow() {
+			return domainError.NewServiceUnavailableError("payment gateway", "circuit breaker is open, try again later")
+		}
+
+		err = g.attempt(ctx, fn)
+		if err == nil {
+			g.breaker.Success()
+			return nil
+		}
+
+		// A declined payment is a valid answer from a healthy gateway, trying again would not change it
+		if errors.Is(err, &domainError.PaymentDeclinedError{}) {
+			g.breaker.Success()
+			return err
+		}
+
+		// The caller gave up, which says nothing about the gateway health and leaves nobody to retry for
+		if ctx.Err() != nil {
+			g.breaker.Abort()
+			return ctx.Err()
+		}
+		g.breaker.Failure()
+	}
+
+	return domainError.NewServiceUnavailableError("payment gateway", fmt.Sprintf("failed after %d attempts: %s", attempts, err))
+}
+
+func (g *resilientGateway) attempt(ctx context.Context, fn func(ctx context.Context) error) error {
+	if g.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, g.timeout)
+		defer cancel()
+	}
+
+	return fn(ctx)
+}
+
+// backoff returns a random delay up to retryBaseDelay * 2^(attempt-1), the full jitter spreads
+// the retries of concurrent totems instead of having them hit the gateway in lockstep.
+func (g *resilientGateway) backoff(attempt int) time.Duration {
+	ceiling := g.retryBaseDelay << (attempt - 1)
+	if ceiling <= 0 {
+		return 0
+	}
+
+	return rand.N(ceiling)
+}
+
+func sleep(ctx context.Context, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
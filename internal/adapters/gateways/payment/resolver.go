@@ -0,0 +1,72 @@
+package gateways
+
+import (
+	"errors"
+	"log/slog"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/config"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+)
+
+const ProviderLocal = "local"
+
+var ErrPaymentMethodNotSupported = errors.New("payment method not supported")
+
+type paymentGatewayResolver struct {
+	redisClient *redis.Client
+	cfg         config.PaymentGateway
+	// Each gateway keeps its breaker across calls, a failing acquirer does not block Pix or QR code.
+	qrCodeBreaker     *CircuitBreaker
+	creditCardBreaker *CircuitBreaker
+	cardVault         *MockCardVault
+}
+
+func NewPaymentGatewayResolver(cfg *config.Config, redisClient *redis.Client, cardVault *MockCardVault) ports.PaymentGatewayResolver {
+	if cfg.PaymentGateway.Provider == ProviderLocal {
+		slog.Warn("Using the local stand-in payment gateway")
+		return NewLocalGatewayResolver(NewLocalStandInGateway(), cfg.PaymentGateway)
+	}
+
+	return &paymentGatewayResolver{
+		redisClient:       redisClient,
+		cfg:               cfg.PaymentGateway,
+		qrCodeBreaker:     newGatewayBreaker("mercado-pago-qr-code", cfg.PaymentGateway),
+		creditCardBreaker: newGatewayBreaker("credit-card", cfg.PaymentGateway),
+		cardVault:         cardVault,
+	}
+}
+
+func (r *paymentGatewayResolver) Resolve(method entities.PaymentMethod) (ports.PaymentGateway, error) {
+	switch method {
+	case entities.PaymentMethodQRCode:
+		return NewResilientGateway(NewQRCodePaymentGateway(r.redisClient), r.qrCodeBreaker, r.cfg), nil
+	case entities.PaymentMethodCreditCard:
+		return NewResilientGateway(NewCreditCardMockGateway(r.cardVault), r.creditCardBreaker, r.cfg), nil
+	default:
+		return nil, ErrPaymentMethodNotSupported
+	}
+}
+
+type localGatewayResolver struct {
+	gateway PaymentGateway
+}
+
+// NewLocalGatewayResolver routes every supported payment method to the same stand-in gateway.
+func NewLocalGatewayResolver(gateway *LocalStandInGateway, cfg config.PaymentGateway) ports.PaymentGatewayResolver {
+	return &localGatewayResolver{gateway: NewResilientGateway(gateway, newGatewayBreaker("local", cfg), cfg)}
+}
+
+func (r *localGatewayResolver) Resolve(method entities.PaymentMethod) (ports.PaymentGateway, error) {
+	switch method {
+	case entities.PaymentMethodQRCode, entities.PaymentMethodCreditCard:
+		return r.gateway, nil
+	default:
+		return nil, ErrPaymentMethodNotSupported
+	}
+}
+
+func newGatewayBreaker(name string, cfg config.PaymentGateway) *CircuitBreaker {
+	return NewCircuitBreaker(name, cfg.BreakerFailureThreshold, cfg.BreakerOpenTimeout)
+}
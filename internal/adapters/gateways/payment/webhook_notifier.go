@@ -0,0 +1,82 @@
+package gateways
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/config"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/shared"
+)
+
+const webhookDeliveryTimeout = 10 * time.Second
+
+type webhookNotification struct {
+	ExternalReference string                 `json:"external_reference"`
+	Status            entities.PaymentStatus `json:"status"`
+	PaymentMethod     entities.PaymentMethod `json:"payment_method"`
+}
+
+type webhookPaymentNotifier struct {
+	client      *http.Client
+	redisClient *redis.Client
+	url         string
+	secret      string
+}
+
+// NewWebhookPaymentNotifier posts notifications to the payment webhook signed with the shared
+// secret, exactly like the gateway would. It backs the development sandbox.
+func NewWebhookPaymentNotifier(cfg *config.Config, redisClient *redis.Client) ports.PaymentNotifier {
+	return &webhookPaymentNotifier{
+		client:      &http.Client{Timeout: webhookDeliveryTimeout},
+		redisClient: redisClient,
+		url:         cfg.Sandbox.WebhookURL,
+		secret:      cfg.Webhook.Secret,
+	}
+}
+
+// Settle records the status of QR code payments where the Mercado Pago mock reports it from. The
+// credit card mock settles on authorization, there is nothing to record for it.
+func (n *webhookPaymentNotifier) Settle(ctx context.Context, externalReference string, method entities.PaymentMethod, status entities.PaymentStatus) error {
+	if method != entities.PaymentMethodQRCode {
+		return nil
+	}
+
+	return n.redisClient.Set(ctx, qrCodeStatusKeyPrefix+externalReference, string(status), qrCodeStatusTTL).Err()
+}
+
+func (n *webhookPaymentNotifier) Notify(ctx context.Context, externalReference string, method entities.PaymentMethod, status entities.PaymentStatus) error {
+	body, err := json.Marshal(webhookNotification{ExternalReference: externalReference, Status: status, PaymentMethod: method})
+	if err != nil {
+		return err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, n.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set(shared.WebhookTimestampHeader, strconv.FormatInt(now.Unix(), 10))
+	request.Header.Set(shared.WebhookSignatureHeader, shared.SignWebhook(n.secret, now, body))
+
+	response, err := n.client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("webhook answered %d", response.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,22 @@
+package handler
+
+import (
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AdminUserHeader names the admin making a change, recorded as its author. The API has no admin
+// authentication yet, so the header is taken as sent.
+const AdminUserHeader = "X-Admin-User"
+
+const maxAdminUserLength = 100
+
+func adminUser(c *gin.Context) string {
+	user := []rune(strings.TrimSpace(c.GetHeader(AdminUserHeader)))
+	if len(user) > maxAdminUserLength {
+		user = user[:maxAdminUserLength]
+	}
+
+	return string(user)
+}
@@ -0,0 +1,182 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	domainError "github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/error"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/validator"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/dto"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/mappers"
+)
+
+type AvailabilityAdminHandler interface {
+	GetProduct(c *gin.Context)
+	UpdateProduct(c *gin.Context)
+	GetCategory(c *gin.Context)
+	UpdateCategory(c *gin.Context)
+}
+
+type availabilityAdminHandler struct {
+	getProductAvailabilityUseCase     usecase.GetProductAvailabilityUseCase
+	updateProductAvailabilityUseCase  usecase.UpdateProductAvailabilityUseCase
+	getCategoryAvailabilityUseCase    usecase.GetCategoryAvailabilityUseCase
+	updateCategoryAvailabilityUseCase usecase.UpdateCategoryAvailabilityUseCase
+}
+
+func NewAvailabilityAdminHandler(getProductAvailabilityUseCase usecase.GetProductAvailabilityUseCase, updateProductAvailabilityUseCase usecase.UpdateProductAvailabilityUseCase, getCategoryAvailabilityUseCase usecase.GetCategoryAvailabilityUseCase, updateCategoryAvailabilityUseCase usecase.UpdateCategoryAvailabilityUseCase) AvailabilityAdminHandler {
+	return &availabilityAdminHandler{
+		getProductAvailabilityUseCase:     getProductAvailabilityUseCase,
+		updateProductAvailabilityUseCase:  updateProductAvailabilityUseCase,
+		getCategoryAvailabilityUseCase:    getCategoryAvailabilityUseCase,
+		updateCategoryAvailabilityUseCase: updateCategoryAvailabilityUseCase,
+	}
+}
+
+// GetProduct godoc
+// @Summary      Obtém os horários de um produto
+// @Description  Lista os horários em que o produto pode ser pedido, no fuso horário da loja. Sem horários próprios o produto segue os da categoria
+// @Tags         availability
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "ID do Produto"
+// @Success      200  {object}  dto.AvailabilityOutput
+// @Failure      400  {object}  handler.ErrorResponse
+// @Failure      404  {object}  handler.ErrorResponse
+// @Failure      500  {object}  handler.ErrorResponse
+// @Router       /admin/products/{id}/availability [get]
+func (h *availabilityAdminHandler) GetProduct(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	schedule, err := h.getProductAvailabilityUseCase.Run(c.Request.Context(), id)
+	if err != nil {
+		respondAvailabilityError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, mappers.ToAvailabilityDTO(schedule))
+}
+
+// UpdateProduct godoc
+// @Summary      Substitui os horários de um produto
+// @Description  Substitui os horários do produto, uma lista vazia faz o produto seguir os horários da categoria. Um horário que termina antes de começar passa da meia-noite, 00:00 a 00:00 cobre o dia todo
+// @Tags         availability
+// @Accept       json
+// @Produce      json
+// @Param        id     path      int                    true  "ID do Produto"
+// @Param        input  body      dto.AvailabilityInput  true  "Horários"
+// @Success      200    {object}  dto.AvailabilityOutput
+// @Failure      400    {object}  handler.ErrorResponse
+// @Failure      404    {object}  handler.ErrorResponse
+// @Failure      500    {object}  handler.ErrorResponse
+// @Router       /admin/products/{id}/availability [put]
+func (h *availabilityAdminHandler) UpdateProduct(c *gin.Context) {
+	id, input, ok := bindAvailabilityInput(c)
+	if !ok {
+		return
+	}
+
+	schedule, err := h.updateProductAvailabilityUseCase.Run(c.Request.Context(), id, input)
+	if err != nil {
+		respondAvailabilityError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, mappers.ToAvailabilityDTO(schedule))
+}
+
+// GetCategory godoc
+// @Summary      Obtém os horários de uma categoria
+// @Description  Lista os horários seguidos pelos produtos da categoria que não têm horários próprios
+// @Tags         availability
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "ID da Categoria"
+// @Success      200  {object}  dto.AvailabilityOutput
+// @Failure      400  {object}  handler.ErrorResponse
+// @Failure      404  {object}  handler.ErrorResponse
+// @Failure      500  {object}  handler.ErrorResponse
+// @Router       /admin/categories/{id}/availability [get]
+func (h *availabilityAdminHandler) GetCategory(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	schedule, err := h.getCategoryAvailabilityUseCase.Run(c.Request.Context(), id)
+	if err != nil {
+		respondAvailabilityError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, mappers.ToAvailabilityDTO(schedule))
+}
+
+// UpdateCategory godoc
+// @Summary      Substitui os horários de uma categoria
+// @Description  Substitui os horários da categoria, uma lista vazia deixa seus produtos sem horários próprios sempre disponíveis
+// @Tags         availability
+// @Accept       json
+// @Produce      json
+// @Param        id     path      int                    true  "ID da Categoria"
+// @Param        input  body      dto.AvailabilityInput  true  "Horários"
+// @Success      200    {object}  dto.AvailabilityOutput
+// @Failure      400    {object}  handler.ErrorResponse
+// @Failure      404    {object}  handler.ErrorResponse
+// @Failure      500    {object}  handler.ErrorResponse
+// @Router       /admin/categories/{id}/availability [put]
+func (h *availabilityAdminHandler) UpdateCategory(c *gin.Context) {
+	id, input, ok := bindAvailabilityInput(c)
+	if !ok {
+		return
+	}
+
+	schedule, err := h.updateCategoryAvailabilityUseCase.Run(c.Request.Context(), id, input)
+	if err != nil {
+		respondAvailabilityError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, mappers.ToAvailabilityDTO(schedule))
+}
+
+func bindAvailabilityInput(c *gin.Context) (int, dto.AvailabilityInput, bool) {
+	var input dto.AvailabilityInput
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return 0, input, false
+	}
+
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return 0, input, false
+	}
+
+	if err := dto.ValidateAvailabilityInput(input); err != nil {
+		errors := validator.HandleValidationError(err)
+		c.JSON(http.StatusBadRequest, gin.H{"errors": errors})
+		return 0, input, false
+	}
+
+	return id, input, true
+}
+
+func respondAvailabilityError(c *gin.Context, err error) {
+	if errors.Is(err, &domainError.NotFoundError{}) {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	} else if errors.Is(err, &domainError.EntityNotProcessableError{}) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	} else {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
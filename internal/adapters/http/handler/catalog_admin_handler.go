@@ -0,0 +1,138 @@
+package handler
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	domainError "github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/error"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/dto"
+)
+
+// maxCatalogBytes limits the size of an imported catalog.
+const maxCatalogBytes = 10 << 20
+
+type CatalogAdminHandler interface {
+	Import(c *gin.Context)
+	Export(c *gin.Context)
+}
+
+type catalogAdminHandler struct {
+	importCatalogUseCase usecase.ImportCatalogUseCase
+	exportCatalogUseCase usecase.ExportCatalogUseCase
+}
+
+func NewCatalogAdminHandler(importCatalogUseCase usecase.ImportCatalogUseCase, exportCatalogUseCase usecase.ExportCatalogUseCase) CatalogAdminHandler {
+	return &catalogAdminHandler{importCatalogUseCase: importCatalogUseCase, exportCatalogUseCase: exportCatalogUseCase}
+}
+
+// Import godoc
+// @Summary      Importa o catálogo de produtos
+// @Description  Cria ou atualiza os produtos pelo SKU, a partir de um JSON (lista de produtos) ou de um CSV com cabeçalho, no formato da exportação. Nada é importado se algum produto tiver erros. Com dry_run=true só valida e informa o que seria criado e atualizado. O estoque dos produtos existentes é mantido
+// @Tags         catalog
+// @Accept       json
+// @Accept       text/csv
+// @Produce      json
+// @Param        input         body      []dto.CatalogProduct     true   "Catálogo"
+// @Param        dry_run       query     bool                     false  "Só valida o catálogo, sem gravar"
+// @Param        X-Admin-User  header    string                   false  "Admin registrado como autor das mudanças de preço"
+// @Success      200           {object}  dto.CatalogImportOutput
+// @Failure      400           {object}  dto.CatalogImportOutput
+// @Failure      404           {object}  handler.ErrorResponse
+// @Failure      413           {object}  handler.ErrorResponse
+// @Failure      500           {object}  handler.ErrorResponse
+// @Router       /admin/products/import [post]
+func (h *catalogAdminHandler) Import(c *gin.Context) {
+	dryRun := false
+	if value := c.Query("dry_run"); value != "" {
+		var err error
+		if dryRun, err = strconv.ParseBool(value); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid dry_run, use true or false"})
+			return
+		}
+	}
+
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxCatalogBytes)
+
+	var catalog []dto.CatalogProduct
+	var err error
+	if strings.HasPrefix(c.ContentType(), "text/csv") {
+		catalog, err = readCatalogCSV(c.Request.Body)
+	} else {
+		err = c.ShouldBindJSON(&catalog)
+	}
+
+	var maxBytesError *http.MaxBytesError
+	if errors.As(err, &maxBytesError) {
+		c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": "The catalog must have at most 10 MB"})
+		return
+	} else if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	output, err := h.importCatalogUseCase.Run(c.Request.Context(), catalog, dryRun, adminUser(c))
+	if err != nil {
+		if errors.Is(err, &domainError.NotFoundError{}) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		} else if errors.Is(err, &domainError.EntityNotProcessableError{}) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	if len(output.Errors) > 0 {
+		c.JSON(http.StatusBadRequest, output)
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+// Export godoc
+// @Summary      Exporta o catálogo de produtos
+// @Description  Lista todos os produtos no formato lido pela importação, para levar o cardápio a outro ambiente. Imagens enviadas são exportadas pela URL deste ambiente
+// @Tags         catalog
+// @Produce      json
+// @Produce      text/csv
+// @Param        format  query     string  false  "json (padrão) ou csv"
+// @Success      200     {array}   dto.CatalogProduct
+// @Failure      400     {object}  handler.ErrorResponse
+// @Failure      500     {object}  handler.ErrorResponse
+// @Router       /admin/products/export [get]
+func (h *catalogAdminHandler) Export(c *gin.Context) {
+	format := strings.ToLower(c.DefaultQuery("format", "json"))
+	if format != "json" && format != "csv" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid format, use json or csv"})
+		return
+	}
+
+	catalog, err := h.exportCatalogUseCase.Run(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Header("Content-Disposition", `attachment; filename="catalog.`+format+`"`)
+	if format == "json" {
+		c.JSON(http.StatusOK, catalog)
+		return
+	}
+
+	// Written to a buffer first, so a failure can still be answered with a 500
+	var body bytes.Buffer
+	if err := writeCatalogCSV(&body, catalog); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Data(http.StatusOK, "text/csv; charset=utf-8", body.Bytes())
+}
@@ -0,0 +1,170 @@
+package handler
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/dto"
+)
+
+// catalogCSVListSeparator separates the images, allergens and dietary tags inside their column.
+const catalogCSVListSeparator = "|"
+
+var catalogCSVHeader = []string{
+	"sku", "name", "description", "category", "price_cents", "currency", "images", "allergens", "dietary_tags",
+	"serving_size_g", "energy_kcal", "carbohydrates_g", "sugars_g", "protein_g", "fat_g", "saturated_fat_g", "fiber_g", "sodium_mg",
+}
+
+var catalogCSVRequiredColumns = []string{"sku", "name", "description", "category", "price_cents"}
+
+// catalogCSVNutritionColumns point each nutrition column at its field, a product with all of them
+// empty has no nutrition facts.
+func catalogCSVNutritionColumns(nutrition *dto.NutritionInput) map[string]*float64 {
+	return map[string]*float64{
+		"serving_size_g":  &nutrition.ServingSizeG,
+		"energy_kcal":     &nutrition.EnergyKcal,
+		"carbohydrates_g": &nutrition.CarbohydratesG,
+		"sugars_g":        &nutrition.SugarsG,
+		"protein_g":       &nutrition.ProteinG,
+		"fat_g":           &nutrition.FatG,
+		"saturated_fat_g": &nutrition.SaturatedFatG,
+		"fiber_g":         &nutrition.FiberG,
+		"sodium_mg":       &nutrition.SodiumMg,
+	}
+}
+
+// readCatalogCSV reads a catalog with a header row, columns can come in any order and the optional
+// ones can be left out.
+func readCatalogCSV(r io.Reader) ([]dto.CatalogProduct, error) {
+	reader := csv.NewReader(r)
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, errors.New("the CSV is empty")
+	} else if err != nil {
+		return nil, err
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, column := range header {
+		// Spreadsheets often save a byte order mark before the first column
+		column = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(column, "\ufeff")))
+		if !slices.Contains(catalogCSVHeader, column) {
+			return nil, fmt.Errorf("unknown column %q", column)
+		}
+		columns[column] = i
+	}
+
+	for _, column := range catalogCSVRequiredColumns {
+		if _, ok := columns[column]; !ok {
+			return nil, fmt.Errorf("missing column %q", column)
+		}
+	}
+
+	catalog := make([]dto.CatalogProduct, 0)
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return catalog, nil
+		} else if err != nil {
+			return nil, err
+		}
+
+		line, _ := reader.FieldPos(0)
+		value := func(column string) string {
+			if i, ok := columns[column]; ok {
+				return strings.TrimSpace(record[i])
+			}
+
+			return ""
+		}
+
+		product := dto.CatalogProduct{
+			SKU:         value("sku"),
+			Name:        value("name"),
+			Description: value("description"),
+			Category:    value("category"),
+			Currency:    value("currency"),
+			Images:      splitCatalogCSVList(value("images")),
+			Allergens:   splitCatalogCSVList(value("allergens")),
+			DietaryTags: splitCatalogCSVList(value("dietary_tags")),
+		}
+
+		if product.PriceCents, err = strconv.ParseInt(value("price_cents"), 10, 64); err != nil {
+			return nil, fmt.Errorf("line %d: price_cents must be an integer amount of cents", line)
+		}
+
+		var nutrition dto.NutritionInput
+		informed := false
+		for column, field := range catalogCSVNutritionColumns(&nutrition) {
+			if value(column) == "" {
+				continue
+			}
+
+			if *field, err = strconv.ParseFloat(value(column), 64); err != nil {
+				return nil, fmt.Errorf("line %d: %s must be a number", line, column)
+			}
+			informed = true
+		}
+		if informed {
+			product.Nutrition = &nutrition
+		}
+
+		catalog = append(catalog, product)
+	}
+}
+
+func writeCatalogCSV(w io.Writer, catalog []dto.CatalogProduct) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(catalogCSVHeader); err != nil {
+		return err
+	}
+
+	for _, product := range catalog {
+		values := map[string]string{
+			"sku":          product.SKU,
+			"name":         product.Name,
+			"description":  product.Description,
+			"category":     product.Category,
+			"price_cents":  strconv.FormatInt(product.PriceCents, 10),
+			"currency":     product.Currency,
+			"images":       strings.Join(product.Images, catalogCSVListSeparator),
+			"allergens":    strings.Join(product.Allergens, catalogCSVListSeparator),
+			"dietary_tags": strings.Join(product.DietaryTags, catalogCSVListSeparator),
+		}
+		if product.Nutrition != nil {
+			for column, field := range catalogCSVNutritionColumns(product.Nutrition) {
+				values[column] = strconv.FormatFloat(*field, 'f', -1, 64)
+			}
+		}
+
+		record := make([]string, 0, len(catalogCSVHeader))
+		for _, column := range catalogCSVHeader {
+			record = append(record, values[column])
+		}
+
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+
+	return writer.Error()
+}
+
+func splitCatalogCSVList(value string) []string {
+	values := make([]string, 0)
+	for _, item := range strings.Split(value, catalogCSVListSeparator) {
+		if item = strings.TrimSpace(item); item != "" {
+			values = append(values, item)
+		}
+	}
+
+	return values
+}
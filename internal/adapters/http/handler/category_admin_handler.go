@@ -0,0 +1,249 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	domainError "github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/error"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/validator"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/dto"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/mappers"
+)
+
+type CategoryAdminHandler interface {
+	GetAll(c *gin.Context)
+	GetByID(c *gin.Context)
+	Create(c *gin.Context)
+	Update(c *gin.Context)
+	Delete(c *gin.Context)
+	ReassignProducts(c *gin.Context)
+}
+
+type categoryAdminHandler struct {
+	getCategoriesUseCase            usecase.GetCategoriesUseCase
+	getCategoryByIDUseCase          usecase.GetCategoryByIDUseCase
+	createCategoryUseCase           usecase.CreateCategoryUseCase
+	updateCategoryUseCase           usecase.UpdateCategoryUseCase
+	deleteCategoryUseCase           usecase.DeleteCategoryUseCase
+	reassignCategoryProductsUseCase usecase.ReassignCategoryProductsUseCase
+}
+
+func NewCategoryAdminHandler(getCategoriesUseCase usecase.GetCategoriesUseCase, getCategoryByIDUseCase usecase.GetCategoryByIDUseCase, createCategoryUseCase usecase.CreateCategoryUseCase, updateCategoryUseCase usecase.UpdateCategoryUseCase, deleteCategoryUseCase usecase.DeleteCategoryUseCase, reassignCategoryProductsUseCase usecase.ReassignCategoryProductsUseCase) CategoryAdminHandler {
+	return &categoryAdminHandler{
+		getCategoriesUseCase:            getCategoriesUseCase,
+		getCategoryByIDUseCase:          getCategoryByIDUseCase,
+		createCategoryUseCase:           createCategoryUseCase,
+		updateCategoryUseCase:           updateCategoryUseCase,
+		deleteCategoryUseCase:           deleteCategoryUseCase,
+		reassignCategoryProductsUseCase: reassignCategoryProductsUseCase,
+	}
+}
+
+// GetAll godoc
+// @Summary      Lista todas as categorias
+// @Description  Lista as categorias ativas e inativas na ordem de exibição, com a quantidade de produtos de cada uma
+// @Tags         categories
+// @Accept       json
+// @Produce      json
+// @Success      200  {array}   dto.CategoryOutput
+// @Failure      500  {object}  handler.ErrorResponse
+// @Router       /admin/categories [get]
+func (h *categoryAdminHandler) GetAll(c *gin.Context) {
+	categories, err := h.getCategoriesUseCase.Run(c.Request.Context(), false)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, mappers.ToCategoriesDTO(categories))
+}
+
+// GetByID godoc
+// @Summary      Obtém uma categoria por ID
+// @Description  Obtém a categoria com o ID fornecido
+// @Tags         categories
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "ID da Categoria"
+// @Success      200  {object}  dto.CategoryOutput
+// @Failure      400  {object}  handler.ErrorResponse
+// @Failure      404  {object}  handler.ErrorResponse
+// @Failure      500  {object}  handler.ErrorResponse
+// @Router       /admin/categories/{id} [get]
+func (h *categoryAdminHandler) GetByID(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	category, err := h.getCategoryByIDUseCase.Run(c.Request.Context(), id)
+	if err != nil {
+		respondCategoryError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, mappers.ToCategoryDTO(*category))
+}
+
+// Create godoc
+// @Summary      Cria uma categoria
+// @Description  Cria uma categoria, o handle é gerado a partir do nome e numerado quando já existe
+// @Tags         categories
+// @Accept       json
+// @Produce      json
+// @Param        input  body      dto.CategoryInput  true  "Dados da Categoria"
+// @Success      201    {object}  dto.CategoryOutput
+// @Failure      400    {object}  handler.ErrorResponse
+// @Failure      500    {object}  handler.ErrorResponse
+// @Router       /admin/categories [post]
+func (h *categoryAdminHandler) Create(c *gin.Context) {
+	input, ok := bindCategoryInput(c)
+	if !ok {
+		return
+	}
+
+	category, err := h.createCategoryUseCase.Run(c.Request.Context(), input)
+	if err != nil {
+		respondCategoryError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, mappers.ToCategoryDTO(*category))
+}
+
+// Update godoc
+// @Summary      Atualiza uma categoria
+// @Description  Atualiza uma categoria, o handle é mantido mesmo quando o nome muda
+// @Tags         categories
+// @Accept       json
+// @Produce      json
+// @Param        id     path      int                true  "ID da Categoria"
+// @Param        input  body      dto.CategoryInput  true  "Dados da Categoria"
+// @Success      200    {object}  dto.CategoryOutput
+// @Failure      400    {object}  handler.ErrorResponse
+// @Failure      404    {object}  handler.ErrorResponse
+// @Failure      500    {object}  handler.ErrorResponse
+// @Router       /admin/categories/{id} [put]
+func (h *categoryAdminHandler) Update(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	input, ok := bindCategoryInput(c)
+	if !ok {
+		return
+	}
+
+	category, err := h.updateCategoryUseCase.Run(c.Request.Context(), id, input)
+	if err != nil {
+		respondCategoryError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, mappers.ToCategoryDTO(*category))
+}
+
+// Delete godoc
+// @Summary      Remove uma categoria
+// @Description  Remove uma categoria sem produtos. Categorias com produtos retornam 409, mova os produtos antes com o endpoint de reatribuição
+// @Tags         categories
+// @Accept       json
+// @Produce      json
+// @Param        id   path  int  true  "ID da Categoria"
+// @Success      204  "No content"
+// @Failure      400  {object}  handler.ErrorResponse
+// @Failure      404  {object}  handler.ErrorResponse
+// @Failure      409  {object}  handler.ErrorResponse
+// @Failure      500  {object}  handler.ErrorResponse
+// @Router       /admin/categories/{id} [delete]
+func (h *categoryAdminHandler) Delete(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	err = h.deleteCategoryUseCase.Run(c.Request.Context(), id)
+	if errors.Is(err, &domainError.EntityNotProcessableError{}) {
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		return
+	} else if err != nil {
+		respondCategoryError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// ReassignProducts godoc
+// @Summary      Move produtos para outra categoria
+// @Description  Move os produtos informados em product_ids, ou todos quando vazio, da categoria para a categoria de destino
+// @Tags         categories
+// @Accept       json
+// @Produce      json
+// @Param        id     path      int                                true  "ID da Categoria de origem"
+// @Param        input  body      dto.ReassignCategoryProductsInput  true  "Categoria de destino e produtos"
+// @Success      200    {object}  dto.ReassignCategoryProductsOutput
+// @Failure      400    {object}  handler.ErrorResponse
+// @Failure      404    {object}  handler.ErrorResponse
+// @Failure      500    {object}  handler.ErrorResponse
+// @Router       /admin/categories/{id}/products/reassign [post]
+func (h *categoryAdminHandler) ReassignProducts(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	var input dto.ReassignCategoryProductsInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := dto.ValidateReassignCategoryProductsInput(input); err != nil {
+		errors := validator.HandleValidationError(err)
+		c.JSON(http.StatusBadRequest, gin.H{"errors": errors})
+		return
+	}
+
+	reassigned, err := h.reassignCategoryProductsUseCase.Run(c.Request.Context(), id, input)
+	if err != nil {
+		respondCategoryError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ReassignCategoryProductsOutput{Reassigned: reassigned})
+}
+
+func bindCategoryInput(c *gin.Context) (dto.CategoryInput, bool) {
+	var input dto.CategoryInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return input, false
+	}
+
+	if err := dto.ValidateCategoryInput(input); err != nil {
+		errors := validator.HandleValidationError(err)
+		c.JSON(http.StatusBadRequest, gin.H{"errors": errors})
+		return input, false
+	}
+
+	return input, true
+}
+
+func respondCategoryError(c *gin.Context, err error) {
+	if errors.Is(err, &domainError.NotFoundError{}) {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	} else if errors.Is(err, &domainError.EntityNotProcessableError{}) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	} else {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
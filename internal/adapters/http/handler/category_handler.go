@@ -0,0 +1,56 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/adapters/http/middleware"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/mappers"
+)
+
+type CategoryHandler interface {
+	GetCategories(c *gin.Context)
+}
+
+type categoryHandler struct {
+	getCategoriesUseCase          usecase.GetCategoriesUseCase
+	getCatalogLastModifiedUseCase usecase.GetCatalogLastModifiedUseCase
+}
+
+func NewCategoryHandler(getCategoriesUseCase usecase.GetCategoriesUseCase, getCatalogLastModifiedUseCase usecase.GetCatalogLastModifiedUseCase) CategoryHandler {
+	return &categoryHandler{
+		getCategoriesUseCase:          getCategoriesUseCase,
+		getCatalogLastModifiedUseCase: getCatalogLastModifiedUseCase,
+	}
+}
+
+// GetCategories godoc
+// @Summary      Lista as categorias do cardápio
+// @Description  Lista as categorias ativas na ordem de exibição
+// @Tags         categories
+// @Accept       json
+// @Produce      json
+// @Param        If-None-Match      header  string  false  "ETag da cópia que o cliente tem, respondido com 304 enquanto estiver atual"
+// @Param        If-Modified-Since  header  string  false  "Last-Modified da cópia que o cliente tem, usado apenas sem If-None-Match"
+// @Success      200  {array}   dto.CategoryOutput
+// @Success      304  "Not modified"
+// @Failure      500  {object}  handler.ErrorResponse
+// @Router       /categories [get]
+func (h *categoryHandler) GetCategories(c *gin.Context) {
+	// Read before the categories, so a change made meanwhile is never older than Last-Modified
+	lastModified, err := h.getCatalogLastModifiedUseCase.Run(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	categories, err := h.getCategoriesUseCase.Run(c.Request.Context(), true)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	middleware.SetLastModified(c, lastModified)
+	c.JSON(http.StatusOK, mappers.ToCategoriesDTO(categories))
+}
@@ -32,7 +32,10 @@ func NewCheckoutHandler(createOrderUseCase usecase.CreateOrderUseCase) CheckoutH
 // @Param        order  body      dto.CreateOrderRequest  true  "Dados do Pedido"
 // @Success      201     {object}  dto.OrderResponse
 // @Failure      400     {object}  handler.ErrorResponse
+// @Failure      402     {object}  handler.PaymentDeclinedResponse
+// @Failure      409     {object}  handler.InsufficientStockResponse
 // @Failure      500     {object}  handler.ErrorResponse
+// @Failure      503     {object}  handler.ErrorResponse
 // @Router       /checkout [post]
 func (h *checkoutHandler) Create(c *gin.Context) {
 	var createOrderReq dto.CreateOrderRequest
@@ -46,13 +49,40 @@ func (h *checkoutHandler) Create(c *gin.Context) {
 
 	createdOrder, err := h.createOrderUseCase.Run(c.Request.Context(), orderEntity)
 	if err != nil {
-		if errors.Is(err, &domainError.EntityNotProcessableError{}) {
-			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
-		} else {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-		}
+		writeCheckoutError(c, err)
 		return
 	}
 
 	c.JSON(http.StatusCreated, mappers.MapOrderEntityToResponse(*createdOrder))
 }
+
+// writeCheckoutError answers the errors of paying an order, shared by the checkout and the payment
+// retry so both report a rejected order or payment the same way.
+func writeCheckoutError(c *gin.Context, err error) {
+	if errors.Is(err, &domainError.EntityNotProcessableError{}) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	} else if declined := new(domainError.PaymentDeclinedError); errors.As(err, &declined) {
+		c.JSON(http.StatusPaymentRequired, gin.H{"error": declined.Reason, "decline_code": declined.Code})
+	} else if insufficient := new(domainError.InsufficientStockError); errors.As(err, &insufficient) {
+		c.JSON(http.StatusConflict, insufficientStockResponse(insufficient))
+	} else if errors.Is(err, &domainError.ServiceUnavailableError{}) {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": err.Error()})
+	} else {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
+
+func insufficientStockResponse(err *domainError.InsufficientStockError) InsufficientStockResponse {
+	items := make([]InsufficientStockItem, len(err.Shortages))
+	for idx, shortage := range err.Shortages {
+		items[idx] = InsufficientStockItem{
+			ProductID:   shortage.ProductID,
+			VariantID:   shortage.VariantID,
+			ProductName: shortage.ProductName,
+			Requested:   shortage.Requested,
+			Available:   shortage.Available,
+		}
+	}
+
+	return InsufficientStockResponse{Error: err.Error(), Items: items}
+}
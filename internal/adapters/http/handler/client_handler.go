@@ -15,15 +15,17 @@ import (
 type ClientHandler interface {
 	Create(c *gin.Context)
 	GetByCPF(c *gin.Context)
+	GetLoyalty(c *gin.Context)
 }
 
 type clientHandler struct {
-	createClientUseCase   usecase.CreateClientUseCase
-	getClientByCPFUseCase usecase.GetClientByCPFUseCase
+	createClientUseCase     usecase.CreateClientUseCase
+	getClientByCPFUseCase   usecase.GetClientByCPFUseCase
+	getClientLoyaltyUseCase usecase.GetClientLoyaltyUseCase
 }
 
-func NewClientHandler(createClientUseCase usecase.CreateClientUseCase, getClientByCPFUseCase usecase.GetClientByCPFUseCase) ClientHandler {
-	return &clientHandler{createClientUseCase: createClientUseCase, getClientByCPFUseCase: getClientByCPFUseCase}
+func NewClientHandler(createClientUseCase usecase.CreateClientUseCase, getClientByCPFUseCase usecase.GetClientByCPFUseCase, getClientLoyaltyUseCase usecase.GetClientLoyaltyUseCase) ClientHandler {
+	return &clientHandler{createClientUseCase: createClientUseCase, getClientByCPFUseCase: getClientByCPFUseCase, getClientLoyaltyUseCase: getClientLoyaltyUseCase}
 }
 
 // Create CreateClient godoc
@@ -79,3 +81,29 @@ func (h *clientHandler) GetByCPF(c *gin.Context) {
 
 	c.JSON(http.StatusOK, mappers.ToClientDTO(*client))
 }
+
+// GetLoyalty GetClientLoyalty godoc
+// @Summary      Obtém o saldo de pontos de fidelidade do cliente
+// @Description  Retorna o saldo de pontos, o valor em dinheiro equivalente e o extrato de lançamentos do cliente
+// @Tags         clients
+// @Accept       json
+// @Produce      json
+// @Param        cpf   path      string  true  "CPF do Cliente"
+// @Success      200   {object}  dto.ClientLoyaltyOutput
+// @Failure      404   {object}  handler.ErrorResponse
+// @Failure      500   {object}  handler.ErrorResponse
+// @Router       /clients/{cpf}/loyalty [get]
+func (h *clientHandler) GetLoyalty(c *gin.Context) {
+	cpf := c.Param("cpf")
+	loyalty, err := h.getClientLoyaltyUseCase.Run(c.Request.Context(), cpf)
+	if err != nil {
+		if errors.Is(err, domainError.ErrNotFound("client")) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Cliente não encontrado"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, loyalty)
+}
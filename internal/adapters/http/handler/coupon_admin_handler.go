@@ -0,0 +1,169 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	domainError "github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/error"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/validator"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/dto"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/mappers"
+)
+
+type CouponAdminHandler interface {
+	GetAll(c *gin.Context)
+	Create(c *gin.Context)
+	Update(c *gin.Context)
+	Delete(c *gin.Context)
+}
+
+type couponAdminHandler struct {
+	getCouponsUseCase   usecase.GetCouponsUseCase
+	createCouponUseCase usecase.CreateCouponUseCase
+	updateCouponUseCase usecase.UpdateCouponUseCase
+	deleteCouponUseCase usecase.DeleteCouponUseCase
+}
+
+func NewCouponAdminHandler(getCouponsUseCase usecase.GetCouponsUseCase, createCouponUseCase usecase.CreateCouponUseCase, updateCouponUseCase usecase.UpdateCouponUseCase, deleteCouponUseCase usecase.DeleteCouponUseCase) CouponAdminHandler {
+	return &couponAdminHandler{
+		getCouponsUseCase:   getCouponsUseCase,
+		createCouponUseCase: createCouponUseCase,
+		updateCouponUseCase: updateCouponUseCase,
+		deleteCouponUseCase: deleteCouponUseCase,
+	}
+}
+
+// GetAll godoc
+// @Summary      Lista os cupons
+// @Description  Lista os cupons de desconto com as regras de validade, limites de uso e restrições
+// @Tags         coupons
+// @Accept       json
+// @Produce      json
+// @Success      200  {array}   dto.CouponOutput
+// @Failure      500  {object}  handler.ErrorResponse
+// @Router       /admin/coupons [get]
+func (h *couponAdminHandler) GetAll(c *gin.Context) {
+	coupons, err := h.getCouponsUseCase.Run(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, mappers.ToCouponsDTO(coupons))
+}
+
+// Create godoc
+// @Summary      Cria um cupom
+// @Description  Cria um cupom de desconto fixo ou percentual, o código não diferencia maiúsculas de minúsculas
+// @Tags         coupons
+// @Accept       json
+// @Produce      json
+// @Param        input  body      dto.CouponInput  true  "Dados do Cupom"
+// @Success      201    {object}  dto.CouponOutput
+// @Failure      400    {object}  handler.ErrorResponse
+// @Failure      500    {object}  handler.ErrorResponse
+// @Router       /admin/coupons [post]
+func (h *couponAdminHandler) Create(c *gin.Context) {
+	input, ok := bindCouponInput(c)
+	if !ok {
+		return
+	}
+
+	coupon, err := h.createCouponUseCase.Run(c.Request.Context(), input)
+	if err != nil {
+		respondCouponError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, mappers.ToCouponDTO(*coupon))
+}
+
+// Update godoc
+// @Summary      Atualiza um cupom
+// @Description  Atualiza um cupom, pedidos já criados mantêm os descontos concedidos
+// @Tags         coupons
+// @Accept       json
+// @Produce      json
+// @Param        id     path      int              true  "ID do Cupom"
+// @Param        input  body      dto.CouponInput  true  "Dados do Cupom"
+// @Success      200    {object}  dto.CouponOutput
+// @Failure      400    {object}  handler.ErrorResponse
+// @Failure      404    {object}  handler.ErrorResponse
+// @Failure      500    {object}  handler.ErrorResponse
+// @Router       /admin/coupons/{id} [put]
+func (h *couponAdminHandler) Update(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	input, ok := bindCouponInput(c)
+	if !ok {
+		return
+	}
+
+	coupon, err := h.updateCouponUseCase.Run(c.Request.Context(), id, input)
+	if err != nil {
+		respondCouponError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, mappers.ToCouponDTO(*coupon))
+}
+
+// Delete godoc
+// @Summary      Remove um cupom
+// @Description  Remove um cupom, pedidos já criados mantêm os descontos concedidos
+// @Tags         coupons
+// @Accept       json
+// @Produce      json
+// @Param        id   path  int  true  "ID do Cupom"
+// @Success      204  "No content"
+// @Failure      400  {object}  handler.ErrorResponse
+// @Failure      404  {object}  handler.ErrorResponse
+// @Failure      500  {object}  handler.ErrorResponse
+// @Router       /admin/coupons/{id} [delete]
+func (h *couponAdminHandler) Delete(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	if err := h.deleteCouponUseCase.Run(c.Request.Context(), id); err != nil {
+		respondCouponError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func bindCouponInput(c *gin.Context) (dto.CouponInput, bool) {
+	var input dto.CouponInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return input, false
+	}
+
+	if err := dto.ValidateCouponInput(input); err != nil {
+		errors := validator.HandleValidationError(err)
+		c.JSON(http.StatusBadRequest, gin.H{"errors": errors})
+		return input, false
+	}
+
+	return input, true
+}
+
+func respondCouponError(c *gin.Context, err error) {
+	if errors.Is(err, &domainError.NotFoundError{}) {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	} else if errors.Is(err, &domainError.EntityNotProcessableError{}) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	} else {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
@@ -5,3 +5,25 @@ type ErrorResponse struct {
 	Code    int    `json:"code,omitempty" example:"400"`
 	Details string `json:"details,omitempty" example:"Additional information"`
 }
+
+// PaymentDeclinedResponse is returned when the gateway refuses the payment, decline_code tells
+// clients why and error can be shown to the customer.
+type PaymentDeclinedResponse struct {
+	Error       string `json:"error" example:"Saldo insuficiente. Tente outro cartão ou forma de pagamento."`
+	DeclineCode string `json:"decline_code" example:"insufficient_funds"`
+}
+
+// InsufficientStockResponse is returned when the stock cannot cover the order, items lists every
+// product short of stock so clients can adjust the quantities.
+type InsufficientStockResponse struct {
+	Error string                  `json:"error" example:"Insufficient stock: X-Burger (requested 3, available 1)"`
+	Items []InsufficientStockItem `json:"items"`
+}
+
+type InsufficientStockItem struct {
+	ProductID   int    `json:"product_id" example:"1"`
+	VariantID   int    `json:"variant_id,omitempty" example:"3"`
+	ProductName string `json:"product_name" example:"X-Burger"`
+	Requested   int    `json:"requested" example:"3"`
+	Available   int    `json:"available" example:"1"`
+}
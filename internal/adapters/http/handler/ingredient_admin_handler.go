@@ -0,0 +1,315 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	domainError "github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/error"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/validator"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/dto"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/mappers"
+)
+
+type IngredientAdminHandler interface {
+	GetAll(c *gin.Context)
+	Create(c *gin.Context)
+	Update(c *gin.Context)
+	Delete(c *gin.Context)
+	Receive(c *gin.Context)
+	Count(c *gin.Context)
+	GetMovements(c *gin.Context)
+	GetReport(c *gin.Context)
+}
+
+type ingredientAdminHandler struct {
+	getIngredientsUseCase         usecase.GetIngredientsUseCase
+	createIngredientUseCase       usecase.CreateIngredientUseCase
+	updateIngredientUseCase       usecase.UpdateIngredientUseCase
+	deleteIngredientUseCase       usecase.DeleteIngredientUseCase
+	receiveIngredientUseCase      usecase.ReceiveIngredientUseCase
+	countIngredientUseCase        usecase.CountIngredientUseCase
+	getIngredientMovementsUseCase usecase.GetIngredientMovementsUseCase
+	getInventoryReportUseCase     usecase.GetInventoryReportUseCase
+}
+
+func NewIngredientAdminHandler(getIngredientsUseCase usecase.GetIngredientsUseCase, createIngredientUseCase usecase.CreateIngredientUseCase, updateIngredientUseCase usecase.UpdateIngredientUseCase, deleteIngredientUseCase usecase.DeleteIngredientUseCase, receiveIngredientUseCase usecase.ReceiveIngredientUseCase, countIngredientUseCase usecase.CountIngredientUseCase, getIngredientMovementsUseCase usecase.GetIngredientMovementsUseCase, getInventoryReportUseCase usecase.GetInventoryReportUseCase) IngredientAdminHandler {
+	return &ingredientAdminHandler{
+		getIngredientsUseCase:         getIngredientsUseCase,
+		createIngredientUseCase:       createIngredientUseCase,
+		updateIngredientUseCase:       updateIngredientUseCase,
+		deleteIngredientUseCase:       deleteIngredientUseCase,
+		receiveIngredientUseCase:      receiveIngredientUseCase,
+		countIngredientUseCase:        countIngredientUseCase,
+		getIngredientMovementsUseCase: getIngredientMovementsUseCase,
+		getInventoryReportUseCase:     getInventoryReportUseCase,
+	}
+}
+
+// GetAll godoc
+// @Summary      Lista os ingredientes
+// @Description  Lista os ingredientes com o estoque teórico, recebimentos menos o consumo dos pedidos desde a última contagem
+// @Tags         ingredients
+// @Accept       json
+// @Produce      json
+// @Success      200  {array}   dto.IngredientOutput
+// @Failure      500  {object}  handler.ErrorResponse
+// @Router       /admin/ingredients [get]
+func (h *ingredientAdminHandler) GetAll(c *gin.Context) {
+	ingredients, err := h.getIngredientsUseCase.Run(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, mappers.ToIngredientsDTO(ingredients))
+}
+
+// Create godoc
+// @Summary      Cria um ingrediente
+// @Description  Cria um ingrediente sem estoque, com a unidade em que é recebido e usado nas receitas (g, kg, ml, l ou unit)
+// @Tags         ingredients
+// @Accept       json
+// @Produce      json
+// @Param        input  body      dto.IngredientInput  true  "Dados do Ingrediente"
+// @Success      201    {object}  dto.IngredientOutput
+// @Failure      400    {object}  handler.ErrorResponse
+// @Failure      500    {object}  handler.ErrorResponse
+// @Router       /admin/ingredients [post]
+func (h *ingredientAdminHandler) Create(c *gin.Context) {
+	input, ok := bindIngredientInput(c)
+	if !ok {
+		return
+	}
+
+	ingredient, err := h.createIngredientUseCase.Run(c.Request.Context(), input)
+	if err != nil {
+		respondIngredientError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, mappers.ToIngredientDTO(*ingredient))
+}
+
+// Update godoc
+// @Summary      Atualiza um ingrediente
+// @Description  Atualiza o nome e a unidade do ingrediente. A unidade não muda depois que o ingrediente entra em receitas ou tem movimentações
+// @Tags         ingredients
+// @Accept       json
+// @Produce      json
+// @Param        id     path      int                  true  "ID do Ingrediente"
+// @Param        input  body      dto.IngredientInput  true  "Dados do Ingrediente"
+// @Success      200    {object}  dto.IngredientOutput
+// @Failure      400    {object}  handler.ErrorResponse
+// @Failure      404    {object}  handler.ErrorResponse
+// @Failure      500    {object}  handler.ErrorResponse
+// @Router       /admin/ingredients/{id} [put]
+func (h *ingredientAdminHandler) Update(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	input, ok := bindIngredientInput(c)
+	if !ok {
+		return
+	}
+
+	ingredient, err := h.updateIngredientUseCase.Run(c.Request.Context(), id, input)
+	if err != nil {
+		respondIngredientError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, mappers.ToIngredientDTO(*ingredient))
+}
+
+// Delete godoc
+// @Summary      Remove um ingrediente
+// @Description  Remove um ingrediente que nenhuma receita usa. Ingredientes em receitas retornam 409
+// @Tags         ingredients
+// @Accept       json
+// @Produce      json
+// @Param        id   path  int  true  "ID do Ingrediente"
+// @Success      204  "No content"
+// @Failure      400  {object}  handler.ErrorResponse
+// @Failure      404  {object}  handler.ErrorResponse
+// @Failure      409  {object}  handler.ErrorResponse
+// @Failure      500  {object}  handler.ErrorResponse
+// @Router       /admin/ingredients/{id} [delete]
+func (h *ingredientAdminHandler) Delete(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	err = h.deleteIngredientUseCase.Run(c.Request.Context(), id)
+	if errors.Is(err, &domainError.EntityNotProcessableError{}) {
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		return
+	} else if err != nil {
+		respondIngredientError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// Receive godoc
+// @Summary      Registra um recebimento de ingrediente
+// @Description  Soma a quantidade recebida ao estoque do ingrediente. Produtos desativados por falta dele voltam a ficar disponíveis quando todos os ingredientes da receita estão em estoque
+// @Tags         ingredients
+// @Accept       json
+// @Produce      json
+// @Param        id     path      int                         true  "ID do Ingrediente"
+// @Param        input  body      dto.IngredientReceiptInput  true  "Dados do Recebimento"
+// @Success      201    {object}  dto.IngredientMovementOutput
+// @Failure      400    {object}  handler.ErrorResponse
+// @Failure      404    {object}  handler.ErrorResponse
+// @Failure      500    {object}  handler.ErrorResponse
+// @Router       /admin/ingredients/{id}/receipts [post]
+func (h *ingredientAdminHandler) Receive(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	var input dto.IngredientReceiptInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := dto.ValidateIngredientReceiptInput(input); err != nil {
+		errors := validator.HandleValidationError(err)
+		c.JSON(http.StatusBadRequest, gin.H{"errors": errors})
+		return
+	}
+
+	movement, err := h.receiveIngredientUseCase.Run(c.Request.Context(), id, input)
+	if err != nil {
+		respondIngredientError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, mappers.ToIngredientMovementDTO(*movement))
+}
+
+// Count godoc
+// @Summary      Registra uma contagem de ingrediente
+// @Description  Registra a quantidade contada fisicamente. O estoque teórico passa a ser a quantidade contada e a diferença fica no relatório de inventário
+// @Tags         ingredients
+// @Accept       json
+// @Produce      json
+// @Param        id     path      int                       true  "ID do Ingrediente"
+// @Param        input  body      dto.IngredientCountInput  true  "Dados da Contagem"
+// @Success      201    {object}  dto.IngredientCountOutput
+// @Failure      400    {object}  handler.ErrorResponse
+// @Failure      404    {object}  handler.ErrorResponse
+// @Failure      500    {object}  handler.ErrorResponse
+// @Router       /admin/ingredients/{id}/counts [post]
+func (h *ingredientAdminHandler) Count(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	var input dto.IngredientCountInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := dto.ValidateIngredientCountInput(input); err != nil {
+		errors := validator.HandleValidationError(err)
+		c.JSON(http.StatusBadRequest, gin.H{"errors": errors})
+		return
+	}
+
+	count, err := h.countIngredientUseCase.Run(c.Request.Context(), id, input)
+	if err != nil {
+		respondIngredientError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, mappers.ToIngredientCountDTO(*count))
+}
+
+// GetMovements godoc
+// @Summary      Lista as movimentações de um ingrediente
+// @Description  Lista os últimos recebimentos, consumos de pedidos e ajustes de contagem do ingrediente, dos mais recentes para os mais antigos
+// @Tags         ingredients
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "ID do Ingrediente"
+// @Success      200  {array}   dto.IngredientMovementOutput
+// @Failure      400  {object}  handler.ErrorResponse
+// @Failure      404  {object}  handler.ErrorResponse
+// @Failure      500  {object}  handler.ErrorResponse
+// @Router       /admin/ingredients/{id}/movements [get]
+func (h *ingredientAdminHandler) GetMovements(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	movements, err := h.getIngredientMovementsUseCase.Run(c.Request.Context(), id)
+	if err != nil {
+		respondIngredientError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, mappers.ToIngredientMovementsDTO(movements))
+}
+
+// GetReport godoc
+// @Summary      Relatório de inventário
+// @Description  Compara o estoque teórico de cada ingrediente com a última contagem. A variância é negativa quando foi contado menos do que o esperado
+// @Tags         ingredients
+// @Accept       json
+// @Produce      json
+// @Success      200  {object}  dto.InventoryReportOutput
+// @Failure      500  {object}  handler.ErrorResponse
+// @Router       /admin/ingredients/report [get]
+func (h *ingredientAdminHandler) GetReport(c *gin.Context) {
+	lines, err := h.getInventoryReportUseCase.Run(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, mappers.ToInventoryReportDTO(lines))
+}
+
+func bindIngredientInput(c *gin.Context) (dto.IngredientInput, bool) {
+	var input dto.IngredientInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return input, false
+	}
+
+	if err := dto.ValidateIngredientInput(input); err != nil {
+		errors := validator.HandleValidationError(err)
+		c.JSON(http.StatusBadRequest, gin.H{"errors": errors})
+		return input, false
+	}
+
+	return input, true
+}
+
+func respondIngredientError(c *gin.Context, err error) {
+	if errors.Is(err, &domainError.NotFoundError{}) {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	} else if errors.Is(err, &domainError.EntityNotProcessableError{}) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	} else {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/adapters/db/repository"
 	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/dto"
 	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/mappers"
 	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
 
@@ -17,6 +18,7 @@ type OrderHandler interface {
 	GetAll(c *gin.Context)
 	UpdateOrderStatusToReady(c *gin.Context)
 	UpdateOrderStatusToDelivered(c *gin.Context)
+	RetryPayment(c *gin.Context)
 }
 
 type orderHandler struct {
@@ -24,10 +26,11 @@ type orderHandler struct {
 	getOrderByIDUseCase                 usecase.GetOrderByIDUseCase
 	updateOrderStatusToReadyUseCase     usecase.UpdateOrderStatusToReadyUseCase
 	updateOrderStatusToDeliveredUseCase usecase.UpdateOrderStatusToDeliveredUseCase
+	retryOrderPaymentUseCase            usecase.RetryOrderPaymentUseCase
 }
 
-func NewOrderHandler(getAllOrdersUseCase usecase.GetAllOrdersUseCase, getOrderByIDUseCase usecase.GetOrderByIDUseCase, updateOrderStatusToReadyUseCase usecase.UpdateOrderStatusToReadyUseCase, updateOrderStatusToDeliveredUseCase usecase.UpdateOrderStatusToDeliveredUseCase) OrderHandler {
-	return &orderHandler{getAllOrdersUseCase: getAllOrdersUseCase, getOrderByIDUseCase: getOrderByIDUseCase, updateOrderStatusToReadyUseCase: updateOrderStatusToReadyUseCase, updateOrderStatusToDeliveredUseCase: updateOrderStatusToDeliveredUseCase}
+func NewOrderHandler(getAllOrdersUseCase usecase.GetAllOrdersUseCase, getOrderByIDUseCase usecase.GetOrderByIDUseCase, updateOrderStatusToReadyUseCase usecase.UpdateOrderStatusToReadyUseCase, updateOrderStatusToDeliveredUseCase usecase.UpdateOrderStatusToDeliveredUseCase, retryOrderPaymentUseCase usecase.RetryOrderPaymentUseCase) OrderHandler {
+	return &orderHandler{getAllOrdersUseCase: getAllOrdersUseCase, getOrderByIDUseCase: getOrderByIDUseCase, updateOrderStatusToReadyUseCase: updateOrderStatusToReadyUseCase, updateOrderStatusToDeliveredUseCase: updateOrderStatusToDeliveredUseCase, retryOrderPaymentUseCase: retryOrderPaymentUseCase}
 }
 
 // GetById godoc
@@ -153,3 +156,44 @@ func (h *orderHandler) UpdateOrderStatusToDelivered(c *gin.Context) {
 
 	c.Status(http.StatusNoContent)
 }
+
+// RetryPayment godoc
+// @Summary      Paga novamente um pedido com pagamento recusado
+// @Description  Cria uma nova tentativa de pagamento, com o mesmo ou outro método, para um pedido em payment_failed. O pedido é cancelado se não for pago dentro da janela configurada
+// @Tags         orders
+// @Accept       json
+// @Produce      json
+// @Param        id       path      int                       true  "ID do Pedido"
+// @Param        payment  body      dto.CreatePaymentRequest  true  "Método de pagamento"
+// @Success      201      {object}  dto.OrderResponse
+// @Failure      400      {object}  handler.ErrorResponse
+// @Failure      402      {object}  handler.PaymentDeclinedResponse
+// @Failure      404      {object}  handler.ErrorResponse
+// @Failure      500      {object}  handler.ErrorResponse
+// @Failure      503      {object}  handler.ErrorResponse
+// @Router       /orders/{id}/payments [post]
+func (h *orderHandler) RetryPayment(c *gin.Context) {
+	orderID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid order ID"})
+		return
+	}
+
+	var input dto.CreatePaymentRequest
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	orderEntity, err := h.retryOrderPaymentUseCase.Run(c.Request.Context(), orderID, mappers.MapCreatePaymentRequestToEntity(input))
+	if err != nil {
+		if err == repository.ErrOrderNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Order not found"})
+		} else {
+			writeCheckoutError(c, err)
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, mappers.MapOrderEntityToResponse(*orderEntity))
+}
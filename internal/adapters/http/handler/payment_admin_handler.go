@@ -0,0 +1,67 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	domainError "github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/error"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase"
+)
+
+type PaymentAdminHandler interface {
+	GetReconciliationSummary(c *gin.Context)
+	Reconcile(c *gin.Context)
+}
+
+type paymentAdminHandler struct {
+	reconcilePendingPaymentsUseCase        usecase.ReconcilePendingPaymentsUseCase
+	getPaymentReconciliationSummaryUseCase usecase.GetPaymentReconciliationSummaryUseCase
+}
+
+func NewPaymentAdminHandler(reconcilePendingPaymentsUseCase usecase.ReconcilePendingPaymentsUseCase, getPaymentReconciliationSummaryUseCase usecase.GetPaymentReconciliationSummaryUseCase) PaymentAdminHandler {
+	return &paymentAdminHandler{reconcilePendingPaymentsUseCase: reconcilePendingPaymentsUseCase, getPaymentReconciliationSummaryUseCase: getPaymentReconciliationSummaryUseCase}
+}
+
+// GetReconciliationSummary godoc
+// @Summary      Resumo da última conciliação de pagamentos
+// @Description  Retorna o resultado da última execução da conciliação de pagamentos pendentes
+// @Tags         payments
+// @Accept       json
+// @Produce      json
+// @Success      200  {object}  dto.PaymentReconciliationSummary
+// @Failure      404  {object}  handler.ErrorResponse
+// @Failure      500  {object}  handler.ErrorResponse
+// @Router       /admin/payments/reconciliation [get]
+func (h *paymentAdminHandler) GetReconciliationSummary(c *gin.Context) {
+	summary, err := h.getPaymentReconciliationSummaryUseCase.Run(c.Request.Context())
+	if err != nil {
+		if errors.Is(err, &domainError.NotFoundError{}) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, summary)
+}
+
+// Reconcile godoc
+// @Summary      Executa a conciliação de pagamentos
+// @Description  Consulta o gateway para os pagamentos pendentes há mais tempo que o configurado e aplica as mudanças de status
+// @Tags         payments
+// @Accept       json
+// @Produce      json
+// @Success      200  {object}  dto.PaymentReconciliationSummary
+// @Failure      500  {object}  handler.ErrorResponse
+// @Router       /admin/payments/reconciliation [post]
+func (h *paymentAdminHandler) Reconcile(c *gin.Context) {
+	summary, err := h.reconcilePendingPaymentsUseCase.Run(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, summary)
+}
@@ -0,0 +1,169 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	domainError "github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/error"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/validator"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/dto"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/mappers"
+)
+
+type PaymentTaxSettingsAdminHandler interface {
+	GetAll(c *gin.Context)
+	Create(c *gin.Context)
+	Update(c *gin.Context)
+	Delete(c *gin.Context)
+}
+
+type paymentTaxSettingsAdminHandler struct {
+	getPaymentTaxSettingsUseCase   usecase.GetPaymentTaxSettingsUseCase
+	createPaymentTaxSettingUseCase usecase.CreatePaymentTaxSettingUseCase
+	updatePaymentTaxSettingUseCase usecase.UpdatePaymentTaxSettingUseCase
+	deletePaymentTaxSettingUseCase usecase.DeletePaymentTaxSettingUseCase
+}
+
+func NewPaymentTaxSettingsAdminHandler(getPaymentTaxSettingsUseCase usecase.GetPaymentTaxSettingsUseCase, createPaymentTaxSettingUseCase usecase.CreatePaymentTaxSettingUseCase, updatePaymentTaxSettingUseCase usecase.UpdatePaymentTaxSettingUseCase, deletePaymentTaxSettingUseCase usecase.DeletePaymentTaxSettingUseCase) PaymentTaxSettingsAdminHandler {
+	return &paymentTaxSettingsAdminHandler{
+		getPaymentTaxSettingsUseCase:   getPaymentTaxSettingsUseCase,
+		createPaymentTaxSettingUseCase: createPaymentTaxSettingUseCase,
+		updatePaymentTaxSettingUseCase: updatePaymentTaxSettingUseCase,
+		deletePaymentTaxSettingUseCase: deletePaymentTaxSettingUseCase,
+	}
+}
+
+// GetAll godoc
+// @Summary      Lista as taxas configuradas
+// @Description  Lista as taxas fixas ou percentuais aplicadas por método de pagamento, plataforma ou entrega
+// @Tags         fees
+// @Accept       json
+// @Produce      json
+// @Success      200  {array}   dto.PaymentTaxSettingOutput
+// @Failure      500  {object}  handler.ErrorResponse
+// @Router       /admin/fees [get]
+func (h *paymentTaxSettingsAdminHandler) GetAll(c *gin.Context) {
+	settings, err := h.getPaymentTaxSettingsUseCase.Run(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, mappers.ToPaymentTaxSettingsDTO(settings))
+}
+
+// Create godoc
+// @Summary      Cria uma taxa
+// @Description  Cria uma taxa fixa ou percentual
+// @Tags         fees
+// @Accept       json
+// @Produce      json
+// @Param        input  body      dto.PaymentTaxSettingInput  true  "Dados da Taxa"
+// @Success      201    {object}  dto.PaymentTaxSettingOutput
+// @Failure      400    {object}  handler.ErrorResponse
+// @Failure      500    {object}  handler.ErrorResponse
+// @Router       /admin/fees [post]
+func (h *paymentTaxSettingsAdminHandler) Create(c *gin.Context) {
+	input, ok := bindPaymentTaxSettingInput(c)
+	if !ok {
+		return
+	}
+
+	setting, err := h.createPaymentTaxSettingUseCase.Run(c.Request.Context(), input)
+	if err != nil {
+		respondPaymentTaxSettingError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, mappers.ToPaymentTaxSettingDTO(*setting))
+}
+
+// Update godoc
+// @Summary      Atualiza uma taxa
+// @Description  Atualiza uma taxa, pedidos já criados mantêm as taxas cobradas
+// @Tags         fees
+// @Accept       json
+// @Produce      json
+// @Param        id     path      int                         true  "ID da Taxa"
+// @Param        input  body      dto.PaymentTaxSettingInput  true  "Dados da Taxa"
+// @Success      200    {object}  dto.PaymentTaxSettingOutput
+// @Failure      400    {object}  handler.ErrorResponse
+// @Failure      404    {object}  handler.ErrorResponse
+// @Failure      500    {object}  handler.ErrorResponse
+// @Router       /admin/fees/{id} [put]
+func (h *paymentTaxSettingsAdminHandler) Update(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	input, ok := bindPaymentTaxSettingInput(c)
+	if !ok {
+		return
+	}
+
+	setting, err := h.updatePaymentTaxSettingUseCase.Run(c.Request.Context(), id, input)
+	if err != nil {
+		respondPaymentTaxSettingError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, mappers.ToPaymentTaxSettingDTO(*setting))
+}
+
+// Delete godoc
+// @Summary      Remove uma taxa
+// @Description  Remove uma taxa, pedidos já criados mantêm as taxas cobradas
+// @Tags         fees
+// @Accept       json
+// @Produce      json
+// @Param        id   path  int  true  "ID da Taxa"
+// @Success      204  "No content"
+// @Failure      400  {object}  handler.ErrorResponse
+// @Failure      404  {object}  handler.ErrorResponse
+// @Failure      500  {object}  handler.ErrorResponse
+// @Router       /admin/fees/{id} [delete]
+func (h *paymentTaxSettingsAdminHandler) Delete(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	if err := h.deletePaymentTaxSettingUseCase.Run(c.Request.Context(), id); err != nil {
+		respondPaymentTaxSettingError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func bindPaymentTaxSettingInput(c *gin.Context) (dto.PaymentTaxSettingInput, bool) {
+	var input dto.PaymentTaxSettingInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return input, false
+	}
+
+	if err := dto.ValidatePaymentTaxSettingInput(input); err != nil {
+		errors := validator.HandleValidationError(err)
+		c.JSON(http.StatusBadRequest, gin.H{"errors": errors})
+		return input, false
+	}
+
+	return input, true
+}
+
+func respondPaymentTaxSettingError(c *gin.Context, err error) {
+	if errors.Is(err, &domainError.NotFoundError{}) {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	} else if errors.Is(err, &domainError.EntityNotProcessableError{}) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	} else {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
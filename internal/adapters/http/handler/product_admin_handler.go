@@ -2,34 +2,121 @@ package handler
 
 import (
 	"errors"
+	"fmt"
 	"log/slog"
 	"net/http"
 	"strconv"
+	"strings"
+	"unicode/utf8"
 
 	domainError "github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/error"
 	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/validator"
 	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase"
 	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/dto"
 	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/mappers"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
 	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/shared"
 
 	"github.com/gin-gonic/gin"
 )
 
 type ProductAdminHandler interface {
+	GetAll(c *gin.Context)
 	Create(c *gin.Context)
 	Update(c *gin.Context)
 	Delete(c *gin.Context)
+	Restore(c *gin.Context)
+	Purge(c *gin.Context)
+	UpdateStock(c *gin.Context)
 }
 
 type productAdminHandler struct {
-	createProductUseCase usecase.CreateProductUseCase
-	updateProductUseCase usecase.UpdateProductUseCase
-	deleteProductUseCase usecase.DeleteProductUseCase
+	getProductsUseCase        usecase.GetProductsUseCase
+	createProductUseCase      usecase.CreateProductUseCase
+	updateProductUseCase      usecase.UpdateProductUseCase
+	deleteProductUseCase      usecase.DeleteProductUseCase
+	restoreProductUseCase     usecase.RestoreProductUseCase
+	purgeProductUseCase       usecase.PurgeProductUseCase
+	updateProductStockUseCase usecase.UpdateProductStockUseCase
 }
 
-func NewProductAdminHandler(updateProductUseCase usecase.UpdateProductUseCase, createProductUseCase usecase.CreateProductUseCase, deleteProductUseCase usecase.DeleteProductUseCase) ProductAdminHandler {
-	return &productAdminHandler{updateProductUseCase: updateProductUseCase, createProductUseCase: createProductUseCase, deleteProductUseCase: deleteProductUseCase}
+func NewProductAdminHandler(updateProductUseCase usecase.UpdateProductUseCase, createProductUseCase usecase.CreateProductUseCase, deleteProductUseCase usecase.DeleteProductUseCase, updateProductStockUseCase usecase.UpdateProductStockUseCase, getProductsUseCase usecase.GetProductsUseCase, restoreProductUseCase usecase.RestoreProductUseCase, purgeProductUseCase usecase.PurgeProductUseCase) ProductAdminHandler {
+	return &productAdminHandler{
+		getProductsUseCase:        getProductsUseCase,
+		updateProductUseCase:      updateProductUseCase,
+		createProductUseCase:      createProductUseCase,
+		deleteProductUseCase:      deleteProductUseCase,
+		restoreProductUseCase:     restoreProductUseCase,
+		purgeProductUseCase:       purgeProductUseCase,
+		updateProductStockUseCase: updateProductStockUseCase,
+	}
+}
+
+// GetAll godoc
+// @Summary      Get Products (admin)
+// @Description  Lists products like the menu does, optionally with the soft-deleted ones, that carry deleted_at. only_deleted takes precedence over include_deleted
+// @Tags         products
+// @Accept       json
+// @Produce      json
+// @Param        page             query  int     false  "Page number"
+// @Param        pageSize         query  int     false  "Page size"
+// @Param        category         query  string  false  "Category"
+// @Param        q                query  string  false  "Search by name, category and description"
+// @Param        include_deleted  query  bool    false  "Also list deleted products"
+// @Param        only_deleted     query  bool    false  "List only deleted products"
+// @Success      200  {array}   dto.ProductOutput
+// @Failure      400  {object}  handler.ErrorResponse
+// @Failure      500  {object}  handler.ErrorResponse
+// @Router       /admin/products [get]
+func (h *productAdminHandler) GetAll(c *gin.Context) {
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid page number"})
+		return
+	}
+
+	pageSize, err := strconv.Atoi(c.DefaultQuery("pageSize", "10"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid page size"})
+		return
+	}
+
+	query := strings.TrimSpace(c.Query("q"))
+	if utf8.RuneCountInString(query) > maxProductSearchLength {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("Search must have at most %d characters", maxProductSearchLength)})
+		return
+	}
+
+	includeDeleted, err := strconv.ParseBool(c.DefaultQuery("include_deleted", "false"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid include_deleted, use true or false"})
+		return
+	}
+
+	onlyDeleted, err := strconv.ParseBool(c.DefaultQuery("only_deleted", "false"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid only_deleted, use true or false"})
+		return
+	}
+
+	products, total, err := h.getProductsUseCase.Run(c.Request.Context(), &ports.ProductFilter{
+		Category:                  c.Query("category"),
+		Query:                     query,
+		IncludeDeleted:            includeDeleted,
+		OnlyDeleted:               onlyDeleted,
+		IncludeInactiveCategories: true,
+		Page:                      page,
+		PageSize:                  pageSize,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"products": mappers.ToProductsDTO(products),
+		"total":    total,
+	})
 }
 
 // Create godoc
@@ -39,6 +126,7 @@ func NewProductAdminHandler(updateProductUseCase usecase.UpdateProductUseCase, c
 // @Accept       json
 // @Produce      json
 // @Param        input  body     dto.ProductInputCreate  true  "Product data"
+// @Param        X-Admin-User  header  string  false  "Admin recorded as the author of the price"
 // @Success      201  {object}  dto.ProductOutput
 // @Failure      400  {object}  handler.ErrorResponse
 // @Failure      500  {object}  handler.ErrorResponse
@@ -58,11 +146,14 @@ func (h *productAdminHandler) Create(c *gin.Context) {
 
 	slog.Info("Creating product", "input", shared.ToJSON(input))
 
-	product, err := h.createProductUseCase.Run(c.Request.Context(), input)
+	product, err := h.createProductUseCase.Run(c.Request.Context(), input, adminUser(c))
 	if err != nil {
 		if errors.Is(err, &domainError.NotFoundError{}) {
 			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
 			return
+		} else if errors.Is(err, &domainError.EntityNotProcessableError{}) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
 		}
 
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
@@ -82,6 +173,7 @@ func (h *productAdminHandler) Create(c *gin.Context) {
 // @Produce      json
 // @Param        id     path     int  true  "Product ID"
 // @Param        input  body     dto.ProductInputUpdate  true  "Product data"
+// @Param        X-Admin-User  header  string  false  "Admin recorded as the author of a price change"
 // @Success      200  {object}  dto.ProductOutput
 // @Failure      400  {object}  handler.ErrorResponse
 // @Failure      500  {object}  handler.ErrorResponse
@@ -101,11 +193,14 @@ func (h *productAdminHandler) Update(c *gin.Context) {
 
 	slog.Info("Updating product", "input", shared.ToJSON(input))
 
-	product, err := h.updateProductUseCase.Run(c.Request.Context(), input.ID, input)
+	product, err := h.updateProductUseCase.Run(c.Request.Context(), input.ID, input, adminUser(c))
 	if err != nil {
 		if errors.Is(err, &domainError.NotFoundError{}) {
 			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
 			return
+		} else if errors.Is(err, &domainError.EntityNotProcessableError{}) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
 		}
 
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
@@ -119,13 +214,14 @@ func (h *productAdminHandler) Update(c *gin.Context) {
 
 // Delete godoc
 // @Summary      Delete Product
-// @Description  Delete Product
+// @Description  Soft deletes the product with its images and variants, it can be restored later
 // @Tags         products
 // @Accept       json
 // @Produce      json
 // @Param        id     path     int  true  "Product ID"
 // @Success      204 "No content"
 // @Failure      400  {object}  handler.ErrorResponse
+// @Failure      404  {object}  handler.ErrorResponse
 // @Failure      500  {object}  handler.ErrorResponse
 // @Router       /admin/products/{id} [delete]
 func (h *productAdminHandler) Delete(c *gin.Context) {
@@ -140,6 +236,11 @@ func (h *productAdminHandler) Delete(c *gin.Context) {
 
 	err = h.deleteProductUseCase.Run(c.Request.Context(), id)
 	if err != nil {
+		if errors.Is(err, &domainError.NotFoundError{}) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
@@ -148,3 +249,129 @@ func (h *productAdminHandler) Delete(c *gin.Context) {
 
 	c.Status(http.StatusNoContent)
 }
+
+// Restore godoc
+// @Summary      Restore Product
+// @Description  Puts a deleted product back on the menu with the images and variants deleted along with it. Fails when the product is not deleted, its category was deleted or its SKU was taken
+// @Tags         products
+// @Accept       json
+// @Produce      json
+// @Param        id     path     int  true  "Product ID"
+// @Success      200  {object}  dto.ProductOutput
+// @Failure      400  {object}  handler.ErrorResponse
+// @Failure      404  {object}  handler.ErrorResponse
+// @Failure      409  {object}  handler.ErrorResponse
+// @Failure      500  {object}  handler.ErrorResponse
+// @Router       /admin/products/{id}/restore [post]
+func (h *productAdminHandler) Restore(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	product, err := h.restoreProductUseCase.Run(c.Request.Context(), id)
+	if err != nil {
+		respondProductLifecycleError(c, err)
+		return
+	}
+
+	slog.Info("Product restored", "id", id)
+
+	c.JSON(http.StatusOK, mappers.ToProductDTO(*product))
+}
+
+// Purge godoc
+// @Summary      Purge Product
+// @Description  Erases a deleted product for good, with its images, variants, prices, recipe and availability. Products ever ordered can only stay soft deleted
+// @Tags         products
+// @Accept       json
+// @Produce      json
+// @Param        id     path     int  true  "Product ID"
+// @Success      204 "No content"
+// @Failure      400  {object}  handler.ErrorResponse
+// @Failure      404  {object}  handler.ErrorResponse
+// @Failure      409  {object}  handler.ErrorResponse
+// @Failure      500  {object}  handler.ErrorResponse
+// @Router       /admin/products/{id}/purge [delete]
+func (h *productAdminHandler) Purge(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	if err := h.purgeProductUseCase.Run(c.Request.Context(), id); err != nil {
+		respondProductLifecycleError(c, err)
+		return
+	}
+
+	slog.Info("Product purged", "id", id)
+
+	c.Status(http.StatusNoContent)
+}
+
+// respondProductLifecycleError answers restores and purges the product state does not allow with
+// a conflict, the request itself was fine.
+func respondProductLifecycleError(c *gin.Context, err error) {
+	if errors.Is(err, &domainError.NotFoundError{}) {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	} else if errors.Is(err, &domainError.EntityNotProcessableError{}) {
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	} else {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
+
+// UpdateStock godoc
+// @Summary      Update Product Stock
+// @Description  Sets the stock of the product after a count or a restock. Untracked products are always available
+// @Tags         products
+// @Accept       json
+// @Produce      json
+// @Param        id     path     int  true  "Product ID"
+// @Param        input  body     dto.ProductStockInput  true  "Stock data"
+// @Success      200  {object}  dto.ProductOutput
+// @Failure      400  {object}  handler.ErrorResponse
+// @Failure      404  {object}  handler.ErrorResponse
+// @Failure      500  {object}  handler.ErrorResponse
+// @Router       /admin/products/{id}/stock [put]
+func (h *productAdminHandler) UpdateStock(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	var input dto.ProductStockInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := dto.ValidateProductStock(input); err != nil {
+		errors := validator.HandleValidationError(err)
+		c.JSON(http.StatusBadRequest, gin.H{"errors": errors})
+		return
+	}
+
+	slog.Info("Updating product stock", "id", id, "input", shared.ToJSON(input))
+
+	product, err := h.updateProductStockUseCase.Run(c.Request.Context(), id, input)
+	if err != nil {
+		if errors.Is(err, &domainError.NotFoundError{}) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		} else if errors.Is(err, &domainError.EntityNotProcessableError{}) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	slog.Info("Product stock updated", "product", shared.ToJSON(product))
+
+	c.JSON(http.StatusOK, mappers.ToProductDTO(*product))
+}
@@ -0,0 +1,45 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase"
+)
+
+type ProductCacheAdminHandler interface {
+	GetStats(c *gin.Context)
+	Invalidate(c *gin.Context)
+}
+
+type productCacheAdminHandler struct {
+	getProductCacheStatsUseCase   usecase.GetProductCacheStatsUseCase
+	invalidateProductCacheUseCase usecase.InvalidateProductCacheUseCase
+}
+
+func NewProductCacheAdminHandler(getProductCacheStatsUseCase usecase.GetProductCacheStatsUseCase, invalidateProductCacheUseCase usecase.InvalidateProductCacheUseCase) ProductCacheAdminHandler {
+	return &productCacheAdminHandler{getProductCacheStatsUseCase: getProductCacheStatsUseCase, invalidateProductCacheUseCase: invalidateProductCacheUseCase}
+}
+
+// GetStats godoc
+// @Summary      Métricas do cache de produtos
+// @Description  Acertos, falhas e invalidações do cache de produtos desta instância desde que ela iniciou
+// @Tags         products
+// @Produce      json
+// @Success      200  {object}  dto.ProductCacheStatsOutput
+// @Router       /admin/products/cache [get]
+func (h *productCacheAdminHandler) GetStats(c *gin.Context) {
+	c.JSON(http.StatusOK, h.getProductCacheStatsUseCase.Run(c.Request.Context()))
+}
+
+// Invalidate godoc
+// @Summary      Invalida o cache de produtos
+// @Description  Descarta as leituras de produtos em cache, para mudanças feitas direto no banco. As alterações feitas pela API já invalidam o cache
+// @Tags         products
+// @Success      204 "No content"
+// @Router       /admin/products/cache [delete]
+func (h *productCacheAdminHandler) Invalidate(c *gin.Context) {
+	h.invalidateProductCacheUseCase.Run(c.Request.Context())
+
+	c.Status(http.StatusNoContent)
+}
@@ -1,9 +1,15 @@
 package handler
 
 import (
+	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
 
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/adapters/http/middleware"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
 	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase"
 	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/mappers"
 	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
@@ -11,16 +17,22 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
+const maxProductSearchLength = 100
+
 type ProductHandler interface {
 	GetProducts(c *gin.Context)
 }
 
 type productHandler struct {
-	getProductsUseCase usecase.GetProductsUseCase
+	getProductsUseCase            usecase.GetProductsUseCase
+	getCatalogLastModifiedUseCase usecase.GetCatalogLastModifiedUseCase
 }
 
-func NewProductHandler(getProductsUseCase usecase.GetProductsUseCase) ProductHandler {
-	return &productHandler{getProductsUseCase: getProductsUseCase}
+func NewProductHandler(getProductsUseCase usecase.GetProductsUseCase, getCatalogLastModifiedUseCase usecase.GetCatalogLastModifiedUseCase) ProductHandler {
+	return &productHandler{
+		getProductsUseCase:            getProductsUseCase,
+		getCatalogLastModifiedUseCase: getCatalogLastModifiedUseCase,
+	}
 }
 
 // GetProducts godoc
@@ -32,7 +44,15 @@ func NewProductHandler(getProductsUseCase usecase.GetProductsUseCase) ProductHan
 // @Param        page     query     int  false  "Page number"
 // @Param        pageSize query     int  false  "Page size"
 // @Param        category query     string  false  "Category"
+// @Param        q        query     string  false  "Busca por nome, categoria e descrição, tolera acentos e erros de digitação"
+// @Param        available query    bool    false  "Only products that can be ordered now"
+// @Param        at       query     string  false  "Only products that can be ordered at this RFC 3339 time, like 2025-01-06T08:30:00-03:00"
+// @Param        exclude_allergens query string false "Drops products containing any of these comma-separated allergens, like gluten,milk"
+// @Param        diet     query     string  false  "Only products with all of these comma-separated dietary tags: vegan, vegetarian, gluten_free, lactose_free"
+// @Param        If-None-Match     header string false "ETag of the copy the client has, answered with 304 when it is still current"
+// @Param        If-Modified-Since header string false "Last-Modified of the copy the client has, only used without If-None-Match"
 // @Success      200      {array}  dto.ProductOutput
+// @Success      304      "Not modified"
 // @Failure      400      {object}  handler.ErrorResponse
 // @Failure      500      {object}  handler.ErrorResponse
 // @Router       /products [get]
@@ -46,22 +66,95 @@ func (h *productHandler) GetProducts(c *gin.Context) {
 	pageSize, err := strconv.Atoi(c.DefaultQuery("pageSize", "10"))
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid page size"})
+		return
 	}
 
 	category := c.DefaultQuery("category", "")
 
+	query := strings.TrimSpace(c.Query("q"))
+	if utf8.RuneCountInString(query) > maxProductSearchLength {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("Search must have at most %d characters", maxProductSearchLength)})
+		return
+	}
+
+	// Which products are available now changes with the clock, not with the catalog
+	availableNow := false
+	var availableAt *time.Time
+	if at := c.Query("at"); at != "" {
+		parsed, err := time.Parse(time.RFC3339, at)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid at, use an RFC 3339 time like 2025-01-06T08:30:00-03:00"})
+			return
+		}
+		availableAt = &parsed
+	} else if available := c.Query("available"); available != "" {
+		onlyAvailable, err := strconv.ParseBool(available)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid available, use true or false"})
+			return
+		}
+		if onlyAvailable {
+			now := time.Now()
+			availableAt = &now
+			availableNow = true
+		}
+	}
+
+	excludeAllergens := splitQueryList(c.Query("exclude_allergens"))
+	for _, allergen := range excludeAllergens {
+		if !entities.Allergen(allergen).Valid() {
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("Invalid allergen %q", allergen)})
+			return
+		}
+	}
+
+	dietaryTags := splitQueryList(c.Query("diet"))
+	for _, tag := range dietaryTags {
+		if !entities.DietaryTag(tag).Valid() {
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("Invalid diet %q", tag)})
+			return
+		}
+	}
+
+	// Read before the products, so a change made meanwhile is never older than Last-Modified
+	lastModified, err := h.getCatalogLastModifiedUseCase.Run(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
 	products, total, err := h.getProductsUseCase.Run(c.Request.Context(), &ports.ProductFilter{
-		Category: category,
-		Page:     page,
-		PageSize: pageSize,
+		Category:         category,
+		Query:            query,
+		AvailableAt:      availableAt,
+		ExcludeAllergens: excludeAllergens,
+		DietaryTags:      dietaryTags,
+		Page:             page,
+		PageSize:         pageSize,
 	})
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
 
+	if !availableNow {
+		middleware.SetLastModified(c, lastModified)
+	}
+
 	c.JSON(http.StatusOK, gin.H{
 		"products": mappers.ToProductsDTO(products),
 		"total":    total,
 	})
 }
+
+// splitQueryList splits a comma-separated query parameter, ignoring blanks and case.
+func splitQueryList(value string) []string {
+	var values []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.ToLower(strings.TrimSpace(item)); item != "" {
+			values = append(values, item)
+		}
+	}
+
+	return values
+}
@@ -0,0 +1,174 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/config"
+	domainError "github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/error"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/mappers"
+)
+
+// multipartOverhead is the room left in the request body for the multipart boundaries and headers.
+const multipartOverhead = 64 << 10
+
+type ProductImageHandler interface {
+	Upload(c *gin.Context)
+	Delete(c *gin.Context)
+	Serve(c *gin.Context)
+}
+
+type productImageHandler struct {
+	uploadProductImageUseCase usecase.UploadProductImageUseCase
+	deleteProductImageUseCase usecase.DeleteProductImageUseCase
+	getStoredImageUseCase     usecase.GetStoredImageUseCase
+	cfg                       *config.Config
+}
+
+func NewProductImageHandler(uploadProductImageUseCase usecase.UploadProductImageUseCase, deleteProductImageUseCase usecase.DeleteProductImageUseCase, getStoredImageUseCase usecase.GetStoredImageUseCase, cfg *config.Config) ProductImageHandler {
+	return &productImageHandler{uploadProductImageUseCase: uploadProductImageUseCase, deleteProductImageUseCase: deleteProductImageUseCase, getStoredImageUseCase: getStoredImageUseCase, cfg: cfg}
+}
+
+// Upload godoc
+// @Summary      Upload Product Image
+// @Description  Uploads a JPEG, PNG or GIF image of the product, up to STORAGE_MAX_UPLOAD_BYTES (5 MB by default) and 4096 pixels per side. Small (200px) and medium (600px) thumbnails are generated, all served from stable URLs
+// @Tags         products
+// @Accept       multipart/form-data
+// @Produce      json
+// @Param        id     path      int   true  "Product ID"
+// @Param        image  formData  file  true  "Image file"
+// @Success      201  {object}  dto.ProductImageOutput
+// @Failure      400  {object}  handler.ErrorResponse
+// @Failure      404  {object}  handler.ErrorResponse
+// @Failure      413  {object}  handler.ErrorResponse
+// @Failure      500  {object}  handler.ErrorResponse
+// @Router       /admin/products/{id}/images [post]
+func (h *productImageHandler) Upload(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	maxBytes := h.cfg.Storage.MaxUploadBytes
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxBytes+multipartOverhead)
+
+	fileHeader, err := c.FormFile("image")
+	if err != nil {
+		var maxBytesError *http.MaxBytesError
+		if errors.As(err, &maxBytesError) {
+			c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": fmt.Sprintf("image must be at most %d bytes", maxBytes)})
+			return
+		}
+
+		c.JSON(http.StatusBadRequest, gin.H{"error": "image file is required"})
+		return
+	}
+
+	if fileHeader.Size > maxBytes {
+		c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": fmt.Sprintf("image must be at most %d bytes", maxBytes)})
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	slog.Info("Uploading product image", "id", id, "filename", fileHeader.Filename, "size", len(data))
+
+	image, err := h.uploadProductImageUseCase.Run(c.Request.Context(), id, data)
+	if err != nil {
+		respondProductImageError(c, err)
+		return
+	}
+
+	slog.Info("Product image uploaded", "id", id, "image_id", image.ID, "key", image.StorageKey)
+
+	c.JSON(http.StatusCreated, mappers.ToProductImageDTO(*image))
+}
+
+// Delete godoc
+// @Summary      Delete Product Image
+// @Description  Removes the image from the product, uploaded files and thumbnails are deleted from the storage
+// @Tags         products
+// @Accept       json
+// @Produce      json
+// @Param        id        path  int  true  "Product ID"
+// @Param        image_id  path  int  true  "Image ID"
+// @Success      204 "No content"
+// @Failure      400  {object}  handler.ErrorResponse
+// @Failure      404  {object}  handler.ErrorResponse
+// @Failure      500  {object}  handler.ErrorResponse
+// @Router       /admin/products/{id}/images/{image_id} [delete]
+func (h *productImageHandler) Delete(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	imageID, err := strconv.Atoi(c.Param("image_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid image ID format"})
+		return
+	}
+
+	if err := h.deleteProductImageUseCase.Run(c.Request.Context(), id, imageID); err != nil {
+		respondProductImageError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// Serve godoc
+// @Summary      Get Image
+// @Description  Serves an uploaded product image or thumbnail. Keys are never reused, so responses are cached for a year
+// @Tags         products
+// @Produce      image/jpeg,image/png,image/gif
+// @Param        key  path  string  true  "Storage key, like products/1/{uuid}/small.jpg"
+// @Success      200  {file}    binary
+// @Failure      404  {object}  handler.ErrorResponse
+// @Failure      500  {object}  handler.ErrorResponse
+// @Router       /images/{key} [get]
+func (h *productImageHandler) Serve(c *gin.Context) {
+	key := strings.TrimPrefix(c.Param("key"), "/")
+
+	file, err := h.getStoredImageUseCase.Run(c.Request.Context(), key)
+	if err != nil {
+		respondProductImageError(c, err)
+		return
+	}
+	defer file.Body.Close()
+
+	c.DataFromReader(http.StatusOK, file.Size, file.ContentType, file.Body, map[string]string{
+		"Cache-Control":          "public, max-age=31536000, immutable",
+		"X-Content-Type-Options": "nosniff",
+	})
+}
+
+func respondProductImageError(c *gin.Context, err error) {
+	if errors.Is(err, &domainError.NotFoundError{}) {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	} else if errors.Is(err, &domainError.EntityNotProcessableError{}) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	} else {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
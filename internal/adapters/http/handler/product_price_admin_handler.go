@@ -0,0 +1,152 @@
+package handler
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	domainError "github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/error"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/validator"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/dto"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/mappers"
+)
+
+type ProductPriceAdminHandler interface {
+	GetAll(c *gin.Context)
+	Create(c *gin.Context)
+	Cancel(c *gin.Context)
+}
+
+type productPriceAdminHandler struct {
+	getProductPricesUseCase   usecase.GetProductPricesUseCase
+	changeProductPriceUseCase usecase.ChangeProductPriceUseCase
+	cancelProductPriceUseCase usecase.CancelProductPriceUseCase
+}
+
+func NewProductPriceAdminHandler(getProductPricesUseCase usecase.GetProductPricesUseCase, changeProductPriceUseCase usecase.ChangeProductPriceUseCase, cancelProductPriceUseCase usecase.CancelProductPriceUseCase) ProductPriceAdminHandler {
+	return &productPriceAdminHandler{getProductPricesUseCase: getProductPricesUseCase, changeProductPriceUseCase: changeProductPriceUseCase, cancelProductPriceUseCase: cancelProductPriceUseCase}
+}
+
+// GetAll godoc
+// @Summary      Get Product Price History
+// @Description  Lists every price of the product with its effective date and author, scheduled prices included, latest effective date first
+// @Tags         products
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Product ID"
+// @Success      200  {array}   dto.ProductPriceOutput
+// @Failure      400  {object}  handler.ErrorResponse
+// @Failure      404  {object}  handler.ErrorResponse
+// @Failure      500  {object}  handler.ErrorResponse
+// @Router       /admin/products/{id}/prices [get]
+func (h *productPriceAdminHandler) GetAll(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	prices, err := h.getProductPricesUseCase.Run(c.Request.Context(), id)
+	if err != nil {
+		respondProductPriceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, mappers.ToProductPricesDTO(prices))
+}
+
+// Create godoc
+// @Summary      Change Product Price
+// @Description  Changes the price at once or, with a future effective_at, schedules it. Scheduled prices are applied by a worker shortly after their effective date
+// @Tags         products
+// @Accept       json
+// @Produce      json
+// @Param        id            path    int                    true   "Product ID"
+// @Param        input         body    dto.ProductPriceInput  true   "Price data"
+// @Param        X-Admin-User  header  string                 false  "Admin recorded as the author of the price"
+// @Success      201  {object}  dto.ProductPriceOutput
+// @Failure      400  {object}  handler.ErrorResponse
+// @Failure      404  {object}  handler.ErrorResponse
+// @Failure      500  {object}  handler.ErrorResponse
+// @Router       /admin/products/{id}/prices [post]
+func (h *productPriceAdminHandler) Create(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	var input dto.ProductPriceInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := dto.ValidateProductPriceInput(input); err != nil {
+		errors := validator.HandleValidationError(err)
+		c.JSON(http.StatusBadRequest, gin.H{"errors": errors})
+		return
+	}
+
+	price, err := h.changeProductPriceUseCase.Run(c.Request.Context(), id, input, adminUser(c))
+	if err != nil {
+		respondProductPriceError(c, err)
+		return
+	}
+
+	slog.Info("Product price changed", "product_id", id, "price_id", price.ID, "status", price.Status())
+
+	c.JSON(http.StatusCreated, mappers.ToProductPriceDTO(*price))
+}
+
+// Cancel godoc
+// @Summary      Cancel Scheduled Product Price
+// @Description  Cancels a price that was not applied yet, it stays in the history as canceled
+// @Tags         products
+// @Accept       json
+// @Produce      json
+// @Param        id        path  int  true  "Product ID"
+// @Param        price_id  path  int  true  "Price ID"
+// @Success      204 "No content"
+// @Failure      400  {object}  handler.ErrorResponse
+// @Failure      404  {object}  handler.ErrorResponse
+// @Failure      409  {object}  handler.ErrorResponse
+// @Failure      500  {object}  handler.ErrorResponse
+// @Router       /admin/products/{id}/prices/{price_id} [delete]
+func (h *productPriceAdminHandler) Cancel(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	priceID, err := strconv.Atoi(c.Param("price_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid price ID format"})
+		return
+	}
+
+	err = h.cancelProductPriceUseCase.Run(c.Request.Context(), id, priceID)
+	if errors.Is(err, &domainError.EntityNotProcessableError{}) {
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		return
+	} else if err != nil {
+		respondProductPriceError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func respondProductPriceError(c *gin.Context, err error) {
+	if errors.Is(err, &domainError.NotFoundError{}) {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	} else if errors.Is(err, &domainError.EntityNotProcessableError{}) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	} else {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
@@ -0,0 +1,137 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	domainError "github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/error"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/mappers"
+)
+
+const (
+	defaultRecommendationLimit = 4
+	maxRecommendationLimit     = 20
+	maxCartProducts            = 50
+)
+
+type ProductRecommendationHandler interface {
+	GetByProduct(c *gin.Context)
+	GetByCart(c *gin.Context)
+}
+
+type productRecommendationHandler struct {
+	getProductRecommendationsUseCase usecase.GetProductRecommendationsUseCase
+	getCartRecommendationsUseCase    usecase.GetCartRecommendationsUseCase
+}
+
+func NewProductRecommendationHandler(getProductRecommendationsUseCase usecase.GetProductRecommendationsUseCase, getCartRecommendationsUseCase usecase.GetCartRecommendationsUseCase) ProductRecommendationHandler {
+	return &productRecommendationHandler{
+		getProductRecommendationsUseCase: getProductRecommendationsUseCase,
+		getCartRecommendationsUseCase:    getCartRecommendationsUseCase,
+	}
+}
+
+// GetByProduct godoc
+// @Summary      Get Product Recommendations
+// @Description  Products frequently bought together with the product in paid orders, most frequent first. Only products that can be ordered now are recommended
+// @Tags         products
+// @Accept       json
+// @Produce      json
+// @Param        id     path   int  true   "Product ID"
+// @Param        limit  query  int  false  "How many products to recommend, 4 by default and at most 20"
+// @Success      200  {array}   dto.ProductOutput
+// @Failure      400  {object}  handler.ErrorResponse
+// @Failure      404  {object}  handler.ErrorResponse
+// @Failure      500  {object}  handler.ErrorResponse
+// @Router       /products/{id}/recommendations [get]
+func (h *productRecommendationHandler) GetByProduct(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	limit, ok := recommendationLimit(c)
+	if !ok {
+		return
+	}
+
+	products, err := h.getProductRecommendationsUseCase.Run(c.Request.Context(), id, limit)
+	if err != nil {
+		if errors.Is(err, &domainError.NotFoundError{}) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, mappers.ToProductsDTO(products))
+}
+
+// GetByCart godoc
+// @Summary      Get Cart Recommendations
+// @Description  Products frequently bought together with those in the cart, like a dessert for a burger and fries. Products already in the cart are never suggested
+// @Tags         products
+// @Accept       json
+// @Produce      json
+// @Param        product_ids  query  string  true   "Comma-separated ids of the products in the cart, like 1,5,8"
+// @Param        limit        query  int     false  "How many products to recommend, 4 by default and at most 20"
+// @Success      200  {array}   dto.ProductOutput
+// @Failure      400  {object}  handler.ErrorResponse
+// @Failure      500  {object}  handler.ErrorResponse
+// @Router       /products/recommendations [get]
+func (h *productRecommendationHandler) GetByCart(c *gin.Context) {
+	var productIDs []int
+	for _, value := range strings.Split(c.Query("product_ids"), ",") {
+		if value = strings.TrimSpace(value); value == "" {
+			continue
+		}
+
+		id, err := strconv.Atoi(value)
+		if err != nil || id <= 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("Invalid product id %q", value)})
+			return
+		}
+		productIDs = append(productIDs, id)
+	}
+
+	if len(productIDs) == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Inform the products in the cart in product_ids"})
+		return
+	}
+
+	if len(productIDs) > maxCartProducts {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("The cart must have at most %d products", maxCartProducts)})
+		return
+	}
+
+	limit, ok := recommendationLimit(c)
+	if !ok {
+		return
+	}
+
+	products, err := h.getCartRecommendationsUseCase.Run(c.Request.Context(), productIDs, limit)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, mappers.ToProductsDTO(products))
+}
+
+func recommendationLimit(c *gin.Context) (int, bool) {
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", strconv.Itoa(defaultRecommendationLimit)))
+	if err != nil || limit < 1 || limit > maxRecommendationLimit {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("Invalid limit, use a number from 1 to %d", maxRecommendationLimit)})
+		return 0, false
+	}
+
+	return limit, true
+}
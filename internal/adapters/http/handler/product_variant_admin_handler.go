@@ -0,0 +1,205 @@
+package handler
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	domainError "github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/error"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/validator"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/dto"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/mappers"
+)
+
+type ProductVariantAdminHandler interface {
+	GetAll(c *gin.Context)
+	Create(c *gin.Context)
+	Update(c *gin.Context)
+	Delete(c *gin.Context)
+}
+
+type productVariantAdminHandler struct {
+	getProductVariantsUseCase   usecase.GetProductVariantsUseCase
+	createProductVariantUseCase usecase.CreateProductVariantUseCase
+	updateProductVariantUseCase usecase.UpdateProductVariantUseCase
+	deleteProductVariantUseCase usecase.DeleteProductVariantUseCase
+}
+
+func NewProductVariantAdminHandler(getProductVariantsUseCase usecase.GetProductVariantsUseCase, createProductVariantUseCase usecase.CreateProductVariantUseCase, updateProductVariantUseCase usecase.UpdateProductVariantUseCase, deleteProductVariantUseCase usecase.DeleteProductVariantUseCase) ProductVariantAdminHandler {
+	return &productVariantAdminHandler{
+		getProductVariantsUseCase:   getProductVariantsUseCase,
+		createProductVariantUseCase: createProductVariantUseCase,
+		updateProductVariantUseCase: updateProductVariantUseCase,
+		deleteProductVariantUseCase: deleteProductVariantUseCase,
+	}
+}
+
+// GetAll godoc
+// @Summary      Get Product Variants
+// @Description  Lists the sizes of the product in display order, with their current stock
+// @Tags         products
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Product ID"
+// @Success      200  {array}   dto.ProductVariantOutput
+// @Failure      400  {object}  handler.ErrorResponse
+// @Failure      404  {object}  handler.ErrorResponse
+// @Failure      500  {object}  handler.ErrorResponse
+// @Router       /admin/products/{id}/variants [get]
+func (h *productVariantAdminHandler) GetAll(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	variants, err := h.getProductVariantsUseCase.Run(c.Request.Context(), id)
+	if err != nil {
+		respondProductVariantError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, mappers.ToProductVariantsDTO(variants))
+}
+
+// Create godoc
+// @Summary      Create Product Variant
+// @Description  Adds a size to the product, like 500ml or G. Products with variants are ordered by variant, each at its own price
+// @Tags         products
+// @Accept       json
+// @Produce      json
+// @Param        id     path    int                      true  "Product ID"
+// @Param        input  body    dto.ProductVariantInput  true  "Variant data"
+// @Success      201  {object}  dto.ProductVariantOutput
+// @Failure      400  {object}  handler.ErrorResponse
+// @Failure      404  {object}  handler.ErrorResponse
+// @Failure      500  {object}  handler.ErrorResponse
+// @Router       /admin/products/{id}/variants [post]
+func (h *productVariantAdminHandler) Create(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	input, ok := bindProductVariantInput(c)
+	if !ok {
+		return
+	}
+
+	variant, err := h.createProductVariantUseCase.Run(c.Request.Context(), id, input)
+	if err != nil {
+		respondProductVariantError(c, err)
+		return
+	}
+
+	slog.Info("Product variant created", "product_id", id, "variant_id", variant.ID)
+
+	c.JSON(http.StatusCreated, mappers.ToProductVariantDTO(*variant))
+}
+
+// Update godoc
+// @Summary      Update Product Variant
+// @Description  Replaces the variant, orders already placed keep the price they were charged
+// @Tags         products
+// @Accept       json
+// @Produce      json
+// @Param        id          path  int                      true  "Product ID"
+// @Param        variant_id  path  int                      true  "Variant ID"
+// @Param        input       body  dto.ProductVariantInput  true  "Variant data"
+// @Success      200  {object}  dto.ProductVariantOutput
+// @Failure      400  {object}  handler.ErrorResponse
+// @Failure      404  {object}  handler.ErrorResponse
+// @Failure      500  {object}  handler.ErrorResponse
+// @Router       /admin/products/{id}/variants/{variant_id} [put]
+func (h *productVariantAdminHandler) Update(c *gin.Context) {
+	id, variantID, ok := productVariantIDs(c)
+	if !ok {
+		return
+	}
+
+	input, ok := bindProductVariantInput(c)
+	if !ok {
+		return
+	}
+
+	variant, err := h.updateProductVariantUseCase.Run(c.Request.Context(), id, variantID, input)
+	if err != nil {
+		respondProductVariantError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, mappers.ToProductVariantDTO(*variant))
+}
+
+// Delete godoc
+// @Summary      Delete Product Variant
+// @Description  Removes the variant from the menu, once the last one is removed the product is ordered at its own price again
+// @Tags         products
+// @Accept       json
+// @Produce      json
+// @Param        id          path  int  true  "Product ID"
+// @Param        variant_id  path  int  true  "Variant ID"
+// @Success      204 "No content"
+// @Failure      400  {object}  handler.ErrorResponse
+// @Failure      404  {object}  handler.ErrorResponse
+// @Failure      500  {object}  handler.ErrorResponse
+// @Router       /admin/products/{id}/variants/{variant_id} [delete]
+func (h *productVariantAdminHandler) Delete(c *gin.Context) {
+	id, variantID, ok := productVariantIDs(c)
+	if !ok {
+		return
+	}
+
+	if err := h.deleteProductVariantUseCase.Run(c.Request.Context(), id, variantID); err != nil {
+		respondProductVariantError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func productVariantIDs(c *gin.Context) (int, int, bool) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return 0, 0, false
+	}
+
+	variantID, err := strconv.Atoi(c.Param("variant_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid variant ID format"})
+		return 0, 0, false
+	}
+
+	return id, variantID, true
+}
+
+func bindProductVariantInput(c *gin.Context) (dto.ProductVariantInput, bool) {
+	var input dto.ProductVariantInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return input, false
+	}
+
+	if err := dto.ValidateProductVariantInput(input); err != nil {
+		errors := validator.HandleValidationError(err)
+		c.JSON(http.StatusBadRequest, gin.H{"errors": errors})
+		return input, false
+	}
+
+	return input, true
+}
+
+func respondProductVariantError(c *gin.Context, err error) {
+	if errors.Is(err, &domainError.NotFoundError{}) {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	} else if errors.Is(err, &domainError.EntityNotProcessableError{}) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	} else {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
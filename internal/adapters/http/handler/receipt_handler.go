@@ -0,0 +1,88 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/adapters/db/repository"
+	domainError "github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/error"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/mappers"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+)
+
+type ReceiptHandler interface {
+	GetByOrder(c *gin.Context)
+}
+
+type receiptHandler struct {
+	getOrderReceiptUseCase usecase.GetOrderReceiptUseCase
+	rendererResolver       ports.ReceiptRendererResolver
+}
+
+func NewReceiptHandler(getOrderReceiptUseCase usecase.GetOrderReceiptUseCase, rendererResolver ports.ReceiptRendererResolver) ReceiptHandler {
+	return &receiptHandler{getOrderReceiptUseCase: getOrderReceiptUseCase, rendererResolver: rendererResolver}
+}
+
+// GetByOrder godoc
+// @Summary      Obtém o recibo de um pedido pago
+// @Description  Retorna o recibo do pedido com itens, descontos, taxas, forma de pagamento e CPF do consumidor. Use format=pdf para a versão para impressão ou format=xml para exportar no layout da NFC-e (sem assinatura)
+// @Tags         orders
+// @Produce      json
+// @Produce      application/pdf
+// @Produce      application/xml
+// @Param        id      path      int     true   "ID do Pedido"
+// @Param        format  query     string  false  "Formato do recibo"  Enums(json, pdf, xml)  default(json)
+// @Success      200     {object}  dto.ReceiptOutput
+// @Failure      400     {object}  handler.ErrorResponse
+// @Failure      404     {object}  handler.ErrorResponse
+// @Failure      422     {object}  handler.ErrorResponse
+// @Failure      500     {object}  handler.ErrorResponse
+// @Router       /orders/{id}/receipt [get]
+func (h *receiptHandler) GetByOrder(c *gin.Context) {
+	orderID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid order ID"})
+		return
+	}
+
+	format := c.DefaultQuery("format", "json")
+
+	var renderer ports.ReceiptRenderer
+	if format != "json" {
+		renderer, err = h.rendererResolver.Resolve(format)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	receipt, err := h.getOrderReceiptUseCase.Run(c.Request.Context(), orderID)
+	if err != nil {
+		if err == repository.ErrOrderNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Order not found"})
+		} else if errors.Is(err, &domainError.EntityNotProcessableError{}) {
+			c.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	if renderer == nil {
+		c.JSON(http.StatusOK, mappers.ToReceiptDTO(*receipt))
+		return
+	}
+
+	document, err := renderer.Render(*receipt)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf("inline; filename=\"recibo-%d.%s\"", receipt.Number, format))
+	c.Data(http.StatusOK, renderer.ContentType(), document)
+}
@@ -0,0 +1,95 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/validator"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/dto"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/mappers"
+)
+
+type RecipeAdminHandler interface {
+	Get(c *gin.Context)
+	Update(c *gin.Context)
+}
+
+type recipeAdminHandler struct {
+	getProductRecipeUseCase    usecase.GetProductRecipeUseCase
+	updateProductRecipeUseCase usecase.UpdateProductRecipeUseCase
+}
+
+func NewRecipeAdminHandler(getProductRecipeUseCase usecase.GetProductRecipeUseCase, updateProductRecipeUseCase usecase.UpdateProductRecipeUseCase) RecipeAdminHandler {
+	return &recipeAdminHandler{getProductRecipeUseCase: getProductRecipeUseCase, updateProductRecipeUseCase: updateProductRecipeUseCase}
+}
+
+// Get godoc
+// @Summary      Obtém a receita de um produto
+// @Description  Lista os ingredientes usados para fazer uma unidade do produto
+// @Tags         ingredients
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "ID do Produto"
+// @Success      200  {object}  dto.RecipeOutput
+// @Failure      400  {object}  handler.ErrorResponse
+// @Failure      404  {object}  handler.ErrorResponse
+// @Failure      500  {object}  handler.ErrorResponse
+// @Router       /admin/products/{id}/recipe [get]
+func (h *recipeAdminHandler) Get(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	items, err := h.getProductRecipeUseCase.Run(c.Request.Context(), id)
+	if err != nil {
+		respondIngredientError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, mappers.ToRecipeDTO(id, items))
+}
+
+// Update godoc
+// @Summary      Substitui a receita de um produto
+// @Description  Substitui os ingredientes do produto, uma lista vazia remove a receita. O consumo é lançado quando o pedido entra em preparo e o produto é desativado enquanto faltar algum ingrediente
+// @Tags         ingredients
+// @Accept       json
+// @Produce      json
+// @Param        id     path      int              true  "ID do Produto"
+// @Param        input  body      dto.RecipeInput  true  "Ingredientes da Receita"
+// @Success      200    {object}  dto.RecipeOutput
+// @Failure      400    {object}  handler.ErrorResponse
+// @Failure      404    {object}  handler.ErrorResponse
+// @Failure      500    {object}  handler.ErrorResponse
+// @Router       /admin/products/{id}/recipe [put]
+func (h *recipeAdminHandler) Update(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	var input dto.RecipeInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := dto.ValidateRecipeInput(input); err != nil {
+		errors := validator.HandleValidationError(err)
+		c.JSON(http.StatusBadRequest, gin.H{"errors": errors})
+		return
+	}
+
+	items, err := h.updateProductRecipeUseCase.Run(c.Request.Context(), id, input)
+	if err != nil {
+		respondIngredientError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, mappers.ToRecipeDTO(id, items))
+}
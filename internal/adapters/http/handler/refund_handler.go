@@ -0,0 +1,111 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/adapters/db/repository"
+	domainError "github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/error"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/validator"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/dto"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/mappers"
+)
+
+type RefundHandler interface {
+	Create(c *gin.Context)
+	GetByOrder(c *gin.Context)
+}
+
+type refundHandler struct {
+	createRefundUseCase    usecase.CreateRefundUseCase
+	getOrderRefundsUseCase usecase.GetOrderRefundsUseCase
+}
+
+func NewRefundHandler(createRefundUseCase usecase.CreateRefundUseCase, getOrderRefundsUseCase usecase.GetOrderRefundsUseCase) RefundHandler {
+	return &refundHandler{createRefundUseCase: createRefundUseCase, getOrderRefundsUseCase: getOrderRefundsUseCase}
+}
+
+// Create godoc
+// @Summary      Estorna o pagamento de um pedido
+// @Description  Cria um estorno total (sem amount) ou parcial para o pagamento aprovado do pedido
+// @Tags         refunds
+// @Accept       json
+// @Produce      json
+// @Param        id     path      int              true  "ID do Pedido"
+// @Param        input  body      dto.RefundInput  true  "Dados do Estorno"
+// @Success      201    {object}  dto.RefundOutput
+// @Failure      400    {object}  handler.ErrorResponse
+// @Failure      404    {object}  handler.ErrorResponse
+// @Failure      500    {object}  handler.ErrorResponse
+// @Failure      503    {object}  handler.ErrorResponse
+// @Router       /admin/orders/{id}/refunds [post]
+func (h *refundHandler) Create(c *gin.Context) {
+	orderID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid order ID"})
+		return
+	}
+
+	var input dto.RefundInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := dto.ValidateRefundInput(input); err != nil {
+		errors := validator.HandleValidationError(err)
+		c.JSON(http.StatusBadRequest, gin.H{"errors": errors})
+		return
+	}
+
+	refund, err := h.createRefundUseCase.Run(c.Request.Context(), orderID, input)
+	if err != nil {
+		if err == repository.ErrOrderNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Order not found"})
+		} else if errors.Is(err, &domainError.EntityNotProcessableError{}) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		} else if errors.Is(err, &domainError.ServiceUnavailableError{}) {
+			c.JSON(http.StatusServiceUnavailable, gin.H{"error": err.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, mappers.ToRefundDTO(*refund))
+}
+
+// GetByOrder godoc
+// @Summary      Lista os estornos de um pedido
+// @Description  Lista todos os estornos solicitados para o pagamento do pedido
+// @Tags         refunds
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "ID do Pedido"
+// @Success      200  {array}   dto.RefundOutput
+// @Failure      400  {object}  handler.ErrorResponse
+// @Failure      404  {object}  handler.ErrorResponse
+// @Failure      500  {object}  handler.ErrorResponse
+// @Router       /admin/orders/{id}/refunds [get]
+func (h *refundHandler) GetByOrder(c *gin.Context) {
+	orderID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid order ID"})
+		return
+	}
+
+	refunds, err := h.getOrderRefundsUseCase.Run(c.Request.Context(), orderID)
+	if err != nil {
+		if err == repository.ErrOrderNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Order not found"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, mappers.ToRefundsDTO(refunds))
+}
@@ -0,0 +1,93 @@
+package handler
+
+import (
+	_ "embed"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/validator"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/dto"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/mappers"
+)
+
+//go:embed templates/sandbox.html
+var sandboxPage []byte
+
+type SandboxHandler interface {
+	Page(c *gin.Context)
+	GetPayments(c *gin.Context)
+	Notify(c *gin.Context)
+}
+
+type sandboxHandler struct {
+	listSandboxPaymentsUseCase         usecase.ListSandboxPaymentsUseCase
+	simulatePaymentNotificationUseCase usecase.SimulatePaymentNotificationUseCase
+}
+
+func NewSandboxHandler(listSandboxPaymentsUseCase usecase.ListSandboxPaymentsUseCase, simulatePaymentNotificationUseCase usecase.SimulatePaymentNotificationUseCase) SandboxHandler {
+	return &sandboxHandler{listSandboxPaymentsUseCase: listSandboxPaymentsUseCase, simulatePaymentNotificationUseCase: simulatePaymentNotificationUseCase}
+}
+
+// Page serves the sandbox page, a thin client of the sandbox API.
+func (h *sandboxHandler) Page(c *gin.Context) {
+	c.Data(http.StatusOK, "text/html; charset=utf-8", sandboxPage)
+}
+
+// GetPayments godoc
+// @Summary      Lista os pagamentos pendentes (sandbox)
+// @Description  Disponível apenas com SANDBOX_ENABLED=true. Lista os pagamentos que aguardam a notificação do gateway
+// @Tags         sandbox
+// @Produce      json
+// @Success      200  {array}   dto.SandboxPaymentOutput
+// @Failure      500  {object}  handler.ErrorResponse
+// @Router       /sandbox/payments [get]
+func (h *sandboxHandler) GetPayments(c *gin.Context) {
+	payments, err := h.listSandboxPaymentsUseCase.Run(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, mappers.MapPaymentsToSandboxOutput(payments))
+}
+
+// Notify godoc
+// @Summary      Simula a notificação do gateway para um pagamento (sandbox)
+// @Description  Disponível apenas com SANDBOX_ENABLED=true. Envia ao webhook de pagamentos uma notificação assinada aprovando ou recusando o pagamento. Com delay_seconds a entrega é agendada e a resposta é 202, duplicates envia cópias simultâneas para reproduzir entregas concorrentes
+// @Tags         sandbox
+// @Accept       json
+// @Produce      json
+// @Param        external_reference  path      string                        true  "Referência externa do pagamento"
+// @Param        notification        body      dto.SandboxNotificationInput  true  "Notificação"
+// @Success      200  {object}  map[string]string
+// @Success      202  {object}  map[string]string
+// @Failure      400  {object}  handler.ErrorResponse
+// @Failure      502  {object}  handler.ErrorResponse
+// @Router       /sandbox/payments/{external_reference}/notifications [post]
+func (h *sandboxHandler) Notify(c *gin.Context) {
+	var input dto.SandboxNotificationInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := dto.ValidateSandboxNotificationInput(input); err != nil {
+		errors := validator.HandleValidationError(err)
+		c.JSON(http.StatusBadRequest, gin.H{"errors": errors})
+		return
+	}
+
+	err := h.simulatePaymentNotificationUseCase.Run(c.Request.Context(), c.Param("external_reference"), input)
+	if err != nil {
+		c.JSON(http.StatusBadGateway, gin.H{"error": err.Error()})
+		return
+	}
+
+	if input.DelaySeconds > 0 {
+		c.JSON(http.StatusAccepted, gin.H{"message": "Notification scheduled"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Notification delivered"})
+}
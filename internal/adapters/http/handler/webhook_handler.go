@@ -1,10 +1,12 @@
 package handler
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
 	"github.com/go-playground/validator/v10"
+	domainError "github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/error"
 	ineternalValidator "github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/validator"
 	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase"
 	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/dto"
@@ -12,14 +14,16 @@ import (
 
 type WebhookHandler interface {
 	ProcessPayment(c *gin.Context)
+	ProcessRefund(c *gin.Context)
 }
 
 type webhookHandler struct {
 	procecssPaymentUseCase usecase.ProcessPaymentUseCase
+	processRefundUseCase   usecase.ProcessRefundUseCase
 }
 
-func NewWebhookHandler(procecssPaymentUseCase usecase.ProcessPaymentUseCase) WebhookHandler {
-	return &webhookHandler{procecssPaymentUseCase: procecssPaymentUseCase}
+func NewWebhookHandler(procecssPaymentUseCase usecase.ProcessPaymentUseCase, processRefundUseCase usecase.ProcessRefundUseCase) WebhookHandler {
+	return &webhookHandler{procecssPaymentUseCase: procecssPaymentUseCase, processRefundUseCase: processRefundUseCase}
 }
 
 func (h *webhookHandler) ProcessPayment(c *gin.Context) {
@@ -37,10 +41,42 @@ func (h *webhookHandler) ProcessPayment(c *gin.Context) {
 	}
 
 	err := h.procecssPaymentUseCase.Run(c.Request.Context(), paymentInput.ExternalReference, paymentInput.PaymentMethod, paymentInput.Status)
-	if err != nil {
+	if errors.Is(err, &domainError.NotFoundError{}) {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	} else if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{"message": "Payment processed successfully"})
 }
+
+func (h *webhookHandler) ProcessRefund(c *gin.Context) {
+	var refundInput dto.RefundWebhookInputDTO
+
+	if err := c.ShouldBindJSON(&refundInput); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := validator.New().Struct(refundInput); err != nil {
+		errors := ineternalValidator.HandleValidationError(err)
+		c.JSON(http.StatusBadRequest, gin.H{"errors": errors})
+		return
+	}
+
+	err := h.processRefundUseCase.Run(c.Request.Context(), refundInput.ExternalReference, refundInput.Status)
+	if err != nil {
+		if errors.Is(err, &domainError.NotFoundError{}) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		} else if errors.Is(err, &domainError.EntityNotProcessableError{}) {
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Refund processed successfully"})
+}
@@ -0,0 +1,38 @@
+package middleware
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/shared"
+)
+
+// WebhookSignature rejects notifications that are not signed with the shared secret. Without a
+// secret every notification is accepted, which keeps local setups working.
+func WebhookSignature(secret string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if secret == "" {
+			c.Next()
+			return
+		}
+
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		// The handlers bind the body again
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		err = shared.VerifyWebhookSignature(secret, c.GetHeader(shared.WebhookTimestampHeader), c.GetHeader(shared.WebhookSignatureHeader), body, time.Now())
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -6,6 +6,8 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/adapters/http/handler"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/adapters/http/middleware"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/config"
 )
 
 type Router struct {
@@ -13,6 +15,7 @@ type Router struct {
 }
 
 func NewRouter(
+	cfg *config.Config,
 	healthcheckHandler handler.HealthcheckHandler,
 	clientHandler handler.ClientHandler,
 	productHandler handler.ProductHandler,
@@ -20,6 +23,23 @@ func NewRouter(
 	orderHandler handler.OrderHandler,
 	checkoutHandler handler.CheckoutHandler,
 	webhookHandler handler.WebhookHandler,
+	refundHandler handler.RefundHandler,
+	paymentAdminHandler handler.PaymentAdminHandler,
+	paymentTaxSettingsAdminHandler handler.PaymentTaxSettingsAdminHandler,
+	couponAdminHandler handler.CouponAdminHandler,
+	receiptHandler handler.ReceiptHandler,
+	categoryHandler handler.CategoryHandler,
+	categoryAdminHandler handler.CategoryAdminHandler,
+	sandboxHandler handler.SandboxHandler,
+	ingredientAdminHandler handler.IngredientAdminHandler,
+	recipeAdminHandler handler.RecipeAdminHandler,
+	productImageHandler handler.ProductImageHandler,
+	productPriceAdminHandler handler.ProductPriceAdminHandler,
+	availabilityAdminHandler handler.AvailabilityAdminHandler,
+	catalogAdminHandler handler.CatalogAdminHandler,
+	productCacheAdminHandler handler.ProductCacheAdminHandler,
+	productVariantAdminHandler handler.ProductVariantAdminHandler,
+	productRecommendationHandler handler.ProductRecommendationHandler,
 ) Router {
 	engine := gin.Default()
 
@@ -36,16 +56,28 @@ func NewRouter(
 		{
 			clients.POST("/", clientHandler.Create)
 			clients.GET("/:cpf", clientHandler.GetByCPF)
+			clients.GET("/:cpf/loyalty", clientHandler.GetLoyalty)
 		}
 
 		products := v1.Group("/products")
 		{
-			products.GET("/", productHandler.GetProducts)
+			products.GET("/", middleware.ConditionalGET(cfg.HTTPCache.Products), productHandler.GetProducts)
+			products.GET("/recommendations", productRecommendationHandler.GetByCart)
+			products.GET("/:id/recommendations", productRecommendationHandler.GetByProduct)
+		}
+
+		v1.GET("/images/*key", productImageHandler.Serve)
+
+		categories := v1.Group("/categories")
+		{
+			categories.GET("/", middleware.ConditionalGET(cfg.HTTPCache.Categories), categoryHandler.GetCategories)
 		}
 
 		orders := v1.Group("/orders")
 		{
 			orders.GET("/:id", orderHandler.GetById)
+			orders.GET("/:id/receipt", receiptHandler.GetByOrder)
+			orders.POST("/:id/payments", orderHandler.RetryPayment)
 		}
 
 		checkout := v1.Group("/checkout")
@@ -53,9 +85,10 @@ func NewRouter(
 			checkout.POST("/", checkoutHandler.Create)
 		}
 
-		webhooks := v1.Group("/webhooks")
+		webhooks := v1.Group("/webhooks", middleware.WebhookSignature(cfg.Webhook.Secret))
 		{
 			webhooks.POST("/notifications", webhookHandler.ProcessPayment)
+			webhooks.POST("/refunds", webhookHandler.ProcessRefund)
 		}
 
 		admin := v1.Group("/admin")
@@ -65,17 +98,97 @@ func NewRouter(
 				adminOrders.GET("/", orderHandler.GetAll)
 				adminOrders.PATCH("/:id/ready", orderHandler.UpdateOrderStatusToReady)
 				adminOrders.PATCH("/:id/delivered", orderHandler.UpdateOrderStatusToDelivered)
+				adminOrders.POST("/:id/refunds", refundHandler.Create)
+				adminOrders.GET("/:id/refunds", refundHandler.GetByOrder)
+			}
+
+			adminPayments := admin.Group("/payments")
+			{
+				adminPayments.GET("/reconciliation", paymentAdminHandler.GetReconciliationSummary)
+				adminPayments.POST("/reconciliation", paymentAdminHandler.Reconcile)
+			}
+
+			adminFees := admin.Group("/fees")
+			{
+				adminFees.GET("/", paymentTaxSettingsAdminHandler.GetAll)
+				adminFees.POST("/", paymentTaxSettingsAdminHandler.Create)
+				adminFees.PUT("/:id", paymentTaxSettingsAdminHandler.Update)
+				adminFees.DELETE("/:id", paymentTaxSettingsAdminHandler.Delete)
+			}
+
+			adminCoupons := admin.Group("/coupons")
+			{
+				adminCoupons.GET("/", couponAdminHandler.GetAll)
+				adminCoupons.POST("/", couponAdminHandler.Create)
+				adminCoupons.PUT("/:id", couponAdminHandler.Update)
+				adminCoupons.DELETE("/:id", couponAdminHandler.Delete)
 			}
 
 			adminProducts := admin.Group("/products")
 			{
+				adminProducts.GET("/", adminProductHandler.GetAll)
 				adminProducts.POST("/", adminProductHandler.Create)
+				adminProducts.POST("/import", catalogAdminHandler.Import)
+				adminProducts.GET("/export", catalogAdminHandler.Export)
+				adminProducts.GET("/cache", productCacheAdminHandler.GetStats)
+				adminProducts.DELETE("/cache", productCacheAdminHandler.Invalidate)
 				adminProducts.PUT("/:id", adminProductHandler.Update)
 				adminProducts.DELETE("/:id", adminProductHandler.Delete)
+				adminProducts.POST("/:id/restore", adminProductHandler.Restore)
+				adminProducts.DELETE("/:id/purge", adminProductHandler.Purge)
+				adminProducts.PUT("/:id/stock", adminProductHandler.UpdateStock)
+				adminProducts.GET("/:id/recipe", recipeAdminHandler.Get)
+				adminProducts.PUT("/:id/recipe", recipeAdminHandler.Update)
+				adminProducts.POST("/:id/images", productImageHandler.Upload)
+				adminProducts.DELETE("/:id/images/:image_id", productImageHandler.Delete)
+				adminProducts.GET("/:id/prices", productPriceAdminHandler.GetAll)
+				adminProducts.POST("/:id/prices", productPriceAdminHandler.Create)
+				adminProducts.DELETE("/:id/prices/:price_id", productPriceAdminHandler.Cancel)
+				adminProducts.GET("/:id/availability", availabilityAdminHandler.GetProduct)
+				adminProducts.PUT("/:id/availability", availabilityAdminHandler.UpdateProduct)
+				adminProducts.GET("/:id/variants", productVariantAdminHandler.GetAll)
+				adminProducts.POST("/:id/variants", productVariantAdminHandler.Create)
+				adminProducts.PUT("/:id/variants/:variant_id", productVariantAdminHandler.Update)
+				adminProducts.DELETE("/:id/variants/:variant_id", productVariantAdminHandler.Delete)
+			}
+
+			adminIngredients := admin.Group("/ingredients")
+			{
+				adminIngredients.GET("/", ingredientAdminHandler.GetAll)
+				adminIngredients.GET("/report", ingredientAdminHandler.GetReport)
+				adminIngredients.POST("/", ingredientAdminHandler.Create)
+				adminIngredients.PUT("/:id", ingredientAdminHandler.Update)
+				adminIngredients.DELETE("/:id", ingredientAdminHandler.Delete)
+				adminIngredients.POST("/:id/receipts", ingredientAdminHandler.Receive)
+				adminIngredients.POST("/:id/counts", ingredientAdminHandler.Count)
+				adminIngredients.GET("/:id/movements", ingredientAdminHandler.GetMovements)
+			}
+
+			adminCategories := admin.Group("/categories")
+			{
+				adminCategories.GET("/", categoryAdminHandler.GetAll)
+				adminCategories.GET("/:id", categoryAdminHandler.GetByID)
+				adminCategories.POST("/", categoryAdminHandler.Create)
+				adminCategories.PUT("/:id", categoryAdminHandler.Update)
+				adminCategories.DELETE("/:id", categoryAdminHandler.Delete)
+				adminCategories.POST("/:id/products/reassign", categoryAdminHandler.ReassignProducts)
+				adminCategories.GET("/:id/availability", availabilityAdminHandler.GetCategory)
+				adminCategories.PUT("/:id/availability", availabilityAdminHandler.UpdateCategory)
 			}
 		}
 	}
 
+	// Development payment simulator, never enabled in production
+	if cfg.Sandbox.Enabled {
+		engine.GET("/sandbox", sandboxHandler.Page)
+
+		sandbox := v1.Group("/sandbox")
+		{
+			sandbox.GET("/payments", sandboxHandler.GetPayments)
+			sandbox.POST("/payments/:external_reference/notifications", sandboxHandler.Notify)
+		}
+	}
+
 	return Router{engine: engine}
 }
 
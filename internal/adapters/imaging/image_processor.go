@@ -0,0 +1,136 @@
+package imaging
+
+import (
+	"bytes"
+	"image"
+	"image/draw"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+)
+
+const thumbnailJPEGQuality = 85
+
+type imageProcessor struct{}
+
+// NewImageProcessor handles JPEG, PNG and GIF with the standard library only. JPEG thumbnails
+// stay JPEG, the others become PNG so transparency is kept, GIFs keep only their first frame.
+func NewImageProcessor() ports.ImageProcessor {
+	return &imageProcessor{}
+}
+
+func (p *imageProcessor) Inspect(data []byte) (entities.ImageUpload, error) {
+	upload := entities.ImageUpload{Data: data, ContentType: http.DetectContentType(data)}
+	if _, ok := entities.ImageExtension(upload.ContentType); !ok {
+		return upload, nil
+	}
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return upload, err
+	}
+
+	upload.Width = config.Width
+	upload.Height = config.Height
+
+	return upload, nil
+}
+
+func (p *imageProcessor) Thumbnails(upload entities.ImageUpload, sizes []entities.ThumbnailSize) ([]entities.Thumbnail, error) {
+	decoded, err := decode(upload)
+	if err != nil {
+		return nil, err
+	}
+
+	source := image.NewRGBA(decoded.Bounds())
+	draw.Draw(source, source.Bounds(), decoded, decoded.Bounds().Min, draw.Src)
+
+	thumbnails := make([]entities.Thumbnail, 0, len(sizes))
+	for _, size := range sizes {
+		width, height := fit(source.Bounds().Dx(), source.Bounds().Dy(), size.MaxSide)
+		resized := resize(source, width, height)
+
+		var buffer bytes.Buffer
+		contentType := "image/png"
+		if upload.ContentType == "image/jpeg" {
+			contentType = "image/jpeg"
+			err = jpeg.Encode(&buffer, resized, &jpeg.Options{Quality: thumbnailJPEGQuality})
+		} else {
+			err = png.Encode(&buffer, resized)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		thumbnails = append(thumbnails, entities.Thumbnail{Size: size, Data: buffer.Bytes(), ContentType: contentType})
+	}
+
+	return thumbnails, nil
+}
+
+func decode(upload entities.ImageUpload) (image.Image, error) {
+	reader := bytes.NewReader(upload.Data)
+	switch upload.ContentType {
+	case "image/jpeg":
+		return jpeg.Decode(reader)
+	case "image/gif":
+		return gif.Decode(reader)
+	default:
+		return png.Decode(reader)
+	}
+}
+
+// fit scales the dimensions down, keeping the aspect ratio, until the longest side is maxSide.
+func fit(width, height, maxSide int) (int, int) {
+	if width <= maxSide && height <= maxSide {
+		return width, height
+	}
+
+	if width >= height {
+		return maxSide, max(1, height*maxSide/width)
+	}
+
+	return max(1, width*maxSide/height), maxSide
+}
+
+// resize averages the source pixels covered by each target pixel, a box filter that gives smooth
+// results when shrinking. The RGBA values are alpha premultiplied, so averaging them is correct.
+func resize(source *image.RGBA, width, height int) *image.RGBA {
+	bounds := source.Bounds()
+	sourceWidth, sourceHeight := bounds.Dx(), bounds.Dy()
+	target := image.NewRGBA(image.Rect(0, 0, width, height))
+
+	for y := 0; y < height; y++ {
+		y0 := y * sourceHeight / height
+		y1 := max(y0+1, (y+1)*sourceHeight/height)
+		for x := 0; x < width; x++ {
+			x0 := x * sourceWidth / width
+			x1 := max(x0+1, (x+1)*sourceWidth/width)
+
+			var r, g, b, a, count uint64
+			for sy := y0; sy < y1; sy++ {
+				offset := source.PixOffset(bounds.Min.X+x0, bounds.Min.Y+sy)
+				for sx := x0; sx < x1; sx++ {
+					r += uint64(source.Pix[offset])
+					g += uint64(source.Pix[offset+1])
+					b += uint64(source.Pix[offset+2])
+					a += uint64(source.Pix[offset+3])
+					offset += 4
+					count++
+				}
+			}
+
+			offset := target.PixOffset(x, y)
+			target.Pix[offset] = uint8(r / count)
+			target.Pix[offset+1] = uint8(g / count)
+			target.Pix[offset+2] = uint8(b / count)
+			target.Pix[offset+3] = uint8(a / count)
+		}
+	}
+
+	return target
+}
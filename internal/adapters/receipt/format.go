@@ -0,0 +1,54 @@
+package receipt
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+)
+
+var paymentMethodLabels = map[entities.PaymentMethod]string{
+	entities.PaymentMethodPix:        "Pix",
+	entities.PaymentMethodCreditCard: "Cartão de crédito",
+	entities.PaymentMethodBillet:     "Boleto",
+	entities.PaymentMethodQRCode:     "QR Code",
+}
+
+func paymentMethodLabel(method entities.PaymentMethod) string {
+	if label, ok := paymentMethodLabels[method]; ok {
+		return label
+	}
+
+	return string(method)
+}
+
+// formatMoney writes amounts the way they are printed in Brazil, "R$ 1.234,50".
+func formatMoney(amount entities.Money) string {
+	sign := ""
+	cents := amount.Cents
+	if cents < 0 {
+		sign = "-"
+		cents = -cents
+	}
+
+	units := fmt.Sprintf("%d", cents/100)
+	for i := len(units) - 3; i > 0; i -= 3 {
+		units = units[:i] + "." + units[i:]
+	}
+
+	symbol := amount.Currency
+	if symbol == "" || symbol == entities.DefaultCurrency {
+		symbol = "R$"
+	}
+
+	return fmt.Sprintf("%s%s %s,%02d", sign, symbol, units, cents%100)
+}
+
+// formatCPF masks an 11 digit CPF as 000.000.000-00, anything else is printed as stored.
+func formatCPF(cpf string) string {
+	if len(cpf) != 11 || strings.Trim(cpf, "0123456789") != "" {
+		return cpf
+	}
+
+	return fmt.Sprintf("%s.%s.%s-%s", cpf[:3], cpf[3:6], cpf[6:9], cpf[9:])
+}
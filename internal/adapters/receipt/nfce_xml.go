@@ -0,0 +1,259 @@
+package receipt
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+)
+
+// nfceDefaultNCM is "outras preparações alimentícias", the usual classification for prepared meals.
+const nfceDefaultNCM = "21069090"
+
+// NFC-e payment method codes (tPag).
+var nfcePaymentCodes = map[entities.PaymentMethod]string{
+	entities.PaymentMethodCreditCard: "03",
+	entities.PaymentMethodBillet:     "15",
+	entities.PaymentMethodPix:        "17",
+}
+
+// The structs follow the NFC-e 4.00 layout (modelo 65). Only the groups the order data can fill
+// are written: the access key, state and city codes, state registration, tax groups and the
+// signature depend on the issuer registration and are added when the document is sent to SEFAZ.
+type nfce struct {
+	XMLName xml.Name `xml:"NFe"`
+	Xmlns   string   `xml:"xmlns,attr"`
+	InfNFe  nfceInf  `xml:"infNFe"`
+}
+
+type nfceInf struct {
+	Versao string       `xml:"versao,attr"`
+	Ide    nfceIde      `xml:"ide"`
+	Emit   nfceEmit     `xml:"emit"`
+	Dest   *nfceDest    `xml:"dest,omitempty"`
+	Det    []nfceDet    `xml:"det"`
+	Total  nfceTotal    `xml:"total"`
+	Pag    nfcePag      `xml:"pag"`
+	InfAdi *nfceInfAdic `xml:"infAdic,omitempty"`
+}
+
+type nfceIde struct {
+	NatOp    string `xml:"natOp"`
+	Mod      string `xml:"mod"`
+	Serie    string `xml:"serie"`
+	NNF      int    `xml:"nNF"`
+	DhEmi    string `xml:"dhEmi"`
+	TpNF     string `xml:"tpNF"`
+	IdDest   string `xml:"idDest"`
+	TpImp    string `xml:"tpImp"`
+	TpEmis   string `xml:"tpEmis"`
+	TpAmb    string `xml:"tpAmb"`
+	FinNFe   string `xml:"finNFe"`
+	IndFinal string `xml:"indFinal"`
+	IndPres  string `xml:"indPres"`
+	ProcEmi  string `xml:"procEmi"`
+	VerProc  string `xml:"verProc"`
+}
+
+type nfceEmit struct {
+	CNPJ  string `xml:"CNPJ,omitempty"`
+	XNome string `xml:"xNome"`
+}
+
+type nfceDest struct {
+	CPF   string `xml:"CPF"`
+	XNome string `xml:"xNome,omitempty"`
+}
+
+type nfceDet struct {
+	NItem int      `xml:"nItem,attr"`
+	Prod  nfceProd `xml:"prod"`
+}
+
+type nfceProd struct {
+	CProd    string `xml:"cProd"`
+	CEAN     string `xml:"cEAN"`
+	XProd    string `xml:"xProd"`
+	NCM      string `xml:"NCM"`
+	CFOP     string `xml:"CFOP"`
+	UCom     string `xml:"uCom"`
+	QCom     string `xml:"qCom"`
+	VUnCom   string `xml:"vUnCom"`
+	VProd    string `xml:"vProd"`
+	CEANTrib string `xml:"cEANTrib"`
+	UTrib    string `xml:"uTrib"`
+	QTrib    string `xml:"qTrib"`
+	VUnTrib  string `xml:"vUnTrib"`
+	VDesc    string `xml:"vDesc,omitempty"`
+	VOutro   string `xml:"vOutro,omitempty"`
+	IndTot   string `xml:"indTot"`
+}
+
+type nfceTotal struct {
+	ICMSTot nfceICMSTot `xml:"ICMSTot"`
+}
+
+type nfceICMSTot struct {
+	VProd  string `xml:"vProd"`
+	VDesc  string `xml:"vDesc"`
+	VOutro string `xml:"vOutro"`
+	VNF    string `xml:"vNF"`
+}
+
+type nfcePag struct {
+	DetPag []nfceDetPag `xml:"detPag"`
+}
+
+type nfceDetPag struct {
+	TPag string `xml:"tPag"`
+	XPag string `xml:"xPag,omitempty"`
+	VPag string `xml:"vPag"`
+}
+
+type nfceInfAdic struct {
+	InfCpl string `xml:"infCpl"`
+}
+
+type nfceXMLRenderer struct{}
+
+// NewNFCeXMLRenderer exports the receipt as an unsigned NFC-e document in the homologation
+// environment, ready to be completed and transmitted by an issuer integration.
+func NewNFCeXMLRenderer() ports.ReceiptRenderer {
+	return &nfceXMLRenderer{}
+}
+
+func (r *nfceXMLRenderer) ContentType() string {
+	return "application/xml"
+}
+
+func (r *nfceXMLRenderer) Render(receipt entities.Receipt) ([]byte, error) {
+	document := nfce{
+		Xmlns: "http://www.portalfiscal.inf.br/nfe",
+		InfNFe: nfceInf{
+			Versao: "4.00",
+			Ide: nfceIde{
+				NatOp:    "VENDA",
+				Mod:      "65",
+				Serie:    "1",
+				NNF:      receipt.Number,
+				DhEmi:    receipt.IssuedAt.Format("2006-01-02T15:04:05-07:00"),
+				TpNF:     "1",
+				IdDest:   "1",
+				TpImp:    "4",
+				TpEmis:   "1",
+				TpAmb:    "2",
+				FinNFe:   "1",
+				IndFinal: "1",
+				IndPres:  "1",
+				ProcEmi:  "0",
+				VerProc:  "restaurant-food-api",
+			},
+			Emit: nfceEmit{CNPJ: receipt.Issuer.CNPJ, XNome: receipt.Issuer.Name},
+			Pag:  nfcePag{DetPag: []nfceDetPag{nfcePayment(receipt)}},
+			InfAdi: &nfceInfAdic{
+				InfCpl: fmt.Sprintf("Pedido %d", receipt.Number),
+			},
+		},
+	}
+
+	if receipt.CustomerCPF != "" {
+		document.InfNFe.Dest = &nfceDest{CPF: receipt.CustomerCPF, XNome: receipt.CustomerName}
+	}
+
+	// The layout has no order level discount or fee, they are spread over the items instead
+	weights := make([]int64, len(receipt.Items))
+	for idx, item := range receipt.Items {
+		weights[idx] = item.Total.Cents
+	}
+	discounts := allocate(receipt.DiscountsTotal().Cents, weights)
+	fees := allocate(receipt.FeesTotal().Cents, weights)
+
+	for idx, item := range receipt.Items {
+		quantity := fmt.Sprintf("%d.0000", item.Quantity)
+		document.InfNFe.Det = append(document.InfNFe.Det, nfceDet{
+			NItem: idx + 1,
+			Prod: nfceProd{
+				CProd:    fmt.Sprintf("%d", item.ProductID),
+				CEAN:     "SEM GTIN",
+				XProd:    item.Name,
+				NCM:      nfceDefaultNCM,
+				CFOP:     "5102",
+				UCom:     "UN",
+				QCom:     quantity,
+				VUnCom:   item.UnitPrice.String(),
+				VProd:    item.Total.String(),
+				CEANTrib: "SEM GTIN",
+				UTrib:    "UN",
+				QTrib:    quantity,
+				VUnTrib:  item.UnitPrice.String(),
+				VDesc:    optionalAmount(discounts[idx]),
+				VOutro:   optionalAmount(fees[idx]),
+				IndTot:   "1",
+			},
+		})
+	}
+
+	document.InfNFe.Total.ICMSTot = nfceICMSTot{
+		VProd:  receipt.Subtotal.String(),
+		VDesc:  receipt.DiscountsTotal().String(),
+		VOutro: receipt.FeesTotal().String(),
+		VNF:    receipt.Total.String(),
+	}
+
+	var output bytes.Buffer
+	output.WriteString(xml.Header)
+	encoder := xml.NewEncoder(&output)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(document); err != nil {
+		return nil, err
+	}
+
+	return output.Bytes(), nil
+}
+
+func nfcePayment(receipt entities.Receipt) nfceDetPag {
+	if code, ok := nfcePaymentCodes[receipt.PaymentMethod]; ok {
+		return nfceDetPag{TPag: code, VPag: receipt.Total.String()}
+	}
+
+	// "99 - Outros" requires the description of the method
+	return nfceDetPag{TPag: "99", XPag: paymentMethodLabel(receipt.PaymentMethod), VPag: receipt.Total.String()}
+}
+
+// allocate splits total cents in proportion to the weights. Rounding leftovers go to the last
+// items, so the parts always add up to total.
+func allocate(total int64, weights []int64) []int64 {
+	parts := make([]int64, len(weights))
+
+	var sum int64
+	for _, weight := range weights {
+		sum += weight
+	}
+	if sum == 0 || total == 0 {
+		return parts
+	}
+
+	var allocated int64
+	for idx, weight := range weights {
+		parts[idx] = total * weight / sum
+		allocated += parts[idx]
+	}
+
+	// Each part loses less than one cent to rounding, so the leftover is smaller than the item count
+	for idx := len(parts) - 1; allocated < total; idx-- {
+		parts[idx]++
+		allocated++
+	}
+
+	return parts
+}
+
+func optionalAmount(cents int64) string {
+	if cents == 0 {
+		return ""
+	}
+
+	return entities.NewMoney(cents).String()
+}
@@ -0,0 +1,203 @@
+package receipt
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+)
+
+// The receipt is laid out like a thermal printer slip: one 80mm wide page in a monospaced font,
+// as tall as its content.
+const (
+	pdfColumns    = 40
+	pdfFontSize   = 8
+	pdfLeading    = 10
+	pdfPageWidth  = 226
+	pdfMarginTop  = 20
+	pdfMarginLeft = 17
+)
+
+type pdfRenderer struct{}
+
+func NewPDFRenderer() ports.ReceiptRenderer {
+	return &pdfRenderer{}
+}
+
+func (r *pdfRenderer) ContentType() string {
+	return "application/pdf"
+}
+
+func (r *pdfRenderer) Render(receipt entities.Receipt) ([]byte, error) {
+	return writePDF(receiptLines(receipt)), nil
+}
+
+func receiptLines(receipt entities.Receipt) []string {
+	separator := strings.Repeat("-", pdfColumns)
+
+	lines := make([]string, 0)
+	lines = append(lines, wrap(receipt.Issuer.Name, center)...)
+	if receipt.Issuer.CNPJ != "" {
+		lines = append(lines, center("CNPJ: "+receipt.Issuer.CNPJ))
+	}
+	if receipt.Issuer.Address != "" {
+		lines = append(lines, wrap(receipt.Issuer.Address, center)...)
+	}
+
+	lines = append(lines,
+		separator,
+		center(fmt.Sprintf("RECIBO DO PEDIDO Nº %06d", receipt.Number)),
+		center("Documento sem valor fiscal"),
+		center("Emitido em "+receipt.IssuedAt.Format("02/01/2006 15:04")),
+		separator,
+	)
+
+	for _, item := range receipt.Items {
+		lines = append(lines, wrap(item.Name, func(line string) string { return line })...)
+		lines = append(lines, columns(fmt.Sprintf("  %d x %s", item.Quantity, formatMoney(item.UnitPrice)), formatMoney(item.Total)))
+	}
+
+	lines = append(lines, separator, columns("Subtotal", formatMoney(receipt.Subtotal)))
+	for _, discount := range receipt.Discounts {
+		lines = append(lines, columns("Desconto "+discount.Description, "-"+formatMoney(discount.Amount)))
+	}
+	for _, fee := range receipt.Fees {
+		lines = append(lines, columns(fee.Description, formatMoney(fee.Amount)))
+	}
+
+	lines = append(lines,
+		columns("TOTAL", formatMoney(receipt.Total)),
+		separator,
+		columns("Forma de pagamento", paymentMethodLabel(receipt.PaymentMethod)),
+		columns("Valor pago", formatMoney(receipt.Total)),
+	)
+	if receipt.RefundedAmount.IsPositive() {
+		lines = append(lines,
+			columns("Valor estornado", formatMoney(receipt.RefundedAmount)),
+			columns("Valor líquido", formatMoney(receipt.NetTotal)),
+		)
+	}
+
+	lines = append(lines,
+		separator,
+		"CPF do consumidor: "+formatCPF(receipt.CustomerCPF),
+	)
+	lines = append(lines, wrap(receipt.CustomerName, func(line string) string { return line })...)
+
+	return lines
+}
+
+func center(text string) string {
+	padding := (pdfColumns - utf8.RuneCountInString(text)) / 2
+	if padding <= 0 {
+		return text
+	}
+
+	return strings.Repeat(" ", padding) + text
+}
+
+// columns prints label on the left and value aligned to the right edge of the slip.
+func columns(label, value string) string {
+	space := pdfColumns - utf8.RuneCountInString(label) - utf8.RuneCountInString(value)
+	if space < 1 {
+		label = string([]rune(label)[:max(0, pdfColumns-utf8.RuneCountInString(value)-1)])
+		space = 1
+	}
+
+	return label + strings.Repeat(" ", space) + value
+}
+
+// wrap breaks text into lines that fit the slip, splitting on spaces when possible.
+func wrap(text string, align func(string) string) []string {
+	lines := make([]string, 0)
+	line := ""
+	for _, word := range strings.Fields(text) {
+		for utf8.RuneCountInString(word) > pdfColumns {
+			if line != "" {
+				lines = append(lines, align(line))
+				line = ""
+			}
+			runes := []rune(word)
+			lines = append(lines, align(string(runes[:pdfColumns])))
+			word = string(runes[pdfColumns:])
+		}
+
+		switch {
+		case line == "":
+			line = word
+		case utf8.RuneCountInString(line)+1+utf8.RuneCountInString(word) <= pdfColumns:
+			line += " " + word
+		default:
+			lines = append(lines, align(line))
+			line = word
+		}
+	}
+
+	if line != "" {
+		lines = append(lines, align(line))
+	}
+
+	return lines
+}
+
+// writePDF writes a single page PDF with the lines in Courier. The standard fonts need no
+// embedding, so the document is a handful of objects written by hand.
+func writePDF(lines []string) []byte {
+	pageHeight := 2*pdfMarginTop + len(lines)*pdfLeading
+
+	var content bytes.Buffer
+	fmt.Fprintf(&content, "BT\n/F1 %d Tf\n%d TL\n%d %d Td\n", pdfFontSize, pdfLeading, pdfMarginLeft, pageHeight-pdfMarginTop-pdfFontSize)
+	for _, line := range lines {
+		content.WriteString("(")
+		content.Write(pdfText(line))
+		content.WriteString(") Tj T*\n")
+	}
+	content.WriteString("ET\n")
+
+	objects := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %d %d] /Resources << /Font << /F1 4 0 R >> >> /Contents 5 0 R >>", pdfPageWidth, pageHeight),
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Courier /Encoding /WinAnsiEncoding >>",
+		fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", content.Len(), content.String()),
+	}
+
+	var document bytes.Buffer
+	document.WriteString("%PDF-1.4\n")
+
+	offsets := make([]int, len(objects))
+	for idx, object := range objects {
+		offsets[idx] = document.Len()
+		fmt.Fprintf(&document, "%d 0 obj\n%s\nendobj\n", idx+1, object)
+	}
+
+	xref := document.Len()
+	fmt.Fprintf(&document, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&document, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&document, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+
+	return document.Bytes()
+}
+
+// pdfText encodes the line for a PDF string literal. Accented Portuguese letters share their
+// code points in Latin-1 and WinAnsi, characters outside it are printed as "?".
+func pdfText(line string) []byte {
+	encoded := make([]byte, 0, len(line))
+	for _, char := range line {
+		switch {
+		case char == '(' || char == ')' || char == '\\':
+			encoded = append(encoded, '\\', byte(char))
+		case char < 0x20 || (char >= 0x7f && char < 0xa0) || char > 0xff:
+			encoded = append(encoded, '?')
+		default:
+			encoded = append(encoded, byte(char))
+		}
+	}
+
+	return encoded
+}
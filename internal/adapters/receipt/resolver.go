@@ -0,0 +1,31 @@
+package receipt
+
+import (
+	"errors"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+)
+
+const (
+	FormatPDF = "pdf"
+	FormatXML = "xml"
+)
+
+var ErrReceiptFormatNotSupported = errors.New("receipt format not supported")
+
+type receiptRendererResolver struct{}
+
+func NewReceiptRendererResolver() ports.ReceiptRendererResolver {
+	return &receiptRendererResolver{}
+}
+
+func (r *receiptRendererResolver) Resolve(format string) (ports.ReceiptRenderer, error) {
+	switch format {
+	case FormatPDF:
+		return NewPDFRenderer(), nil
+	case FormatXML:
+		return NewNFCeXMLRenderer(), nil
+	default:
+		return nil, ErrReceiptFormatNotSupported
+	}
+}
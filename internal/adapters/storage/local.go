@@ -0,0 +1,94 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"mime"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+)
+
+// LocalFileStorage keeps files under a directory of the server, for development and single
+// instance deployments. The content type is derived from the key extension.
+type LocalFileStorage struct {
+	dir string
+}
+
+func NewLocalFileStorage(dir string) *LocalFileStorage {
+	return &LocalFileStorage{dir: dir}
+}
+
+func (s *LocalFileStorage) Put(ctx context.Context, key string, contentType string, data []byte) error {
+	if !ValidKey(key) {
+		return ErrInvalidKey
+	}
+
+	name := s.path(key)
+	if err := os.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+		return err
+	}
+
+	// Written aside and renamed, so a reader never sees a partial file
+	tmp, err := os.CreateTemp(filepath.Dir(name), ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), name)
+}
+
+func (s *LocalFileStorage) Open(ctx context.Context, key string) (ports.StoredFile, error) {
+	if !ValidKey(key) {
+		return ports.StoredFile{}, ports.ErrFileNotFound
+	}
+
+	file, err := os.Open(s.path(key))
+	if errors.Is(err, os.ErrNotExist) {
+		return ports.StoredFile{}, ports.ErrFileNotFound
+	} else if err != nil {
+		return ports.StoredFile{}, err
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return ports.StoredFile{}, err
+	}
+
+	contentType := mime.TypeByExtension(path.Ext(key))
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+
+	return ports.StoredFile{Body: file, ContentType: contentType, Size: info.Size()}, nil
+}
+
+func (s *LocalFileStorage) Delete(ctx context.Context, key string) error {
+	if !ValidKey(key) {
+		return ErrInvalidKey
+	}
+
+	err := os.Remove(s.path(key))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
+
+func (s *LocalFileStorage) path(key string) string {
+	return filepath.Join(s.dir, filepath.FromSlash(key))
+}
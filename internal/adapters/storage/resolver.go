@@ -0,0 +1,47 @@
+package storage
+
+import (
+	"errors"
+	"log/slog"
+	"strings"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/config"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+)
+
+const (
+	ProviderLocal = "local"
+	ProviderS3    = "s3"
+)
+
+var ErrInvalidKey = errors.New("invalid storage key")
+
+// NewFileStorage selects the storage adapter from the configuration, files are kept on the local
+// disk unless an S3 compatible bucket, like MinIO, is configured.
+func NewFileStorage(cfg *config.Config) ports.FileStorage {
+	if cfg.Storage.Provider == ProviderS3 {
+		if cfg.Storage.S3.Endpoint == "" || cfg.Storage.S3.Bucket == "" {
+			slog.Error("S3_ENDPOINT and S3_BUCKET must be set to store files on S3")
+		}
+
+		return NewS3FileStorage(cfg.Storage.S3)
+	}
+
+	return NewLocalFileStorage(cfg.Storage.LocalDir)
+}
+
+// ValidKey accepts relative slash separated keys, rejecting anything that could escape the
+// storage root like "..", absolute paths or backslashes.
+func ValidKey(key string) bool {
+	if key == "" || strings.HasPrefix(key, "/") || strings.Contains(key, "\\") {
+		return false
+	}
+
+	for _, segment := range strings.Split(key, "/") {
+		if segment == "" || segment == "." || segment == ".." {
+			return false
+		}
+	}
+
+	return true
+}
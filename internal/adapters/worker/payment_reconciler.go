@@ -0,0 +1,38 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/config"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase"
+)
+
+type PaymentReconciler interface {
+	Worker
+}
+
+type paymentReconciler struct {
+	reconcilePendingPaymentsUseCase usecase.ReconcilePendingPaymentsUseCase
+	interval                        time.Duration
+}
+
+func NewPaymentReconciler(cfg *config.Config, reconcilePendingPaymentsUseCase usecase.ReconcilePendingPaymentsUseCase) PaymentReconciler {
+	return &paymentReconciler{
+		reconcilePendingPaymentsUseCase: reconcilePendingPaymentsUseCase,
+		interval:                        cfg.Reconciler.Interval,
+	}
+}
+
+func (w *paymentReconciler) Name() string {
+	return "payment-reconciler"
+}
+
+func (w *paymentReconciler) Start(ctx context.Context) {
+	runEvery(ctx, w.interval, func(ctx context.Context) {
+		if _, err := w.reconcilePendingPaymentsUseCase.Run(ctx); err != nil {
+			slog.Error("Payment reconciliation failed", "error", err)
+		}
+	})
+}
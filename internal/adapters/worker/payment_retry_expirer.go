@@ -0,0 +1,38 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/config"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase"
+)
+
+type PaymentRetryExpirer interface {
+	Worker
+}
+
+type paymentRetryExpirer struct {
+	expireFailedPaymentsUseCase usecase.ExpireFailedPaymentsUseCase
+	interval                    time.Duration
+}
+
+func NewPaymentRetryExpirer(cfg *config.Config, expireFailedPaymentsUseCase usecase.ExpireFailedPaymentsUseCase) PaymentRetryExpirer {
+	return &paymentRetryExpirer{
+		expireFailedPaymentsUseCase: expireFailedPaymentsUseCase,
+		interval:                    cfg.PaymentRetry.ExpirerInterval,
+	}
+}
+
+func (w *paymentRetryExpirer) Name() string {
+	return "payment-retry-expirer"
+}
+
+func (w *paymentRetryExpirer) Start(ctx context.Context) {
+	runEvery(ctx, w.interval, func(ctx context.Context) {
+		if _, err := w.expireFailedPaymentsUseCase.Run(ctx); err != nil {
+			slog.Error("Payment retry expiration failed", "error", err)
+		}
+	})
+}
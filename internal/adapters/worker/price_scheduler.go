@@ -0,0 +1,38 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/config"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase"
+)
+
+type PriceScheduler interface {
+	Worker
+}
+
+type priceScheduler struct {
+	applyScheduledPricesUseCase usecase.ApplyScheduledPricesUseCase
+	interval                    time.Duration
+}
+
+func NewPriceScheduler(cfg *config.Config, applyScheduledPricesUseCase usecase.ApplyScheduledPricesUseCase) PriceScheduler {
+	return &priceScheduler{
+		applyScheduledPricesUseCase: applyScheduledPricesUseCase,
+		interval:                    cfg.PriceScheduler.Interval,
+	}
+}
+
+func (w *priceScheduler) Name() string {
+	return "price-scheduler"
+}
+
+func (w *priceScheduler) Start(ctx context.Context) {
+	runEvery(ctx, w.interval, func(ctx context.Context) {
+		if _, err := w.applyScheduledPricesUseCase.Run(ctx); err != nil {
+			slog.Error("Applying scheduled prices failed", "error", err)
+		}
+	})
+}
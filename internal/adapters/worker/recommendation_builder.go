@@ -0,0 +1,45 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/config"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase"
+)
+
+type RecommendationBuilder interface {
+	Worker
+}
+
+type recommendationBuilder struct {
+	rebuildProductRecommendationsUseCase usecase.RebuildProductRecommendationsUseCase
+	interval                             time.Duration
+}
+
+func NewRecommendationBuilder(cfg *config.Config, rebuildProductRecommendationsUseCase usecase.RebuildProductRecommendationsUseCase) RecommendationBuilder {
+	return &recommendationBuilder{
+		rebuildProductRecommendationsUseCase: rebuildProductRecommendationsUseCase,
+		interval:                             cfg.Recommendations.Interval,
+	}
+}
+
+func (w *recommendationBuilder) Name() string {
+	return "recommendation-builder"
+}
+
+func (w *recommendationBuilder) Start(ctx context.Context) {
+	// The first tick is an interval away, usually an hour, so the counts are rebuilt on start too
+	if w.interval > 0 {
+		w.rebuild(ctx)
+	}
+
+	runEvery(ctx, w.interval, w.rebuild)
+}
+
+func (w *recommendationBuilder) rebuild(ctx context.Context) {
+	if _, err := w.rebuildProductRecommendationsUseCase.Run(ctx); err != nil {
+		slog.Error("Rebuilding product recommendations failed", "error", err)
+	}
+}
@@ -0,0 +1,46 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+type Worker interface {
+	Name() string
+	Start(ctx context.Context)
+}
+
+type Workers []Worker
+
+func NewWorkers(paymentReconciler PaymentReconciler, paymentRetryExpirer PaymentRetryExpirer, priceScheduler PriceScheduler, recommendationBuilder RecommendationBuilder) Workers {
+	return Workers{paymentReconciler, paymentRetryExpirer, priceScheduler, recommendationBuilder}
+}
+
+// Start launches every worker in its own goroutine, they stop when ctx is canceled.
+func (w Workers) Start(ctx context.Context) {
+	for _, worker := range w {
+		slog.Info("Starting worker", "worker", worker.Name())
+		go worker.Start(ctx)
+	}
+}
+
+// runEvery calls fn on every tick until ctx is canceled.
+func runEvery(ctx context.Context, interval time.Duration, fn func(ctx context.Context)) {
+	if interval <= 0 {
+		slog.Warn("Worker disabled, interval must be positive", "interval", interval)
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			fn(ctx)
+		}
+	}
+}
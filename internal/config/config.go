@@ -2,6 +2,10 @@ package config
 
 import (
 	"log/slog"
+	"strings"
+	"time"
+	// The API image has no zoneinfo, the store timezone is loaded from the embedded copy
+	_ "time/tzdata"
 
 	"github.com/spf13/viper"
 )
@@ -11,18 +15,175 @@ type Redis struct {
 	Password string
 }
 
+type PaymentGateway struct {
+	// Provider selects the gateway adapters: "mock" uses the per-method mocks,
+	// "local" routes every method to an in-memory stand-in that never touches Redis.
+	Provider string
+	// Timeout bounds each gateway call, MaxAttempts and RetryBaseDelay control the retries.
+	Timeout        time.Duration
+	MaxAttempts    int
+	RetryBaseDelay time.Duration
+	// BreakerFailureThreshold consecutive failures open the circuit breaker for BreakerOpenTimeout.
+	BreakerFailureThreshold int
+	BreakerOpenTimeout      time.Duration
+}
+
+type Reconciler struct {
+	Interval   time.Duration
+	PendingAge time.Duration
+	BatchSize  int
+}
+
+// PaymentRetry controls how long an order whose payment failed waits for a new attempt.
+type PaymentRetry struct {
+	Window          time.Duration
+	ExpirerInterval time.Duration
+	BatchSize       int
+}
+
+// PriceScheduler controls how often scheduled product prices are checked, a price goes live at most
+// Interval after its effective date.
+type PriceScheduler struct {
+	Interval  time.Duration
+	BatchSize int
+}
+
+// Recommendations controls the "frequently bought together" job: every Interval it counts, over the
+// orders paid in the last LookbackDays, how often each pair of products was bought together. Pairs
+// seen in fewer than MinOrders orders are not recommended.
+type Recommendations struct {
+	Interval     time.Duration
+	LookbackDays int
+	MinOrders    int
+}
+
+type Loyalty struct {
+	PointsPerCurrencyUnit int
+	PointValueCents       int64
+}
+
+// Receipt holds the issuer data printed on order receipts.
+type Receipt struct {
+	IssuerName    string
+	IssuerCNPJ    string
+	IssuerAddress string
+}
+
+// Webhook holds the secret shared with the payment gateway, used to sign webhook notifications.
+type Webhook struct {
+	Secret string
+}
+
+// Sandbox enables the development payment simulator, which delivers webhooks to WebhookURL.
+type Sandbox struct {
+	Enabled    bool
+	WebhookURL string
+}
+
+// Storage selects where uploaded images are kept: "local" writes them under LocalDir, "s3" to a
+// bucket of an S3 compatible service like MinIO. They are always served from PublicBaseURL.
+type Storage struct {
+	Provider       string
+	LocalDir       string
+	PublicBaseURL  string
+	MaxUploadBytes int64
+	S3             S3Storage
+}
+
+type S3Storage struct {
+	Endpoint        string
+	Region          string
+	Bucket          string
+	AccessKeyID     string
+	SecretAccessKey string
+}
+
+// Store holds the timezone of the restaurant, menu availability windows are set in it.
+type Store struct {
+	Timezone string
+	Location *time.Location
+}
+
+// ProductCache keeps product reads in Redis. Admin writes invalidate it at once, TTL bounds how long
+// stock sold at checkout can be shown outdated.
+type ProductCache struct {
+	Enabled bool
+	TTL     time.Duration
+}
+
+// HTTPCache holds the Cache-Control sent by each menu route. no-cache lets clients keep the
+// response but revalidate it on every request, which costs a 304 when nothing changed.
+type HTTPCache struct {
+	Products   string
+	Categories string
+}
+
 type Config struct {
-	DatabaseURL string
-	Redis       Redis
+	DatabaseURL     string
+	Redis           Redis
+	PaymentGateway  PaymentGateway
+	Reconciler      Reconciler
+	PaymentRetry    PaymentRetry
+	PriceScheduler  PriceScheduler
+	Recommendations Recommendations
+	Loyalty         Loyalty
+	Receipt         Receipt
+	Webhook         Webhook
+	Sandbox         Sandbox
+	Storage         Storage
+	Store           Store
+	ProductCache    ProductCache
+	HTTPCache       HTTPCache
+	// MoneyJSONFormat is "legacy" (decimal number), the default, or "object" ({"cents", "currency"}) once clients opt in.
+	MoneyJSONFormat string
 }
 
 func LoadConfig() *Config {
 	slog.Info("Loading config")
 	viper.AutomaticEnv()
 
+	viper.SetDefault("PAYMENT_GATEWAY_PROVIDER", "mock")
+	viper.SetDefault("PAYMENT_GATEWAY_TIMEOUT_MS", 3000)
+	viper.SetDefault("PAYMENT_GATEWAY_MAX_ATTEMPTS", 3)
+	viper.SetDefault("PAYMENT_GATEWAY_RETRY_BASE_DELAY_MS", 100)
+	viper.SetDefault("PAYMENT_GATEWAY_BREAKER_FAILURE_THRESHOLD", 5)
+	viper.SetDefault("PAYMENT_GATEWAY_BREAKER_OPEN_SECONDS", 30)
+	viper.SetDefault("RECONCILER_INTERVAL_SECONDS", 60)
+	viper.SetDefault("RECONCILER_PENDING_AGE_MINUTES", 15)
+	viper.SetDefault("RECONCILER_BATCH_SIZE", 100)
+	viper.SetDefault("PAYMENT_RETRY_WINDOW_MINUTES", 15)
+	viper.SetDefault("PAYMENT_RETRY_EXPIRER_INTERVAL_SECONDS", 60)
+	viper.SetDefault("PAYMENT_RETRY_EXPIRER_BATCH_SIZE", 100)
+	viper.SetDefault("PRICE_SCHEDULER_INTERVAL_SECONDS", 30)
+	viper.SetDefault("PRICE_SCHEDULER_BATCH_SIZE", 100)
+	viper.SetDefault("RECOMMENDATIONS_INTERVAL_MINUTES", 60)
+	viper.SetDefault("RECOMMENDATIONS_LOOKBACK_DAYS", 90)
+	viper.SetDefault("RECOMMENDATIONS_MIN_ORDERS", 2)
+	viper.SetDefault("MONEY_JSON_FORMAT", "legacy")
+	viper.SetDefault("LOYALTY_POINTS_PER_CURRENCY_UNIT", 1)
+	viper.SetDefault("LOYALTY_POINT_VALUE_CENTS", 5)
+	viper.SetDefault("RECEIPT_ISSUER_NAME", "FIAP Restaurant")
+	viper.SetDefault("SANDBOX_ENABLED", false)
+	viper.SetDefault("SANDBOX_WEBHOOK_URL", "http://localhost:8080/api/v1/webhooks/notifications")
+	viper.SetDefault("STORE_TIMEZONE", "America/Sao_Paulo")
+	viper.SetDefault("STORAGE_PROVIDER", "local")
+	viper.SetDefault("STORAGE_LOCAL_DIR", "./uploads")
+	viper.SetDefault("STORAGE_PUBLIC_BASE_URL", "http://localhost:8080")
+	viper.SetDefault("STORAGE_MAX_UPLOAD_BYTES", 5<<20)
+	viper.SetDefault("S3_REGION", "us-east-1")
+	viper.SetDefault("PRODUCT_CACHE_ENABLED", true)
+	viper.SetDefault("PRODUCT_CACHE_TTL_SECONDS", 30)
+	viper.SetDefault("HTTP_CACHE_CONTROL_PRODUCTS", "no-cache")
+	viper.SetDefault("HTTP_CACHE_CONTROL_CATEGORIES", "public, max-age=60")
+
 	slog.Info("DATABASE_URL", "value", viper.GetString("DATABASE_URL"))
 	slog.Info("REDIS_URL", "value", viper.GetString("REDIS_URL"))
 	slog.Info("REDIS_PASSWORD", "value", viper.GetString("REDIS_PASSWORD"))
+	slog.Info("PAYMENT_GATEWAY_PROVIDER", "value", viper.GetString("PAYMENT_GATEWAY_PROVIDER"))
+	slog.Info("MONEY_JSON_FORMAT", "value", viper.GetString("MONEY_JSON_FORMAT"))
+	slog.Info("SANDBOX_ENABLED", "value", viper.GetBool("SANDBOX_ENABLED"))
+	slog.Info("STORAGE_PROVIDER", "value", viper.GetString("STORAGE_PROVIDER"))
+	slog.Info("PRODUCT_CACHE_ENABLED", "value", viper.GetBool("PRODUCT_CACHE_ENABLED"))
 
 	config := &Config{
 		DatabaseURL: viper.GetString("DATABASE_URL"),
@@ -30,7 +191,82 @@ func LoadConfig() *Config {
 			URL:      viper.GetString("REDIS_URL"),
 			Password: viper.GetString("REDIS_PASSWORD"),
 		},
+		PaymentGateway: PaymentGateway{
+			Provider:                viper.GetString("PAYMENT_GATEWAY_PROVIDER"),
+			Timeout:                 time.Duration(viper.GetInt("PAYMENT_GATEWAY_TIMEOUT_MS")) * time.Millisecond,
+			MaxAttempts:             viper.GetInt("PAYMENT_GATEWAY_MAX_ATTEMPTS"),
+			RetryBaseDelay:          time.Duration(viper.GetInt("PAYMENT_GATEWAY_RETRY_BASE_DELAY_MS")) * time.Millisecond,
+			BreakerFailureThreshold: viper.GetInt("PAYMENT_GATEWAY_BREAKER_FAILURE_THRESHOLD"),
+			BreakerOpenTimeout:      time.Duration(viper.GetInt("PAYMENT_GATEWAY_BREAKER_OPEN_SECONDS")) * time.Second,
+		},
+		Reconciler: Reconciler{
+			Interval:   time.Duration(viper.GetInt("RECONCILER_INTERVAL_SECONDS")) * time.Second,
+			PendingAge: time.Duration(viper.GetInt("RECONCILER_PENDING_AGE_MINUTES")) * time.Minute,
+			BatchSize:  viper.GetInt("RECONCILER_BATCH_SIZE"),
+		},
+		PaymentRetry: PaymentRetry{
+			Window:          time.Duration(viper.GetInt("PAYMENT_RETRY_WINDOW_MINUTES")) * time.Minute,
+			ExpirerInterval: time.Duration(viper.GetInt("PAYMENT_RETRY_EXPIRER_INTERVAL_SECONDS")) * time.Second,
+			BatchSize:       viper.GetInt("PAYMENT_RETRY_EXPIRER_BATCH_SIZE"),
+		},
+		PriceScheduler: PriceScheduler{
+			Interval:  time.Duration(viper.GetInt("PRICE_SCHEDULER_INTERVAL_SECONDS")) * time.Second,
+			BatchSize: viper.GetInt("PRICE_SCHEDULER_BATCH_SIZE"),
+		},
+		Recommendations: Recommendations{
+			Interval:     time.Duration(viper.GetInt("RECOMMENDATIONS_INTERVAL_MINUTES")) * time.Minute,
+			LookbackDays: viper.GetInt("RECOMMENDATIONS_LOOKBACK_DAYS"),
+			MinOrders:    viper.GetInt("RECOMMENDATIONS_MIN_ORDERS"),
+		},
+		Loyalty: Loyalty{
+			PointsPerCurrencyUnit: viper.GetInt("LOYALTY_POINTS_PER_CURRENCY_UNIT"),
+			PointValueCents:       viper.GetInt64("LOYALTY_POINT_VALUE_CENTS"),
+		},
+		Receipt: Receipt{
+			IssuerName:    viper.GetString("RECEIPT_ISSUER_NAME"),
+			IssuerCNPJ:    viper.GetString("RECEIPT_ISSUER_CNPJ"),
+			IssuerAddress: viper.GetString("RECEIPT_ISSUER_ADDRESS"),
+		},
+		Webhook: Webhook{
+			Secret: viper.GetString("WEBHOOK_SECRET"),
+		},
+		Sandbox: Sandbox{
+			Enabled:    viper.GetBool("SANDBOX_ENABLED"),
+			WebhookURL: viper.GetString("SANDBOX_WEBHOOK_URL"),
+		},
+		Storage: Storage{
+			Provider:       viper.GetString("STORAGE_PROVIDER"),
+			LocalDir:       viper.GetString("STORAGE_LOCAL_DIR"),
+			PublicBaseURL:  strings.TrimRight(viper.GetString("STORAGE_PUBLIC_BASE_URL"), "/"),
+			MaxUploadBytes: viper.GetInt64("STORAGE_MAX_UPLOAD_BYTES"),
+			S3: S3Storage{
+				Endpoint:        viper.GetString("S3_ENDPOINT"),
+				Region:          viper.GetString("S3_REGION"),
+				Bucket:          viper.GetString("S3_BUCKET"),
+				AccessKeyID:     viper.GetString("S3_ACCESS_KEY_ID"),
+				SecretAccessKey: viper.GetString("S3_SECRET_ACCESS_KEY"),
+			},
+		},
+		Store: Store{
+			Timezone: viper.GetString("STORE_TIMEZONE"),
+		},
+		ProductCache: ProductCache{
+			Enabled: viper.GetBool("PRODUCT_CACHE_ENABLED"),
+			TTL:     time.Duration(viper.GetInt("PRODUCT_CACHE_TTL_SECONDS")) * time.Second,
+		},
+		HTTPCache: HTTPCache{
+			Products:   viper.GetString("HTTP_CACHE_CONTROL_PRODUCTS"),
+			Categories: viper.GetString("HTTP_CACHE_CONTROL_CATEGORIES"),
+		},
+		MoneyJSONFormat: viper.GetString("MONEY_JSON_FORMAT"),
+	}
+
+	location, err := time.LoadLocation(config.Store.Timezone)
+	if err != nil {
+		slog.Error("Invalid STORE_TIMEZONE, using UTC", "value", config.Store.Timezone, "error", err)
+		location = time.UTC
 	}
+	config.Store.Location = location
 
 	if config.DatabaseURL == "" {
 		slog.Error("DATABASE_URL is not set")
@@ -40,5 +276,9 @@ func LoadConfig() *Config {
 		slog.Error("REDIS_URL is not set")
 	}
 
+	if config.Webhook.Secret == "" {
+		slog.Warn("WEBHOOK_SECRET is not set, webhook signatures are not verified")
+	}
+
 	return config
 }
@@ -0,0 +1,86 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+const minutesPerDay = 24 * 60
+
+// AvailabilityWindow is a time range of a weekday, in minutes since midnight in the store
+// timezone, when a product can be ordered. A window ending at or before its start runs past
+// midnight into the next day, so 00:00 to 00:00 covers the whole weekday.
+type AvailabilityWindow struct {
+	Weekday     time.Weekday
+	StartMinute int
+	EndMinute   int
+}
+
+func (w AvailabilityWindow) Validate() error {
+	if w.Weekday < time.Sunday || w.Weekday > time.Saturday {
+		return errors.New("weekday must be between 0 (Sunday) and 6 (Saturday)")
+	}
+
+	if w.StartMinute < 0 || w.StartMinute >= minutesPerDay || w.EndMinute < 0 || w.EndMinute >= minutesPerDay {
+		return errors.New("start and end must be times between 00:00 and 23:59")
+	}
+
+	return nil
+}
+
+func (w AvailabilityWindow) overnight() bool {
+	return w.EndMinute <= w.StartMinute
+}
+
+// Contains tells whether t, already in the store timezone, falls inside the window.
+func (w AvailabilityWindow) Contains(t time.Time) bool {
+	minute := t.Hour()*60 + t.Minute()
+	if t.Weekday() == w.Weekday && minute >= w.StartMinute && (w.overnight() || minute < w.EndMinute) {
+		return true
+	}
+
+	// The part of an overnight window that runs into the next day
+	return w.overnight() && t.Weekday() == (w.Weekday+1)%7 && minute < w.EndMinute
+}
+
+// AvailabilitySchedule lists the windows a product can be ordered in, empty when it always can.
+type AvailabilitySchedule []AvailabilityWindow
+
+func (s AvailabilitySchedule) Validate() error {
+	for _, window := range s {
+		if err := window.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s AvailabilitySchedule) AvailableAt(t time.Time) bool {
+	if len(s) == 0 {
+		return true
+	}
+
+	for _, window := range s {
+		if window.Contains(t) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseClockMinute reads a HH:MM time as minutes since midnight.
+func ParseClockMinute(clock string) (int, error) {
+	parsed, err := time.Parse("15:04", clock)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time %q, use HH:MM", clock)
+	}
+
+	return parsed.Hour()*60 + parsed.Minute(), nil
+}
+
+func FormatClockMinute(minute int) string {
+	return fmt.Sprintf("%02d:%02d", minute/60, minute%60)
+}
@@ -0,0 +1,91 @@
+package entities
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// CardData is the card informed at checkout. It only lives for the request: it is exchanged for a
+// PaymentCard token before the payment is authorized and is never stored.
+type CardData struct {
+	Number     string
+	HolderName string
+	ExpMonth   int
+	ExpYear    int
+	CVV        string
+}
+
+// PaymentCard is the tokenized card kept with the payment, enough to show which card was used.
+type PaymentCard struct {
+	Token string
+	Brand string
+	Last4 string
+}
+
+// NormalizedNumber returns the card number without the spaces and dashes used to group digits.
+func (c CardData) NormalizedNumber() string {
+	return strings.NewReplacer(" ", "", "-", "").Replace(c.Number)
+}
+
+func (c CardData) Validate(now time.Time) error {
+	number := c.NormalizedNumber()
+	if len(number) < 12 || len(number) > 19 || strings.Trim(number, "0123456789") != "" || !luhnValid(number) {
+		return errors.New("card number is invalid")
+	}
+
+	if c.ExpMonth < 1 || c.ExpMonth > 12 {
+		return errors.New("card expiration month is invalid")
+	}
+
+	// Cards are valid until the last day of their expiration month
+	if !now.Before(time.Date(c.ExpYear, time.Month(c.ExpMonth)+1, 1, 0, 0, 0, 0, now.Location())) {
+		return errors.New("card is expired")
+	}
+
+	if len(c.CVV) < 3 || len(c.CVV) > 4 || strings.Trim(c.CVV, "0123456789") != "" {
+		return errors.New("card security code is invalid")
+	}
+
+	return nil
+}
+
+func (c CardData) Last4() string {
+	number := c.NormalizedNumber()
+	if len(number) < 4 {
+		return number
+	}
+
+	return number[len(number)-4:]
+}
+
+func (c CardData) Brand() string {
+	number := c.NormalizedNumber()
+	switch {
+	case strings.HasPrefix(number, "4"):
+		return "visa"
+	case len(number) >= 2 && number[:2] >= "51" && number[:2] <= "55",
+		len(number) >= 4 && number[:4] >= "2221" && number[:4] <= "2720":
+		return "mastercard"
+	case strings.HasPrefix(number, "34"), strings.HasPrefix(number, "37"):
+		return "amex"
+	default:
+		return "unknown"
+	}
+}
+
+func luhnValid(number string) bool {
+	sum := 0
+	for idx := range number {
+		digit := int(number[len(number)-1-idx] - '0')
+		if idx%2 == 1 {
+			digit *= 2
+			if digit > 9 {
+				digit -= 9
+			}
+		}
+		sum += digit
+	}
+
+	return sum%10 == 0
+}
@@ -0,0 +1,85 @@
+package entities
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type Category struct {
+	ID           int
+	Name         string
+	Handle       string
+	Description  string
+	Image        string
+	DisplayOrder int
+	Active       bool
+	// ProductCount is the number of products in the category, only filled when reading.
+	ProductCount int
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+var accentReplacer = strings.NewReplacer(
+	"á", "a", "à", "a", "â", "a", "ã", "a", "ä", "a",
+	"é", "e", "è", "e", "ê", "e", "ë", "e",
+	"í", "i", "ì", "i", "î", "i", "ï", "i",
+	"ó", "o", "ò", "o", "ô", "o", "õ", "o", "ö", "o",
+	"ú", "u", "ù", "u", "û", "u", "ü", "u",
+	"ç", "c", "ñ", "n",
+)
+
+func (c Category) Validate() error {
+	if strings.TrimSpace(c.Name) == "" {
+		return errors.New("name is required")
+	}
+
+	if CategoryHandle(c.Name) == "" {
+		return errors.New("name must have at least one letter or number")
+	}
+
+	if c.DisplayOrder < 0 {
+		return errors.New("display order must not be negative")
+	}
+
+	return nil
+}
+
+// CategoryHandle turns the name into the handle used in URLs and filters, "Pães & Doces" becomes
+// "paes-doces". It follows the slugify function of the migrations, dropping accents first.
+func CategoryHandle(name string) string {
+	slug := accentReplacer.Replace(strings.ToLower(name))
+
+	var handle strings.Builder
+	dash := false
+	for _, char := range slug {
+		if (char >= 'a' && char <= 'z') || (char >= '0' && char <= '9') {
+			if dash && handle.Len() > 0 {
+				handle.WriteByte('-')
+			}
+			handle.WriteRune(char)
+			dash = false
+		} else {
+			dash = true
+		}
+	}
+
+	return handle.String()
+}
+
+// UniqueCategoryHandle returns handle, or handle with the first free numeric suffix when it is
+// already taken: "bebidas", "bebidas-2", "bebidas-3".
+func UniqueCategoryHandle(handle string, taken []string) string {
+	used := make(map[string]bool, len(taken))
+	for _, existing := range taken {
+		used[existing] = true
+	}
+
+	candidate := handle
+	for suffix := 2; used[candidate]; suffix++ {
+		candidate = handle + "-" + strconv.Itoa(suffix)
+	}
+
+	return candidate
+}
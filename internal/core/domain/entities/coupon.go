@@ -0,0 +1,134 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+type Coupon struct {
+	ID                      int
+	Code                    string
+	Description             string
+	AmountType              AmountType
+	AmountValue             float64
+	MinOrderAmount          Money
+	StartsAt                *time.Time
+	EndsAt                  *time.Time
+	MaxRedemptions          *int
+	MaxRedemptionsPerClient *int
+	RedemptionsCount        int
+	ProductIDs              []int
+	CategoryIDs             []int
+	Active                  bool
+	CreatedAt               time.Time
+	UpdatedAt               time.Time
+	DeletedAt               *time.Time
+}
+
+// NormalizeCouponCode makes codes case-insensitive, "promo10" and "PROMO10" are the same coupon.
+func NormalizeCouponCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+func (c *Coupon) Validate() error {
+	if c.AmountValue <= 0 {
+		return errors.New("amount value must be greater than zero")
+	}
+
+	if c.AmountType == AmountTypePercentage && c.AmountValue > 100 {
+		return errors.New("percentage amount value must not exceed 100")
+	}
+
+	if c.MinOrderAmount.Cents < 0 {
+		return errors.New("minimum order amount must not be negative")
+	}
+
+	if c.StartsAt != nil && c.EndsAt != nil && !c.EndsAt.After(*c.StartsAt) {
+		return errors.New("ends_at must be after starts_at")
+	}
+
+	return nil
+}
+
+// CheckAvailable reports whether the coupon can still be redeemed at the given time. The
+// per-client limit depends on past orders and is enforced when the redemption is stored.
+func (c *Coupon) CheckAvailable(now time.Time) error {
+	if !c.Active {
+		return errors.New("coupon is not active")
+	}
+
+	if c.StartsAt != nil && now.Before(*c.StartsAt) {
+		return errors.New("coupon is not valid yet")
+	}
+
+	if c.EndsAt != nil && !now.Before(*c.EndsAt) {
+		return errors.New("coupon has expired")
+	}
+
+	if c.MaxRedemptions != nil && c.RedemptionsCount >= *c.MaxRedemptions {
+		return errors.New("coupon usage limit reached")
+	}
+
+	return nil
+}
+
+// Applies reports whether the item is eligible for the discount. Coupons without product or
+// category restrictions apply to every item.
+func (c *Coupon) Applies(product Product) bool {
+	if len(c.ProductIDs) == 0 && len(c.CategoryIDs) == 0 {
+		return true
+	}
+
+	for _, id := range c.ProductIDs {
+		if id == product.ID {
+			return true
+		}
+	}
+
+	for _, id := range c.CategoryIDs {
+		if id == product.Category.ID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Discount returns the order discount granted by the coupon. Only eligible items count towards
+// the base, and a fixed discount never exceeds it.
+func (c *Coupon) Discount(order *Order, products map[int]Product) (OrderDiscount, error) {
+	subtotal := order.Subtotal()
+	if subtotal.LessThan(c.MinOrderAmount) {
+		return OrderDiscount{}, fmt.Errorf("coupon requires a minimum order of %s", c.MinOrderAmount)
+	}
+
+	base := Money{Currency: subtotal.Currency}
+	for _, item := range order.Items {
+		if c.Applies(products[item.ProductID]) {
+			base = base.Add(item.Price.Multiply(item.Quantity))
+		}
+	}
+
+	if !base.IsPositive() {
+		return OrderDiscount{}, errors.New("coupon does not apply to any item of the order")
+	}
+
+	amount := base.Percentage(c.AmountValue)
+	if c.AmountType == AmountTypeFixed {
+		amount = NewMoneyFromFloat(c.AmountValue)
+		amount.Currency = base.Currency
+		if amount.GreaterThan(base) {
+			amount = base
+		}
+	}
+
+	return OrderDiscount{
+		CouponID:    c.ID,
+		Code:        c.Code,
+		AmountType:  c.AmountType,
+		AmountValue: c.AmountValue,
+		Amount:      amount,
+	}, nil
+}
@@ -0,0 +1,73 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ImageUpload is an image sent by an admin, before it is stored.
+type ImageUpload struct {
+	Data []byte
+	// ContentType is sniffed from the bytes, the type declared by the client is not trusted.
+	ContentType string
+	Width       int
+	Height      int
+}
+
+// ThumbnailSize is a resized copy generated for every uploaded image, fitting MaxSide pixels
+// on its longest side. Smaller images are kept at their own size.
+type ThumbnailSize struct {
+	Name    string
+	MaxSide int
+}
+
+var ThumbnailSizes = []ThumbnailSize{
+	{Name: "small", MaxSide: 200},
+	{Name: "medium", MaxSide: 600},
+}
+
+// Thumbnail is the encoded copy of an image resized to Size.
+type Thumbnail struct {
+	Size        ThumbnailSize
+	Data        []byte
+	ContentType string
+}
+
+// MaxImageSide bounds the dimensions of uploads, so a small file cannot decode into a huge bitmap.
+const MaxImageSide = 4096
+
+var imageExtensions = map[string]string{
+	"image/jpeg": "jpg",
+	"image/png":  "png",
+	"image/gif":  "gif",
+}
+
+// ImageExtension returns the file extension of a supported image type.
+func ImageExtension(contentType string) (string, bool) {
+	extension, ok := imageExtensions[contentType]
+	return extension, ok
+}
+
+func (i ImageUpload) Validate(maxBytes int64) error {
+	if len(i.Data) == 0 {
+		return errors.New("image is empty")
+	}
+
+	if int64(len(i.Data)) > maxBytes {
+		return fmt.Errorf("image must be at most %d bytes", maxBytes)
+	}
+
+	if _, ok := ImageExtension(i.ContentType); !ok {
+		return errors.New("image must be a JPEG, PNG or GIF")
+	}
+
+	if i.Width <= 0 || i.Height <= 0 {
+		return errors.New("image could not be read")
+	}
+
+	if i.Width > MaxImageSide || i.Height > MaxImageSide {
+		return fmt.Errorf("image must be at most %dx%d pixels", MaxImageSide, MaxImageSide)
+	}
+
+	return nil
+}
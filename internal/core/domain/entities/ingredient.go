@@ -0,0 +1,118 @@
+package entities
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"time"
+)
+
+type IngredientUnit string
+
+const (
+	IngredientUnitGram       IngredientUnit = "g"
+	IngredientUnitKilogram   IngredientUnit = "kg"
+	IngredientUnitMilliliter IngredientUnit = "ml"
+	IngredientUnitLiter      IngredientUnit = "l"
+	IngredientUnitUnit       IngredientUnit = "unit"
+)
+
+// Ingredient is what the kitchen actually keeps in stock, products consume it through their recipe.
+type Ingredient struct {
+	ID   int
+	Name string
+	Unit IngredientUnit
+	// StockQuantity is the theoretical stock: receipts minus what orders consumed since the last count.
+	StockQuantity float64
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+func (i Ingredient) Validate() error {
+	if strings.TrimSpace(i.Name) == "" {
+		return errors.New("name is required")
+	}
+
+	switch i.Unit {
+	case IngredientUnitGram, IngredientUnitKilogram, IngredientUnitMilliliter, IngredientUnitLiter, IngredientUnitUnit:
+	default:
+		return errors.New("unit must be one of g, kg, ml, l or unit")
+	}
+
+	return nil
+}
+
+// RecipeItem is the quantity of an ingredient, in its unit, used to make one unit of a product.
+type RecipeItem struct {
+	ProductID      int
+	IngredientID   int
+	IngredientName string
+	Unit           IngredientUnit
+	Quantity       float64
+}
+
+// ValidateRecipe checks the recipe of a product. An empty recipe is valid, the product is then
+// never disabled for lack of ingredients.
+func ValidateRecipe(items []RecipeItem) error {
+	seen := make(map[int]bool)
+	for _, item := range items {
+		if item.Quantity <= 0 {
+			return errors.New("ingredient quantities must be greater than zero")
+		}
+
+		if seen[item.IngredientID] {
+			return errors.New("each ingredient can only appear once in a recipe")
+		}
+		seen[item.IngredientID] = true
+	}
+
+	return nil
+}
+
+type IngredientMovementType string
+
+const (
+	IngredientMovementReceipt         IngredientMovementType = "receipt"
+	IngredientMovementConsumption     IngredientMovementType = "consumption"
+	IngredientMovementCountAdjustment IngredientMovementType = "count_adjustment"
+)
+
+// IngredientMovement is an entry of the ingredient stock ledger. Receipts are positive,
+// consumptions negative and count adjustments carry the difference found by a count.
+type IngredientMovement struct {
+	ID           int
+	IngredientID int
+	OrderID      *int
+	Type         IngredientMovementType
+	Quantity     float64
+	Description  string
+	CreatedAt    time.Time
+}
+
+// IngredientCount is a physical count of an ingredient, kept with the theoretical stock at that
+// moment so losses can be reported.
+type IngredientCount struct {
+	ID                  int
+	IngredientID        int
+	CountedQuantity     float64
+	TheoreticalQuantity float64
+	Description         string
+	CountedAt           time.Time
+}
+
+// Variance is how much more was counted than expected, negative when stock went missing.
+func (c IngredientCount) Variance() float64 {
+	return RoundIngredientQuantity(c.CountedQuantity - c.TheoreticalQuantity)
+}
+
+// InventoryReportLine compares the theoretical stock of an ingredient with its last count.
+type InventoryReportLine struct {
+	Ingredient Ingredient
+	LastCount  *IngredientCount
+}
+
+// RoundIngredientQuantity rounds to the three decimals stored, so sums of quantities do not
+// show floating point noise.
+func RoundIngredientQuantity(quantity float64) float64 {
+	return math.Round(quantity*1000) / 1000
+}
@@ -0,0 +1,137 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+type LoyaltyEntryType string
+
+const (
+	LoyaltyEntryEarn           LoyaltyEntryType = "earn"
+	LoyaltyEntryRedeem         LoyaltyEntryType = "redeem"
+	LoyaltyEntryEarnReversal   LoyaltyEntryType = "earn_reversal"
+	LoyaltyEntryRedeemReversal LoyaltyEntryType = "redeem_reversal"
+)
+
+// LoyaltyCouponCode identifies the order discount paid with loyalty points.
+const LoyaltyCouponCode = "LOYALTY"
+
+// LoyaltyEntry is a line of the append-only points ledger. Points are positive for credits
+// and negative for debits, the balance is the sum of every entry of the client.
+type LoyaltyEntry struct {
+	ID          int
+	ClientID    int
+	OrderID     *int
+	Type        LoyaltyEntryType
+	Points      int
+	Description string
+	CreatedAt   time.Time
+}
+
+// LoyaltyProgram holds the rules used to earn and redeem points.
+type LoyaltyProgram struct {
+	// PointsPerCurrencyUnit is how many points each whole currency unit paid earns.
+	PointsPerCurrencyUnit int
+	// PointValue is the discount granted by each redeemed point.
+	PointValue Money
+}
+
+func LoyaltyBalance(entries []LoyaltyEntry) int {
+	balance := 0
+	for _, entry := range entries {
+		balance += entry.Points
+	}
+
+	return balance
+}
+
+// PointsEarned returns the points earned by paying amount, fractions of a currency unit earn nothing.
+func (p LoyaltyProgram) PointsEarned(amount Money) int {
+	return int(amount.Cents/100) * p.PointsPerCurrencyUnit
+}
+
+// Redemption returns the discount source that pays part of an order with points.
+func (p LoyaltyProgram) Redemption(points int) LoyaltyRedemption {
+	return LoyaltyRedemption{Points: points, PointValue: p.PointValue}
+}
+
+// PendingEntries compares the order with the ledger entries already posted for it and returns
+// the entries still missing, so it can be called again after every order change:
+//   - delivered orders earn points over the amount paid
+//   - refunds reverse earned points in proportion to the amount returned
+//   - canceled or fully refunded orders get their redeemed points back
+func (p LoyaltyProgram) PendingEntries(order Order, posted []LoyaltyEntry) []LoyaltyEntry {
+	totals := make(map[LoyaltyEntryType]int)
+	for _, entry := range posted {
+		totals[entry.Type] += entry.Points
+	}
+
+	orderID := order.ID
+	newEntry := func(entryType LoyaltyEntryType, points int, description string) LoyaltyEntry {
+		return LoyaltyEntry{ClientID: order.ClientID, OrderID: &orderID, Type: entryType, Points: points, Description: description}
+	}
+
+	entries := make([]LoyaltyEntry, 0)
+
+	earned := totals[LoyaltyEntryEarn]
+	if _, ok := totals[LoyaltyEntryEarn]; !ok && order.Status == OrderStatusDelivered {
+		earned = p.PointsEarned(order.Payment.Amount)
+		if earned > 0 {
+			entries = append(entries, newEntry(LoyaltyEntryEarn, earned, fmt.Sprintf("Points earned on order %d", order.ID)))
+		}
+	}
+
+	if earned > 0 {
+		toReverse := 0
+		if order.Status == OrderStatusCanceled {
+			toReverse = earned
+		} else if order.Payment.Amount.IsPositive() {
+			toReverse = int(int64(earned) * order.Payment.RefundedAmount.Cents / order.Payment.Amount.Cents)
+		}
+
+		// Earn reversals are negative, so the sum of posted ones is minus what was already reversed
+		if missing := toReverse + totals[LoyaltyEntryEarnReversal]; missing > 0 {
+			entries = append(entries, newEntry(LoyaltyEntryEarnReversal, -missing, fmt.Sprintf("Points reversed after refund or cancellation of order %d", order.ID)))
+		}
+	}
+
+	redeemed := -totals[LoyaltyEntryRedeem]
+	_, redemptionReturned := totals[LoyaltyEntryRedeemReversal]
+	fullyRefunded := order.Payment.Status == PaymentStatusRefunded
+	if redeemed > 0 && !redemptionReturned && (order.Status == OrderStatusCanceled || fullyRefunded) {
+		entries = append(entries, newEntry(LoyaltyEntryRedeemReversal, redeemed, fmt.Sprintf("Redeemed points returned from order %d", order.ID)))
+	}
+
+	return entries
+}
+
+// LoyaltyRedemption pays part of an order with points, it is applied like a coupon.
+type LoyaltyRedemption struct {
+	Points     int
+	PointValue Money
+}
+
+// Discount returns the discount paid with the points. Points can only cover what is left of the
+// order after other discounts, asking for more is an error instead of silently spending less.
+func (r LoyaltyRedemption) Discount(order *Order, _ map[int]Product) (OrderDiscount, error) {
+	if r.Points <= 0 {
+		return OrderDiscount{}, errors.New("loyalty points to redeem must be greater than zero")
+	}
+
+	remaining := order.Subtotal().Sub(order.DiscountsTotal())
+	amount := r.PointValue.Multiply(r.Points)
+	amount.Currency = remaining.Currency
+	if amount.GreaterThan(remaining) {
+		return OrderDiscount{}, fmt.Errorf("%d loyalty points are worth %s, more than the %s left to pay", r.Points, amount, remaining)
+	}
+
+	return OrderDiscount{
+		Code:          LoyaltyCouponCode,
+		AmountType:    AmountTypeFixed,
+		AmountValue:   amount.Float64(),
+		Amount:        amount,
+		LoyaltyPoints: r.Points,
+	}, nil
+}
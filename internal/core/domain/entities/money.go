@@ -0,0 +1,140 @@
+package entities
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+const DefaultCurrency = "BRL"
+
+// Money is an amount in integer cents of a currency, so sums never drift like float64 does.
+type Money struct {
+	Cents    int64  `json:"cents" example:"1990"`
+	Currency string `json:"currency" example:"BRL"`
+}
+
+func NewMoney(cents int64) Money {
+	return Money{Cents: cents, Currency: DefaultCurrency}
+}
+
+// NewMoneyFromFloat converts a decimal amount (19.9) to cents, rounding half away from zero.
+func NewMoneyFromFloat(amount float64) Money {
+	return NewMoney(int64(math.Round(amount * 100)))
+}
+
+func (m Money) currency() string {
+	if m.Currency == "" {
+		return DefaultCurrency
+	}
+
+	return m.Currency
+}
+
+// resultCurrency keeps the currency of m, or takes the one of other when m is a zero value.
+func (m Money) resultCurrency(other Money) string {
+	if m.Currency == "" {
+		return other.currency()
+	}
+
+	return m.Currency
+}
+
+func (m Money) Add(other Money) Money {
+	return Money{Cents: m.Cents + other.Cents, Currency: m.resultCurrency(other)}
+}
+
+func (m Money) Sub(other Money) Money {
+	return Money{Cents: m.Cents - other.Cents, Currency: m.resultCurrency(other)}
+}
+
+func (m Money) Multiply(quantity int) Money {
+	return Money{Cents: m.Cents * int64(quantity), Currency: m.currency()}
+}
+
+// Percentage returns percent% of the amount, rounded to the nearest cent.
+func (m Money) Percentage(percent float64) Money {
+	return Money{Cents: int64(math.Round(float64(m.Cents) * percent / 100)), Currency: m.currency()}
+}
+
+// SameCurrency reports whether both amounts are in the same currency, empty meaning the default one.
+func (m Money) SameCurrency(other Money) bool {
+	return m.currency() == other.currency()
+}
+
+func (m Money) IsZero() bool {
+	return m.Cents == 0
+}
+
+func (m Money) IsPositive() bool {
+	return m.Cents > 0
+}
+
+func (m Money) GreaterThan(other Money) bool {
+	return m.Cents > other.Cents
+}
+
+func (m Money) LessThan(other Money) bool {
+	return m.Cents < other.Cents
+}
+
+// Float64 returns the amount in currency units. Use it only for display and legacy JSON.
+func (m Money) Float64() float64 {
+	return float64(m.Cents) / 100
+}
+
+// String formats the amount with two decimals, e.g. "19.90".
+func (m Money) String() string {
+	sign := ""
+	cents := m.Cents
+	if cents < 0 {
+		sign = "-"
+		cents = -cents
+	}
+
+	return fmt.Sprintf("%s%d.%02d", sign, cents/100, cents%100)
+}
+
+// MarshalJSON writes the object format, {"cents": 1990, "currency": "BRL"}. API responses pick
+// their own format, see dto.Money.
+func (m Money) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Cents    int64  `json:"cents"`
+		Currency string `json:"currency"`
+	}{Cents: m.Cents, Currency: m.currency()})
+}
+
+// UnmarshalJSON accepts the object format and, for existing clients, a plain decimal number.
+func (m *Money) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	if len(data) > 0 && data[0] != '{' {
+		amount, err := strconv.ParseFloat(string(data), 64)
+		if err != nil {
+			return fmt.Errorf("invalid money amount %s", data)
+		}
+
+		*m = NewMoneyFromFloat(amount)
+		return nil
+	}
+
+	var object struct {
+		Cents    int64  `json:"cents"`
+		Currency string `json:"currency"`
+	}
+	if err := json.Unmarshal(data, &object); err != nil {
+		return err
+	}
+
+	*m = Money{Cents: object.Cents, Currency: object.Currency}
+	if m.Currency == "" {
+		m.Currency = DefaultCurrency
+	}
+
+	return nil
+}
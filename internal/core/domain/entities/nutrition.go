@@ -0,0 +1,179 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+type Allergen string
+
+const (
+	AllergenGluten      Allergen = "gluten"
+	AllergenLactose     Allergen = "lactose"
+	AllergenMilk        Allergen = "milk"
+	AllergenEggs        Allergen = "eggs"
+	AllergenPeanuts     Allergen = "peanuts"
+	AllergenTreeNuts    Allergen = "tree_nuts"
+	AllergenSoy         Allergen = "soy"
+	AllergenFish        Allergen = "fish"
+	AllergenCrustaceans Allergen = "crustaceans"
+	AllergenMolluscs    Allergen = "molluscs"
+	AllergenSesame      Allergen = "sesame"
+	AllergenMustard     Allergen = "mustard"
+	AllergenCelery      Allergen = "celery"
+	AllergenSulfites    Allergen = "sulfites"
+	AllergenLupin       Allergen = "lupin"
+)
+
+// Allergens is the controlled vocabulary of allergens, the database checks the same list.
+var Allergens = []Allergen{
+	AllergenGluten, AllergenLactose, AllergenMilk, AllergenEggs, AllergenPeanuts, AllergenTreeNuts, AllergenSoy, AllergenFish,
+	AllergenCrustaceans, AllergenMolluscs, AllergenSesame, AllergenMustard, AllergenCelery, AllergenSulfites, AllergenLupin,
+}
+
+func (a Allergen) Valid() bool {
+	for _, allergen := range Allergens {
+		if a == allergen {
+			return true
+		}
+	}
+
+	return false
+}
+
+type DietaryTag string
+
+const (
+	DietaryTagVegan       DietaryTag = "vegan"
+	DietaryTagVegetarian  DietaryTag = "vegetarian"
+	DietaryTagGlutenFree  DietaryTag = "gluten_free"
+	DietaryTagLactoseFree DietaryTag = "lactose_free"
+)
+
+var DietaryTags = []DietaryTag{DietaryTagVegan, DietaryTagVegetarian, DietaryTagGlutenFree, DietaryTagLactoseFree}
+
+func (t DietaryTag) Valid() bool {
+	for _, tag := range DietaryTags {
+		if t == tag {
+			return true
+		}
+	}
+
+	return false
+}
+
+// dietaryTagConflicts are the allergens a product with the tag cannot contain.
+var dietaryTagConflicts = map[DietaryTag][]Allergen{
+	DietaryTagVegan:       {AllergenMilk, AllergenLactose, AllergenEggs, AllergenFish, AllergenCrustaceans, AllergenMolluscs},
+	DietaryTagVegetarian:  {AllergenFish, AllergenCrustaceans, AllergenMolluscs},
+	DietaryTagGlutenFree:  {AllergenGluten},
+	DietaryTagLactoseFree: {AllergenLactose},
+}
+
+// NutritionFacts are the nutrients of one serving of the product, in grams unless named otherwise.
+type NutritionFacts struct {
+	ServingSizeG   float64
+	EnergyKcal     float64
+	CarbohydratesG float64
+	SugarsG        float64
+	ProteinG       float64
+	FatG           float64
+	SaturatedFatG  float64
+	FiberG         float64
+	SodiumMg       float64
+}
+
+func (n NutritionFacts) Validate() error {
+	if n.ServingSizeG <= 0 {
+		return errors.New("serving size must be greater than zero")
+	}
+
+	for _, value := range []float64{n.EnergyKcal, n.CarbohydratesG, n.SugarsG, n.ProteinG, n.FatG, n.SaturatedFatG, n.FiberG, n.SodiumMg} {
+		if value < 0 {
+			return errors.New("nutrition facts must not be negative")
+		}
+	}
+
+	if n.SugarsG > n.CarbohydratesG {
+		return errors.New("sugars must not exceed carbohydrates")
+	}
+
+	if n.SaturatedFatG > n.FatG {
+		return errors.New("saturated fat must not exceed fat")
+	}
+
+	return nil
+}
+
+// ValidateDietaryInformation checks the allergens and tags against the vocabulary and each other,
+// a vegan product cannot contain milk, for instance.
+func ValidateDietaryInformation(allergens []Allergen, tags []DietaryTag) error {
+	contains := make(map[Allergen]bool)
+	for _, allergen := range allergens {
+		if !allergen.Valid() {
+			return fmt.Errorf("unknown allergen %q", allergen)
+		}
+		contains[allergen] = true
+	}
+
+	for _, tag := range tags {
+		if !tag.Valid() {
+			return fmt.Errorf("unknown dietary tag %q", tag)
+		}
+
+		for _, allergen := range dietaryTagConflicts[tag] {
+			if contains[allergen] {
+				return fmt.Errorf("a %s product cannot contain %s", strings.ReplaceAll(string(tag), "_", " "), allergen)
+			}
+		}
+	}
+
+	return nil
+}
+
+// ValidateDietaryInformation checks the nutrition facts, when informed, and the allergens and
+// dietary tags of the product.
+func (p Product) ValidateDietaryInformation() error {
+	if p.Nutrition != nil {
+		if err := p.Nutrition.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return ValidateDietaryInformation(p.Allergens, p.DietaryTags)
+}
+
+// AllergenWarning lists the products of an order containing an allergen.
+type AllergenWarning struct {
+	Allergen     Allergen
+	ProductNames []string
+}
+
+// AllergenWarnings gathers the allergens of every item of the order, in vocabulary order.
+func (o *Order) CollectAllergenWarnings(existingMappedProducts map[int]Product) []AllergenWarning {
+	productsByAllergen := make(map[Allergen][]string)
+	seen := make(map[int]bool)
+	for _, item := range o.Items {
+		product, ok := existingMappedProducts[item.ProductID]
+		if !ok || seen[product.ID] {
+			continue
+		}
+		seen[product.ID] = true
+
+		for _, allergen := range product.Allergens {
+			productsByAllergen[allergen] = append(productsByAllergen[allergen], product.Name)
+		}
+	}
+
+	warnings := make([]AllergenWarning, 0, len(productsByAllergen))
+	for _, allergen := range Allergens {
+		if names, ok := productsByAllergen[allergen]; ok {
+			sort.Strings(names)
+			warnings = append(warnings, AllergenWarning{Allergen: allergen, ProductNames: names})
+		}
+	}
+
+	return warnings
+}
@@ -1,6 +1,7 @@
 package entities
 
 import (
+	"errors"
 	"fmt"
 	"time"
 )
@@ -8,61 +9,245 @@ import (
 type OrderStatus string
 
 const (
-	OrderStatusPending   OrderStatus = "pending"
-	OrderStatusPreparing OrderStatus = "preparing"
-	OrderStatusReady     OrderStatus = "ready"
-	OrderStatusDelivered OrderStatus = "delivered"
-	OrderStatusCanceled  OrderStatus = "canceled"
+	OrderStatusPending       OrderStatus = "pending"
+	OrderStatusPaymentFailed OrderStatus = "payment_failed"
+	OrderStatusPreparing     OrderStatus = "preparing"
+	OrderStatusReady         OrderStatus = "ready"
+	OrderStatusDelivered     OrderStatus = "delivered"
+	OrderStatusCanceled      OrderStatus = "canceled"
 )
 
 type Order struct {
 	ID        int
 	ClientID  int
 	Status    OrderStatus
+	Delivery  bool
 	Items     []OrderItem
+	Fees      []OrderFee
+	Discounts []OrderDiscount
 	Payment   Payment
-	CreatedAt time.Time
-	UpdatedAt time.Time
-	DeletedAt *time.Time
+	// CouponCode is the promo code informed at checkout, the granted discount is kept in Discounts.
+	CouponCode string
+	// LoyaltyPoints is how many points the client asked to redeem at checkout.
+	LoyaltyPoints int
+	// PaymentFailedAt is when the order entered payment_failed, the retry window starts there.
+	PaymentFailedAt *time.Time
+	// AllergenWarnings are computed at checkout from the products ordered, they are not stored.
+	AllergenWarnings []AllergenWarning
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+	DeletedAt        *time.Time
 }
 
 type OrderItem struct {
 	ID        int
 	OrderID   int
 	ProductID int
-	Quantity  int
-	Price     float64
-	CreatedAt time.Time
-	UpdatedAt time.Time
-	DeletedAt *time.Time
+	// VariantID is the size ordered, zero for products without variants. VariantName is kept for
+	// display, it is loaded even after the variant is removed.
+	VariantID   int
+	VariantName string
+	Quantity    int
+	Price       Money
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	DeletedAt   *time.Time
+}
+
+// OrderFee is the snapshot of a PaymentTaxSettings charged on an order, kept so totals stay
+// auditable after the setting is changed or removed.
+type OrderFee struct {
+	ID                  int
+	OrderID             int
+	PaymentTaxSettingID int
+	Name                string
+	AmountType          AmountType
+	AmountValue         float64
+	ApplicableTo        ApplicableTo
+	Amount              Money
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
+}
+
+// OrderDiscount is the snapshot of a Coupon or of the loyalty points redeemed on an order.
+type OrderDiscount struct {
+	ID            int
+	OrderID       int
+	CouponID      int
+	Code          string
+	AmountType    AmountType
+	AmountValue   float64
+	Amount        Money
+	LoyaltyPoints int
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// DiscountSource grants a discount on an order, like a Coupon or a LoyaltyRedemption.
+type DiscountSource interface {
+	Discount(order *Order, products map[int]Product) (OrderDiscount, error)
+}
+
+// Subtotal returns the sum of the order items, before discounts and fees.
+func (o *Order) Subtotal() Money {
+	subtotal := Money{}
+	for _, item := range o.Items {
+		subtotal = subtotal.Add(item.Price.Multiply(item.Quantity))
+	}
+
+	return subtotal
+}
+
+// FeesTotal returns the sum of every fee charged on the order.
+func (o *Order) FeesTotal() Money {
+	total := Money{}
+	for _, fee := range o.Fees {
+		total = total.Add(fee.Amount)
+	}
+
+	return total
+}
+
+// DiscountsTotal returns the sum of every discount granted on the order.
+func (o *Order) DiscountsTotal() Money {
+	total := Money{}
+	for _, discount := range o.Discounts {
+		total = total.Add(discount.Amount)
+	}
+
+	return total
+}
+
+// StockShortages returns the items whose product, or variant, does not have enough stock, in item
+// order. Items for the same product and variant are added up, they all come from the same stock.
+func (o *Order) StockShortages(existingMappedProducts map[int]Product) []StockShortage {
+	type stockKey struct{ productID, variantID int }
+
+	requested := make(map[stockKey]int)
+	keys := make([]stockKey, 0)
+	for _, item := range o.Items {
+		key := stockKey{productID: item.ProductID, variantID: item.VariantID}
+		if _, ok := requested[key]; !ok {
+			keys = append(keys, key)
+		}
+		requested[key] += item.Quantity
+	}
+
+	shortages := make([]StockShortage, 0)
+	for _, key := range keys {
+		// Unknown products and variants are rejected by CalculateTotalAmount
+		product, ok := existingMappedProducts[key.productID]
+		if !ok {
+			continue
+		}
+		if _, ok := product.Variant(key.variantID); key.variantID != 0 && !ok {
+			continue
+		}
+
+		stock := product.StockFor(key.variantID)
+		if stock.Covers(requested[key]) {
+			continue
+		}
+
+		shortages = append(shortages, StockShortage{
+			ProductID:   key.productID,
+			VariantID:   key.variantID,
+			ProductName: product.DisplayName(key.variantID),
+			Requested:   requested[key],
+			Available:   stock.Quantity,
+		})
+	}
+
+	return shortages
 }
 
 // CalculateTotalAmount calculates the total amount for the order, including product prices and applicable taxes.
-// It takes two parameters:
+// It takes three parameters:
 // - existingMappedProducts: a map of product IDs to Product entities
 // - existingPaymentTaxes: a slice of PaymentTaxSettings entities
+// - discounts: the coupon and loyalty redemption used at checkout, applied in order
 //
 // The function performs the following steps:
-// 1. Calculates the base total amount from the order items and their quantities
-// 2. Applies any applicable taxes based on the payment method and tax settings
-// 3. Sets the final amount to the Payment.Amount field of the Order
+// 1. Calculates the base total amount from the order items and their quantities, items of products
+// with variants are priced by the variant ordered
+// 2. Applies the discounts, recording each one in Discounts
+// 3. Applies any applicable taxes over the discounted amount, recording each one in Fees
+// 4. Sets the final amount to the Payment.Amount field of the Order
 //
-// Returns an error if any product in the order is not found in the existingMappedProducts map.
-func (o *Order) CalculateTotalAmount(existingMappedProducts map[int]Product) error {
-	totalAmount := 0.0
-
+// Every amount is summed in integer cents, so the total never drifts with the number of items.
+// Returns an error if any product in the order is not found in the existingMappedProducts map, if
+// an item misses the variant of a product with variants or names one it does not have, if the
+// products are priced in different currencies or if a discount does not apply to the order.
+func (o *Order) CalculateTotalAmount(existingMappedProducts map[int]Product, existingPaymentTaxes []PaymentTaxSettings, discounts ...DiscountSource) error {
 	// Calculate base total amount from order items
 	for idx, item := range o.Items {
-		if product, ok := existingMappedProducts[item.ProductID]; ok {
-			item.Price = product.Price
-			totalAmount += item.Price * float64(item.Quantity)
-		} else {
+		product, ok := existingMappedProducts[item.ProductID]
+		if !ok {
 			return fmt.Errorf("product not found for id %d", item.ProductID)
 		}
+		price, err := product.PriceFor(item.VariantID)
+		if err != nil {
+			return err
+		}
+		item.Price = price
+		if variant, ok := product.Variant(item.VariantID); ok {
+			item.VariantName = variant.Name
+		}
+		if idx > 0 && !item.Price.SameCurrency(o.Items[0].Price) {
+			return fmt.Errorf("product %d is priced in %s, other items in %s", item.ProductID, item.Price.Currency, o.Items[0].Price.Currency)
+		}
 		o.Items[idx] = item
 	}
 
-	o.Payment.Amount = totalAmount
+	// Apply discounts, each one over what the previous ones left to pay
+	o.Discounts = make([]OrderDiscount, 0)
+	for _, source := range discounts {
+		discount, err := source.Discount(o, existingMappedProducts)
+		if err != nil {
+			return err
+		}
+		o.Discounts = append(o.Discounts, discount)
+	}
+
+	o.ApplyFees(existingPaymentTaxes)
+
+	return nil
+}
+
+// ApplyFees charges the taxes matching the payment method, delivery and platform over the
+// discounted subtotal, replacing the order fees, and sets the resulting Payment.Amount.
+func (o *Order) ApplyFees(existingPaymentTaxes []PaymentTaxSettings) {
+	subtotal := o.Subtotal().Sub(o.DiscountsTotal())
+
+	o.Fees = make([]OrderFee, 0)
+	for _, tax := range existingPaymentTaxes {
+		if !tax.AppliesTo(o) {
+			continue
+		}
+
+		o.Fees = append(o.Fees, OrderFee{
+			PaymentTaxSettingID: tax.ID,
+			Name:                tax.Name,
+			AmountType:          tax.AmountType,
+			AmountValue:         tax.AmountValue,
+			ApplicableTo:        tax.ApplicableTo,
+			Amount:              tax.Calculate(subtotal),
+		})
+	}
+
+	o.Payment.Amount = subtotal.Add(o.FeesTotal())
+}
+
+// CheckPaymentRetry reports whether the failed payment of the order can still be retried at the
+// given time. The order is canceled once the window ends.
+func (o *Order) CheckPaymentRetry(now time.Time, window time.Duration) error {
+	if o.Status != OrderStatusPaymentFailed || o.PaymentFailedAt == nil {
+		return fmt.Errorf("only orders with a failed payment can be paid again, order is %s", o.Status)
+	}
+
+	if !now.Before(o.PaymentFailedAt.Add(window)) {
+		return errors.New("the window to retry the payment has expired")
+	}
 
 	return nil
 }
@@ -14,6 +14,9 @@ const (
 	PaymentStatusPending  PaymentStatus = "pending"
 	PaymentStatusApproved PaymentStatus = "approved"
 	PaymentStatusFailed   PaymentStatus = "failed"
+
+	PaymentStatusPartiallyRefunded PaymentStatus = "partially_refunded"
+	PaymentStatusRefunded          PaymentStatus = "refunded"
 )
 
 type PaymentMethod string
@@ -30,12 +33,16 @@ type Payment struct {
 	OrderID           int
 	Status            PaymentStatus
 	Method            PaymentMethod
-	Amount            float64
+	Amount            Money
+	RefundedAmount    Money
 	ExternalReference string
 	QRData            string
-	CreatedAt         time.Time
-	UpdatedAt         time.Time
-	DeletedAt         *time.Time
+	// CardData is only set while a credit card payment is being created, Card is what is stored.
+	CardData  *CardData
+	Card      *PaymentCard
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt *time.Time
 }
 
 func (p *Payment) Authorize() error {
@@ -54,3 +61,18 @@ func (p *Payment) Authorize() error {
 
 	return nil
 }
+
+// IsRefundable reports whether the payment was captured and still has money that can be returned.
+func (p *Payment) IsRefundable() bool {
+	return (p.Status == PaymentStatusApproved || p.Status == PaymentStatusPartiallyRefunded) && p.RefundableAmount().IsPositive()
+}
+
+// NetAmount returns what the store keeps of the payment, the amount charged minus the refunds.
+func (p *Payment) NetAmount() Money {
+	return p.Amount.Sub(p.RefundedAmount)
+}
+
+// RefundableAmount returns how much of the payment has not been refunded yet.
+func (p *Payment) RefundableAmount() Money {
+	return p.Amount.Sub(p.RefundedAmount)
+}
@@ -1,6 +1,9 @@
 package entities
 
-import "time"
+import (
+	"errors"
+	"time"
+)
 
 type AmountType string
 
@@ -12,9 +15,10 @@ const (
 type ApplicableTo string
 
 const (
-	ApplicableToCreditCard     ApplicableTo = "credit_card"
-	ApplicableToTransportation ApplicableTo = "transportation"
-	ApplicableToPlatformFee    ApplicableTo = "platform_fee"
+	ApplicableToCreditCard  ApplicableTo = "credit_card"
+	ApplicableToQRCode      ApplicableTo = "qr_code"
+	ApplicableToPlatformFee ApplicableTo = "platform_fee"
+	ApplicableToDelivery    ApplicableTo = "delivery"
 )
 
 type PaymentTaxSettings struct {
@@ -24,7 +28,51 @@ type PaymentTaxSettings struct {
 	AmountType   AmountType
 	AmountValue  float64
 	ApplicableTo ApplicableTo
+	Active       bool
 	CreatedAt    time.Time
 	UpdatedAt    time.Time
 	DeletedAt    *time.Time
 }
+
+// AppliesTo reports whether the setting charges the given order. Payment method settings only
+// match orders paid with that method, delivery settings only match delivery orders and the
+// platform fee matches every order.
+func (t *PaymentTaxSettings) AppliesTo(order *Order) bool {
+	if !t.Active {
+		return false
+	}
+
+	switch t.ApplicableTo {
+	case ApplicableToPlatformFee:
+		return true
+	case ApplicableToDelivery:
+		return order.Delivery
+	default:
+		return string(t.ApplicableTo) == string(order.Payment.Method)
+	}
+}
+
+// Calculate returns the fee charged over base, rounded to cents. Fixed amounts are in the
+// currency of base.
+func (t *PaymentTaxSettings) Calculate(base Money) Money {
+	if t.AmountType == AmountTypePercentage {
+		return base.Percentage(t.AmountValue)
+	}
+
+	fee := NewMoneyFromFloat(t.AmountValue)
+	fee.Currency = base.currency()
+
+	return fee
+}
+
+func (t *PaymentTaxSettings) Validate() error {
+	if t.AmountValue < 0 {
+		return errors.New("amount value must not be negative")
+	}
+
+	if t.AmountType == AmountTypePercentage && t.AmountValue > 100 {
+		return errors.New("percentage amount value must not exceed 100")
+	}
+
+	return nil
+}
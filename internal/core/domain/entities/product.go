@@ -1,29 +1,124 @@
 package entities
 
-import "time"
+import (
+	"errors"
+	"time"
+)
 
 type ProductImage struct {
-	ID        int
-	ImageURL  string
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	ID       int
+	ImageURL string
+	// StorageKey is only set for uploaded images, external URLs are not stored by the API.
+	StorageKey  string
+	ContentType string
+	Thumbnails  []ProductImageThumbnail
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// ProductImageThumbnail is a resized copy of an uploaded image, Name is its size like small or medium.
+type ProductImageThumbnail struct {
+	Name       string `json:"name"`
+	StorageKey string `json:"storage_key"`
+	URL        string `json:"url"`
 }
 
 type ProductCategory struct {
-	ID        int
-	Name      string
-	Handle    string
+	ID     int
+	Name   string
+	Handle string
+	// Active is false when the category was hidden from the menu, its products cannot be ordered.
+	Active    bool
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
 
 type Product struct {
-	ID          int
+	ID int
+	// SKU identifies the product across environments, it is generated from the id when not informed.
+	SKU         string
 	Name        string
 	Description string
-	Price       float64
+	Price       Money
 	Category    ProductCategory
 	Images      []ProductImage
+	Stock       ProductStock
+	// Variants are the sizes the product is ordered in, in display order. Their stock is used
+	// instead of the product stock. Empty for products ordered as they are.
+	Variants []ProductVariant
+	// IngredientsAvailable is false while an ingredient of the recipe is short, the product cannot
+	// be ordered until it is received again.
+	IngredientsAvailable bool
+	// Availability holds the windows the product can be ordered in, its own or, when it has none,
+	// those of its category.
+	Availability AvailabilitySchedule
+	// Nutrition is nil when the facts were not informed.
+	Nutrition   *NutritionFacts
+	Allergens   []Allergen
+	DietaryTags []DietaryTag
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
+	// DeletedAt is set on deleted products, only listed to admins.
+	DeletedAt *time.Time
+}
+
+type StockStatus string
+
+const (
+	StockStatusUntracked  StockStatus = "untracked"
+	StockStatusInStock    StockStatus = "in_stock"
+	StockStatusLowStock   StockStatus = "low_stock"
+	StockStatusOutOfStock StockStatus = "out_of_stock"
+)
+
+// DefaultLowStockThreshold is the quantity at or below which a tracked product is low on stock,
+// when none is informed.
+const DefaultLowStockThreshold = 5
+
+// ProductStock is the quantity on hand of a product. Untracked products, like made to order
+// dishes, are always available.
+type ProductStock struct {
+	Tracked           bool
+	Quantity          int
+	LowStockThreshold int
+}
+
+func (s ProductStock) Validate() error {
+	if s.Quantity < 0 {
+		return errors.New("stock quantity must not be negative")
+	}
+
+	if s.LowStockThreshold < 0 {
+		return errors.New("low stock threshold must not be negative")
+	}
+
+	return nil
+}
+
+func (s ProductStock) Status() StockStatus {
+	switch {
+	case !s.Tracked:
+		return StockStatusUntracked
+	case s.Quantity == 0:
+		return StockStatusOutOfStock
+	case s.Quantity <= s.LowStockThreshold:
+		return StockStatusLowStock
+	default:
+		return StockStatusInStock
+	}
+}
+
+// Covers tells whether quantity units can be sold.
+func (s ProductStock) Covers(quantity int) bool {
+	return !s.Tracked || s.Quantity >= quantity
+}
+
+// StockShortage is an order item asking for more units than the product, or the variant ordered,
+// has in stock.
+type StockShortage struct {
+	ProductID   int
+	VariantID   int
+	ProductName string
+	Requested   int
+	Available   int
 }
@@ -0,0 +1,52 @@
+package entities
+
+import (
+	"errors"
+	"time"
+)
+
+type ProductPriceStatus string
+
+const (
+	ProductPriceStatusScheduled ProductPriceStatus = "scheduled"
+	ProductPriceStatusApplied   ProductPriceStatus = "applied"
+	ProductPriceStatusCanceled  ProductPriceStatus = "canceled"
+)
+
+// ProductPrice is an entry of the price history of a product. Scheduled prices have no AppliedAt
+// until the price scheduler applies them, after EffectiveAt.
+type ProductPrice struct {
+	ID          int
+	ProductID   int
+	Price       Money
+	EffectiveAt time.Time
+	AppliedAt   *time.Time
+	CanceledAt  *time.Time
+	// ChangedBy is the admin who set the price, empty for prices older than the history.
+	ChangedBy string
+	CreatedAt time.Time
+}
+
+func (p ProductPrice) Validate() error {
+	if p.Price.Cents < 0 {
+		return errors.New("price must not be negative")
+	}
+
+	return nil
+}
+
+func (p ProductPrice) Status() ProductPriceStatus {
+	switch {
+	case p.CanceledAt != nil:
+		return ProductPriceStatusCanceled
+	case p.AppliedAt != nil:
+		return ProductPriceStatusApplied
+	default:
+		return ProductPriceStatusScheduled
+	}
+}
+
+// IsScheduled tells whether the price only takes effect after now.
+func (p ProductPrice) IsScheduled(now time.Time) bool {
+	return p.EffectiveAt.After(now)
+}
@@ -0,0 +1,97 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const maxVariantNameLength = 50
+
+// ProductVariant is a size of a product, like 500ml or G. Products with variants are ordered by
+// variant, each one with its own price and, when tracked, its own stock.
+type ProductVariant struct {
+	ID        int
+	ProductID int
+	Name      string
+	// SKU is generated from the product SKU and the name when not informed.
+	SKU string
+	// Price is always in the currency of the product.
+	Price        Money
+	Stock        ProductStock
+	DisplayOrder int
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+func (v ProductVariant) Validate() error {
+	if strings.TrimSpace(v.Name) == "" {
+		return errors.New("variant name is required")
+	}
+
+	if utf8.RuneCountInString(v.Name) > maxVariantNameLength {
+		return fmt.Errorf("variant name must have at most %d characters", maxVariantNameLength)
+	}
+
+	if v.Price.Cents < 0 {
+		return errors.New("variant price must not be negative")
+	}
+
+	return v.Stock.Validate()
+}
+
+// DefaultVariantSKU derives the SKU of a variant from the product SKU and the variant name, like
+// PRD-000012-500ML.
+func DefaultVariantSKU(productSKU string, name string) string {
+	suffix := strings.ToUpper(strings.Join(strings.Fields(name), "-"))
+	return productSKU + "-" + suffix
+}
+
+// Variant returns the variant of the product with the given id, deleted variants are not loaded.
+func (p Product) Variant(id int) (ProductVariant, bool) {
+	for _, variant := range p.Variants {
+		if variant.ID == id {
+			return variant, true
+		}
+	}
+
+	return ProductVariant{}, false
+}
+
+// PriceFor returns the price of the variant ordered or, for products without variants, of the
+// product. Products with variants cannot be ordered without one.
+func (p Product) PriceFor(variantID int) (Money, error) {
+	if variantID == 0 {
+		if len(p.Variants) > 0 {
+			return Money{}, fmt.Errorf("product %s comes in sizes, choose one of them", p.Name)
+		}
+		return p.Price, nil
+	}
+
+	variant, ok := p.Variant(variantID)
+	if !ok {
+		return Money{}, fmt.Errorf("variant %d not found for product %d", variantID, p.ID)
+	}
+
+	return variant.Price, nil
+}
+
+// StockFor returns the stock the variant ordered is taken from, the product stock without one.
+func (p Product) StockFor(variantID int) ProductStock {
+	if variant, ok := p.Variant(variantID); ok {
+		return variant.Stock
+	}
+
+	return p.Stock
+}
+
+// DisplayName names the product with the variant ordered, like Coca-Cola 500ml.
+func (p Product) DisplayName(variantID int) string {
+	if variant, ok := p.Variant(variantID); ok {
+		return p.Name + " " + variant.Name
+	}
+
+	return p.Name
+}
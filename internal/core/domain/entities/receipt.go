@@ -0,0 +1,128 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ReceiptIssuer identifies the restaurant printed on every receipt.
+type ReceiptIssuer struct {
+	Name    string
+	CNPJ    string
+	Address string
+}
+
+type ReceiptItem struct {
+	ProductID int
+	Name      string
+	Quantity  int
+	UnitPrice Money
+	Total     Money
+}
+
+// ReceiptAdjustment is a discount or fee line, shown with the code or name it was granted under.
+type ReceiptAdjustment struct {
+	Description string
+	Amount      Money
+}
+
+// Receipt is the sale document of a paid order. It is built from the order snapshots, so the same
+// order always yields the same receipt and nothing has to be stored when the payment is approved.
+type Receipt struct {
+	Number         int
+	IssuedAt       time.Time
+	Issuer         ReceiptIssuer
+	CustomerName   string
+	CustomerCPF    string
+	Items          []ReceiptItem
+	Subtotal       Money
+	Discounts      []ReceiptAdjustment
+	Fees           []ReceiptAdjustment
+	Total          Money
+	PaymentMethod  PaymentMethod
+	PaymentStatus  PaymentStatus
+	RefundedAmount Money
+	// NetTotal is the total minus the refunds, what the customer actually paid
+	NetTotal Money
+}
+
+// NewReceipt builds the receipt of the order. Orders only get one once the payment is approved,
+// refunds later on are shown on the receipt but do not void it. Products are looked up for their
+// names, a product removed from the menu since the sale is shown by its ID.
+func NewReceipt(order Order, client Client, products map[int]Product, issuer ReceiptIssuer) (Receipt, error) {
+	switch order.Payment.Status {
+	case PaymentStatusApproved, PaymentStatusPartiallyRefunded, PaymentStatusRefunded:
+	default:
+		return Receipt{}, errors.New("receipts are only issued for orders with an approved payment")
+	}
+
+	receipt := Receipt{
+		Number:         order.ID,
+		IssuedAt:       order.Payment.UpdatedAt,
+		Issuer:         issuer,
+		CustomerName:   client.Name,
+		CustomerCPF:    client.CPF,
+		Items:          make([]ReceiptItem, 0, len(order.Items)),
+		Subtotal:       order.Subtotal(),
+		Discounts:      make([]ReceiptAdjustment, 0, len(order.Discounts)),
+		Fees:           make([]ReceiptAdjustment, 0, len(order.Fees)),
+		Total:          order.Payment.Amount,
+		PaymentMethod:  order.Payment.Method,
+		PaymentStatus:  order.Payment.Status,
+		RefundedAmount: order.Payment.RefundedAmount,
+		NetTotal:       order.Payment.NetAmount(),
+	}
+
+	for _, item := range order.Items {
+		name := fmt.Sprintf("Produto %d", item.ProductID)
+		if product, ok := products[item.ProductID]; ok {
+			name = product.Name
+		}
+		if item.VariantName != "" {
+			name += " " + item.VariantName
+		}
+
+		receipt.Items = append(receipt.Items, ReceiptItem{
+			ProductID: item.ProductID,
+			Name:      name,
+			Quantity:  item.Quantity,
+			UnitPrice: item.Price,
+			Total:     item.Price.Multiply(item.Quantity),
+		})
+	}
+
+	for _, discount := range order.Discounts {
+		description := discount.Code
+		if discount.LoyaltyPoints > 0 {
+			description = fmt.Sprintf("%d pontos de fidelidade", discount.LoyaltyPoints)
+		}
+		receipt.Discounts = append(receipt.Discounts, ReceiptAdjustment{Description: description, Amount: discount.Amount})
+	}
+
+	for _, fee := range order.Fees {
+		receipt.Fees = append(receipt.Fees, ReceiptAdjustment{Description: fee.Name, Amount: fee.Amount})
+	}
+
+	return receipt, nil
+}
+
+// DiscountsTotal returns the sum of every discount line of the receipt.
+func (r *Receipt) DiscountsTotal() Money {
+	total := Money{Currency: r.Subtotal.Currency}
+	for _, discount := range r.Discounts {
+		total = total.Add(discount.Amount)
+	}
+
+	return total
+}
+
+// FeesTotal returns the sum of every fee line of the receipt.
+func (r *Receipt) FeesTotal() Money {
+	total := Money{Currency: r.Subtotal.Currency}
+	for _, fee := range r.Fees {
+		total = total.Add(fee.Amount)
+	}
+
+	return total
+}
@@ -0,0 +1,49 @@
+package entities
+
+import (
+	"errors"
+	"time"
+)
+
+type RefundStatus string
+
+const (
+	RefundStatusPending  RefundStatus = "pending"
+	RefundStatusApproved RefundStatus = "approved"
+	RefundStatusFailed   RefundStatus = "failed"
+)
+
+type Refund struct {
+	ID                int
+	PaymentID         int
+	OrderID           int
+	Amount            Money
+	Reason            string
+	Status            RefundStatus
+	ExternalReference string
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+	DeletedAt         *time.Time
+}
+
+// ValidateRefundAmount checks a refund request against what is still refundable on the payment.
+// reservedAmount is the sum of refunds already requested but not yet settled by the gateway.
+func ValidateRefundAmount(payment Payment, amount, reservedAmount Money) error {
+	if !payment.IsRefundable() {
+		return errors.New("only approved payments can be refunded")
+	}
+
+	if !amount.IsPositive() {
+		return errors.New("refund amount must be greater than zero")
+	}
+
+	if !amount.SameCurrency(payment.Amount) {
+		return errors.New("refund currency must match the payment currency")
+	}
+
+	if amount.GreaterThan(payment.RefundableAmount().Sub(reservedAmount)) {
+		return errors.New("refund amount exceeds the refundable amount of the payment")
+	}
+
+	return nil
+}
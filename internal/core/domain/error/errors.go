@@ -2,6 +2,9 @@ package domain
 
 import (
 	"fmt"
+	"strings"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
 )
 
 type NotFoundError struct {
@@ -38,3 +41,64 @@ func (e *EntityNotProcessableError) Is(target error) bool {
 func NewEntityNotProcessableError(entity, reason string) error {
 	return &EntityNotProcessableError{Entity: entity, Reason: reason}
 }
+
+type ServiceUnavailableError struct {
+	Service string
+	Reason  string
+}
+
+func (e *ServiceUnavailableError) Error() string {
+	return fmt.Sprintf("Service %s unavailable: %s", e.Service, e.Reason)
+}
+
+func (e *ServiceUnavailableError) Is(target error) bool {
+	_, ok := target.(*ServiceUnavailableError)
+	return ok
+}
+
+func NewServiceUnavailableError(service, reason string) error {
+	return &ServiceUnavailableError{Service: service, Reason: reason}
+}
+
+// PaymentDeclinedError is a payment refused by the gateway. Code identifies the reason for clients,
+// Reason is a message that can be shown to the customer.
+type PaymentDeclinedError struct {
+	Code   string
+	Reason string
+}
+
+func (e *PaymentDeclinedError) Error() string {
+	return fmt.Sprintf("Payment declined (%s): %s", e.Code, e.Reason)
+}
+
+func (e *PaymentDeclinedError) Is(target error) bool {
+	_, ok := target.(*PaymentDeclinedError)
+	return ok
+}
+
+func NewPaymentDeclinedError(code, reason string) error {
+	return &PaymentDeclinedError{Code: code, Reason: reason}
+}
+
+// InsufficientStockError rejects an order with items the stock cannot cover, listing each of them.
+type InsufficientStockError struct {
+	Shortages []entities.StockShortage
+}
+
+func (e *InsufficientStockError) Error() string {
+	items := make([]string, len(e.Shortages))
+	for idx, shortage := range e.Shortages {
+		items[idx] = fmt.Sprintf("%s (requested %d, available %d)", shortage.ProductName, shortage.Requested, shortage.Available)
+	}
+
+	return "Insufficient stock: " + strings.Join(items, ", ")
+}
+
+func (e *InsufficientStockError) Is(target error) bool {
+	_, ok := target.(*InsufficientStockError)
+	return ok
+}
+
+func NewInsufficientStockError(shortages []entities.StockShortage) error {
+	return &InsufficientStockError{Shortages: shortages}
+}
@@ -0,0 +1,46 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/config"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+)
+
+type ApplyScheduledPricesUseCase interface {
+	Run(ctx context.Context) (int, error)
+}
+
+type applyScheduledPricesUseCase struct {
+	productPriceRepository ports.ProductPriceRepository
+	productCache           ports.ProductCache
+	batchSize              int
+}
+
+func NewApplyScheduledPricesUseCase(cfg *config.Config, productPriceRepository ports.ProductPriceRepository, productCache ports.ProductCache) ApplyScheduledPricesUseCase {
+	return &applyScheduledPricesUseCase{
+		productPriceRepository: productPriceRepository,
+		productCache:           productCache,
+		batchSize:              cfg.PriceScheduler.BatchSize,
+	}
+}
+
+// Run applies the scheduled prices that became effective, returning how many were applied.
+func (a *applyScheduledPricesUseCase) Run(ctx context.Context) (int, error) {
+	applied, err := a.productPriceRepository.ApplyDue(ctx, time.Now(), a.batchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, price := range applied {
+		slog.Info("Scheduled product price applied", "product_id", price.ProductID, "price_id", price.ID, "price", price.Price)
+	}
+
+	if len(applied) > 0 {
+		a.productCache.Invalidate(ctx)
+	}
+
+	return len(applied), nil
+}
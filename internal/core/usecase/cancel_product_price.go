@@ -0,0 +1,24 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+)
+
+type CancelProductPriceUseCase interface {
+	Run(ctx context.Context, productID int, priceID int) error
+}
+
+type cancelProductPriceUseCase struct {
+	productPriceRepository ports.ProductPriceRepository
+}
+
+func NewCancelProductPriceUseCase(productPriceRepository ports.ProductPriceRepository) CancelProductPriceUseCase {
+	return &cancelProductPriceUseCase{productPriceRepository: productPriceRepository}
+}
+
+// Run cancels a scheduled price, prices already applied are part of the history and stay.
+func (c *cancelProductPriceUseCase) Run(ctx context.Context, productID int, priceID int) error {
+	return c.productPriceRepository.Cancel(ctx, productID, priceID)
+}
@@ -0,0 +1,63 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+	domainError "github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/error"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/dto"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+)
+
+type ChangeProductPriceUseCase interface {
+	Run(ctx context.Context, productID int, input dto.ProductPriceInput, changedBy string) (*entities.ProductPrice, error)
+}
+
+type changeProductPriceUseCase struct {
+	productPriceRepository ports.ProductPriceRepository
+	productCache           ports.ProductCache
+}
+
+func NewChangeProductPriceUseCase(productPriceRepository ports.ProductPriceRepository, productCache ports.ProductCache) ChangeProductPriceUseCase {
+	return &changeProductPriceUseCase{productPriceRepository: productPriceRepository, productCache: productCache}
+}
+
+// Run applies the price at once or, when it is effective in the future, schedules it for the
+// price scheduler worker.
+func (c *changeProductPriceUseCase) Run(ctx context.Context, productID int, input dto.ProductPriceInput, changedBy string) (*entities.ProductPrice, error) {
+	now := time.Now()
+	price := entities.ProductPrice{
+		ProductID:   productID,
+		Price:       input.Price,
+		EffectiveAt: now,
+		ChangedBy:   changedBy,
+	}
+	if input.EffectiveAt != nil {
+		price.EffectiveAt = *input.EffectiveAt
+	}
+	if price.Price.Currency == "" {
+		price.Price.Currency = entities.DefaultCurrency
+	}
+
+	if err := price.Validate(); err != nil {
+		return nil, domainError.NewEntityNotProcessableError("product price", err.Error())
+	}
+
+	if price.IsScheduled(now) {
+		scheduled, err := c.productPriceRepository.Schedule(ctx, price)
+		if err != nil {
+			return nil, err
+		}
+
+		return &scheduled, nil
+	}
+
+	applied, err := c.productPriceRepository.Apply(ctx, price)
+	if err != nil {
+		return nil, err
+	}
+	c.productCache.Invalidate(ctx)
+
+	return &applied, nil
+}
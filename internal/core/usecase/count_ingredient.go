@@ -0,0 +1,40 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/dto"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+)
+
+type CountIngredientUseCase interface {
+	Run(ctx context.Context, ingredientID int, input dto.IngredientCountInput) (*entities.IngredientCount, error)
+}
+
+type countIngredientUseCase struct {
+	ingredientRepository ports.IngredientRepository
+	productCache         ports.ProductCache
+}
+
+func NewCountIngredientUseCase(ingredientRepository ports.IngredientRepository, productCache ports.ProductCache) CountIngredientUseCase {
+	return &countIngredientUseCase{ingredientRepository: ingredientRepository, productCache: productCache}
+}
+
+// Run records a physical count. The theoretical stock becomes the counted quantity and the
+// difference is kept for the inventory report.
+func (c *countIngredientUseCase) Run(ctx context.Context, ingredientID int, input dto.IngredientCountInput) (*entities.IngredientCount, error) {
+	count := entities.IngredientCount{
+		IngredientID:    ingredientID,
+		CountedQuantity: entities.RoundIngredientQuantity(*input.CountedQuantity),
+		Description:     input.Description,
+	}
+
+	createdCount, err := c.ingredientRepository.Count(ctx, count)
+	if err != nil {
+		return nil, err
+	}
+	c.productCache.Invalidate(ctx)
+
+	return &createdCount, nil
+}
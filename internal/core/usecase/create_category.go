@@ -0,0 +1,56 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+	domainError "github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/error"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/dto"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/mappers"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+)
+
+type CreateCategoryUseCase interface {
+	Run(ctx context.Context, input dto.CategoryInput) (*entities.Category, error)
+}
+
+type createCategoryUseCase struct {
+	categoryRepository ports.CategoryRepository
+}
+
+func NewCreateCategoryUseCase(categoryRepository ports.CategoryRepository) CreateCategoryUseCase {
+	return &createCategoryUseCase{categoryRepository: categoryRepository}
+}
+
+func (c *createCategoryUseCase) Run(ctx context.Context, input dto.CategoryInput) (*entities.Category, error) {
+	category := mappers.MapCategoryInputToEntity(0, input)
+	if err := validateCategory(ctx, c.categoryRepository, category); err != nil {
+		return nil, err
+	}
+
+	createdCategory, err := c.categoryRepository.Create(ctx, category)
+	if err != nil {
+		return nil, err
+	}
+
+	return &createdCategory, nil
+}
+
+// validateCategory checks the category rules and that no other category already uses its name.
+func validateCategory(ctx context.Context, categoryRepository ports.CategoryRepository, category entities.Category) error {
+	if err := category.Validate(); err != nil {
+		return domainError.NewEntityNotProcessableError("category", err.Error())
+	}
+
+	existing, err := categoryRepository.GetByName(ctx, category.Name)
+	if err != nil && !errors.Is(err, &domainError.NotFoundError{}) {
+		return err
+	}
+
+	if err == nil && existing.ID != category.ID {
+		return domainError.NewEntityNotProcessableError("category", "name "+category.Name+" is already in use")
+	}
+
+	return nil
+}
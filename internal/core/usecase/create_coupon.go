@@ -0,0 +1,56 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+	domainError "github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/error"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/dto"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/mappers"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+)
+
+type CreateCouponUseCase interface {
+	Run(ctx context.Context, input dto.CouponInput) (*entities.Coupon, error)
+}
+
+type createCouponUseCase struct {
+	couponRepository ports.CouponRepository
+}
+
+func NewCreateCouponUseCase(couponRepository ports.CouponRepository) CreateCouponUseCase {
+	return &createCouponUseCase{couponRepository: couponRepository}
+}
+
+func (c *createCouponUseCase) Run(ctx context.Context, input dto.CouponInput) (*entities.Coupon, error) {
+	coupon := mappers.MapCouponInputToEntity(0, input)
+	if err := validateCoupon(ctx, c.couponRepository, coupon); err != nil {
+		return nil, err
+	}
+
+	createdCoupon, err := c.couponRepository.Create(ctx, coupon)
+	if err != nil {
+		return nil, err
+	}
+
+	return &createdCoupon, nil
+}
+
+// validateCoupon checks the coupon rules and that no other coupon already uses its code.
+func validateCoupon(ctx context.Context, couponRepository ports.CouponRepository, coupon entities.Coupon) error {
+	if err := coupon.Validate(); err != nil {
+		return domainError.NewEntityNotProcessableError("coupon", err.Error())
+	}
+
+	existing, err := couponRepository.GetByCode(ctx, coupon.Code)
+	if err != nil && !errors.Is(err, &domainError.NotFoundError{}) {
+		return err
+	}
+
+	if err == nil && existing.ID != coupon.ID {
+		return domainError.NewEntityNotProcessableError("coupon", "code "+coupon.Code+" is already in use")
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+	domainError "github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/error"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/dto"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/mappers"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+)
+
+type CreateIngredientUseCase interface {
+	Run(ctx context.Context, input dto.IngredientInput) (*entities.Ingredient, error)
+}
+
+type createIngredientUseCase struct {
+	ingredientRepository ports.IngredientRepository
+}
+
+func NewCreateIngredientUseCase(ingredientRepository ports.IngredientRepository) CreateIngredientUseCase {
+	return &createIngredientUseCase{ingredientRepository: ingredientRepository}
+}
+
+// Run adds the ingredient with no stock, receipts bring it in.
+func (c *createIngredientUseCase) Run(ctx context.Context, input dto.IngredientInput) (*entities.Ingredient, error) {
+	ingredient := mappers.MapIngredientInputToEntity(0, input)
+	if err := validateIngredient(ctx, c.ingredientRepository, ingredient); err != nil {
+		return nil, err
+	}
+
+	createdIngredient, err := c.ingredientRepository.Create(ctx, ingredient)
+	if err != nil {
+		return nil, err
+	}
+
+	return &createdIngredient, nil
+}
+
+// validateIngredient checks the ingredient rules and that no other ingredient already uses its name.
+func validateIngredient(ctx context.Context, ingredientRepository ports.IngredientRepository, ingredient entities.Ingredient) error {
+	if err := ingredient.Validate(); err != nil {
+		return domainError.NewEntityNotProcessableError("ingredient", err.Error())
+	}
+
+	existing, err := ingredientRepository.GetByName(ctx, ingredient.Name)
+	if err != nil && !errors.Is(err, &domainError.NotFoundError{}) {
+		return err
+	}
+
+	if err == nil && existing.ID != ingredient.ID {
+		return domainError.NewEntityNotProcessableError("ingredient", "name "+ingredient.Name+" is already in use")
+	}
+
+	return nil
+}
@@ -3,9 +3,10 @@ package usecase
 import (
 	"context"
 	"errors"
+	"log/slog"
+	"time"
 
-	"github.com/redis/go-redis/v9"
-	paymentGateways "github.com/tupizz/restaurant-food-golang-api-fiap/internal/adapters/gateways/payment"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/config"
 	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
 	domainError "github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/error"
 	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
@@ -16,13 +17,18 @@ type CreateOrderUseCase interface {
 }
 
 type createOrderUseCase struct {
-	orderRepository   ports.OrderRepository
-	productRepository ports.ProductRepository
-	redisClient       *redis.Client
+	orderRepository              ports.OrderRepository
+	productRepository            ports.ProductRepository
+	paymentTaxSettingsRepository ports.PaymentTaxSettingsRepository
+	couponRepository             ports.CouponRepository
+	gatewayResolver              ports.PaymentGatewayResolver
+	cardTokenizer                ports.CardTokenizer
+	loyaltyProgram               entities.LoyaltyProgram
+	location                     *time.Location
 }
 
-func NewCreateOrderUseCase(orderRepository ports.OrderRepository, productRepository ports.ProductRepository, redisClient *redis.Client) CreateOrderUseCase {
-	return &createOrderUseCase{orderRepository: orderRepository, productRepository: productRepository, redisClient: redisClient}
+func NewCreateOrderUseCase(cfg *config.Config, orderRepository ports.OrderRepository, productRepository ports.ProductRepository, paymentTaxSettingsRepository ports.PaymentTaxSettingsRepository, couponRepository ports.CouponRepository, gatewayResolver ports.PaymentGatewayResolver, cardTokenizer ports.CardTokenizer) CreateOrderUseCase {
+	return &createOrderUseCase{orderRepository: orderRepository, productRepository: productRepository, paymentTaxSettingsRepository: paymentTaxSettingsRepository, couponRepository: couponRepository, gatewayResolver: gatewayResolver, cardTokenizer: cardTokenizer, loyaltyProgram: loyaltyProgram(cfg), location: cfg.Store.Location}
 }
 
 func (c *createOrderUseCase) Run(ctx context.Context, order entities.Order) (*entities.Order, error) {
@@ -41,30 +47,140 @@ func (c *createOrderUseCase) Run(ctx context.Context, order entities.Order) (*en
 		mappedProducts[product.ID] = product
 	}
 
-	err = order.CalculateTotalAmount(mappedProducts)
+	now := time.Now().In(c.location)
+	for _, item := range order.Items {
+		product, ok := mappedProducts[item.ProductID]
+		if !ok {
+			continue
+		}
+
+		if !product.Category.Active {
+			return nil, domainError.NewEntityNotProcessableError("order", "product "+product.Name+" is unavailable, its category is inactive")
+		}
+
+		if !product.IngredientsAvailable {
+			return nil, domainError.NewEntityNotProcessableError("order", "product "+product.Name+" is unavailable, its ingredients ran out")
+		}
+
+		if !product.Availability.AvailableAt(now) {
+			return nil, domainError.NewEntityNotProcessableError("order", "product "+product.Name+" is not available at this time")
+		}
+	}
+
+	// Stock is reserved again when the order is stored, this only avoids charging for an order that
+	// cannot be served
+	if shortages := order.StockShortages(mappedProducts); len(shortages) > 0 {
+		return nil, domainError.NewInsufficientStockError(shortages)
+	}
+
+	paymentTaxes, err := c.paymentTaxSettingsRepository.GetActive(ctx)
 	if err != nil {
-		return nil, domainError.NewEntityNotProcessableError("order", err.Error())
+		return nil, err
 	}
 
-	var paymentGateway ports.PaymentGateway
-	switch order.Payment.Method {
-	case "qr_code":
-		paymentGateway = paymentGateways.NewQRCodePaymentGateway(c.redisClient)
-	case "credit_card":
-		paymentGateway = paymentGateways.NewCreditCardMockGateway()
-	default:
-		return nil, errors.New("payment method not supported")
+	// The coupon is applied first, points pay part of what is left
+	discounts := make([]entities.DiscountSource, 0)
+	coupon, err := c.getCoupon(ctx, order.CouponCode)
+	if err != nil {
+		return nil, err
+	}
+	if coupon != nil {
+		discounts = append(discounts, coupon)
+	}
+	if order.LoyaltyPoints > 0 {
+		discounts = append(discounts, c.loyaltyProgram.Redemption(order.LoyaltyPoints))
+	}
+
+	err = order.CalculateTotalAmount(mappedProducts, paymentTaxes, discounts...)
+	if err != nil {
+		return nil, domainError.NewEntityNotProcessableError("order", err.Error())
 	}
 
-	err = paymentGateway.Authorize(&order.Payment)
+	paymentGateway, err := c.gatewayResolver.Resolve(order.Payment.Method)
 	if err != nil {
+		return nil, err
+	}
+
+	if err := tokenizeCard(ctx, c.cardTokenizer, &order.Payment); err != nil {
+		return nil, err
+	}
+
+	err = paymentGateway.Authorize(ctx, &order.Payment)
+	if errors.Is(err, &domainError.ServiceUnavailableError{}) || errors.Is(err, &domainError.PaymentDeclinedError{}) {
+		return nil, err
+	} else if err != nil {
 		return nil, domainError.NewEntityNotProcessableError("payment", err.Error())
 	}
 
 	createdOrder, err := c.orderRepository.Create(ctx, order)
 	if err != nil {
+		voidAuthorization(ctx, paymentGateway, &order.Payment)
 		return nil, err
 	}
+	createdOrder.AllergenWarnings = order.CollectAllergenWarnings(mappedProducts)
 
 	return &createdOrder, nil
 }
+
+// getCoupon loads the coupon informed at checkout, nil when no code was sent. Usage limits are
+// checked again when the redemption is stored, this only rejects coupons that are already unusable.
+func (c *createOrderUseCase) getCoupon(ctx context.Context, code string) (*entities.Coupon, error) {
+	if code == "" {
+		return nil, nil
+	}
+
+	coupon, err := c.couponRepository.GetByCode(ctx, code)
+	if errors.Is(err, &domainError.NotFoundError{}) {
+		return nil, domainError.NewEntityNotProcessableError("coupon", "coupon "+entities.NormalizeCouponCode(code)+" not found")
+	} else if err != nil {
+		return nil, err
+	}
+
+	if err := coupon.CheckAvailable(time.Now()); err != nil {
+		return nil, domainError.NewEntityNotProcessableError("coupon", err.Error())
+	}
+
+	return &coupon, nil
+}
+
+// tokenizeCard exchanges the card informed for a credit card payment for a token. The card data is
+// dropped right after, so it never reaches the gateway call or the database.
+func tokenizeCard(ctx context.Context, tokenizer ports.CardTokenizer, payment *entities.Payment) error {
+	cardData := payment.CardData
+	payment.CardData = nil
+
+	if payment.Method != entities.PaymentMethodCreditCard {
+		return nil
+	}
+	if cardData == nil {
+		return domainError.NewEntityNotProcessableError("payment", "card is required for credit_card payments")
+	}
+	if err := cardData.Validate(time.Now()); err != nil {
+		return domainError.NewEntityNotProcessableError("payment", err.Error())
+	}
+
+	card, err := tokenizer.Tokenize(ctx, *cardData)
+	if err != nil {
+		return err
+	}
+	payment.Card = &card
+
+	return nil
+}
+
+// voidAuthorization gives back a payment authorized for an order that could not be stored, like
+// when the stock or the coupon ran out in between, so the client is never charged for nothing.
+func voidAuthorization(ctx context.Context, paymentGateway ports.PaymentGateway, payment *entities.Payment) {
+	refund := entities.Refund{
+		PaymentID: payment.ID,
+		Amount:    payment.Amount,
+		Reason:    "order could not be stored",
+	}
+
+	if err := paymentGateway.Refund(ctx, payment, &refund); err != nil {
+		slog.Error("Error voiding payment of an order not stored, refund it manually", "externalReference", payment.ExternalReference, "method", payment.Method, "amount_cents", payment.Amount.Cents, "error", err)
+		return
+	}
+
+	slog.Warn("Payment voided, the order could not be stored", "externalReference", payment.ExternalReference, "refund_status", refund.Status)
+}
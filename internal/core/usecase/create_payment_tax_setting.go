@@ -0,0 +1,37 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+	domainError "github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/error"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/dto"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/mappers"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+)
+
+type CreatePaymentTaxSettingUseCase interface {
+	Run(ctx context.Context, input dto.PaymentTaxSettingInput) (*entities.PaymentTaxSettings, error)
+}
+
+type createPaymentTaxSettingUseCase struct {
+	paymentTaxSettingsRepository ports.PaymentTaxSettingsRepository
+}
+
+func NewCreatePaymentTaxSettingUseCase(paymentTaxSettingsRepository ports.PaymentTaxSettingsRepository) CreatePaymentTaxSettingUseCase {
+	return &createPaymentTaxSettingUseCase{paymentTaxSettingsRepository: paymentTaxSettingsRepository}
+}
+
+func (c *createPaymentTaxSettingUseCase) Run(ctx context.Context, input dto.PaymentTaxSettingInput) (*entities.PaymentTaxSettings, error) {
+	setting := mappers.MapPaymentTaxSettingInputToEntity(0, input)
+	if err := setting.Validate(); err != nil {
+		return nil, domainError.NewEntityNotProcessableError("payment tax setting", err.Error())
+	}
+
+	createdSetting, err := c.paymentTaxSettingsRepository.Create(ctx, setting)
+	if err != nil {
+		return nil, err
+	}
+
+	return &createdSetting, nil
+}
@@ -2,14 +2,17 @@ package usecase
 
 import (
 	"context"
+	"strings"
 
 	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+	domainError "github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/error"
 	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/dto"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/mappers"
 	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
 )
 
 type CreateProductUseCase interface {
-	Run(ctx context.Context, input dto.ProductInputCreate) (*entities.Product, error)
+	Run(ctx context.Context, input dto.ProductInputCreate, changedBy string) (*entities.Product, error)
 }
 
 type createProductsUseCase struct {
@@ -20,7 +23,7 @@ func NewCreateProductUseCase(productRepository ports.ProductRepository) CreatePr
 	return &createProductsUseCase{productRepository: productRepository}
 }
 
-func (c *createProductsUseCase) Run(ctx context.Context, input dto.ProductInputCreate) (*entities.Product, error) {
+func (c *createProductsUseCase) Run(ctx context.Context, input dto.ProductInputCreate, changedBy string) (*entities.Product, error) {
 	images := make([]entities.ProductImage, 0, len(input.Images))
 	for _, image := range input.Images {
 		images = append(images, entities.ProductImage{
@@ -29,6 +32,7 @@ func (c *createProductsUseCase) Run(ctx context.Context, input dto.ProductInputC
 	}
 
 	product := entities.Product{
+		SKU:         strings.TrimSpace(input.SKU),
 		Name:        input.Name,
 		Description: input.Description,
 		Price:       input.Price,
@@ -36,9 +40,24 @@ func (c *createProductsUseCase) Run(ctx context.Context, input dto.ProductInputC
 			Handle: input.Category,
 		},
 		Images: images,
+		Stock:  entities.ProductStock{LowStockThreshold: entities.DefaultLowStockThreshold},
+	}
+	if input.Stock != nil {
+		product.Stock = mappers.MapProductStockInputToEntity(*input.Stock)
+	}
+
+	product.Allergens = mappers.MapAllergens(input.Allergens)
+	product.DietaryTags = mappers.MapDietaryTags(input.DietaryTags)
+	if input.Nutrition != nil {
+		nutrition := mappers.MapNutritionInputToEntity(*input.Nutrition)
+		product.Nutrition = &nutrition
+	}
+
+	if err := product.ValidateDietaryInformation(); err != nil {
+		return nil, domainError.NewEntityNotProcessableError("product", err.Error())
 	}
 
-	createdProduct, err := c.productRepository.Create(ctx, product)
+	createdProduct, err := c.productRepository.Create(ctx, product, changedBy)
 	if err != nil {
 		return nil, err
 	}
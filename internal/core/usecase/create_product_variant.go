@@ -0,0 +1,69 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+	domainError "github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/error"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/dto"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/mappers"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+)
+
+type CreateProductVariantUseCase interface {
+	Run(ctx context.Context, productID int, input dto.ProductVariantInput) (*entities.ProductVariant, error)
+}
+
+type createProductVariantUseCase struct {
+	productRepository        ports.ProductRepository
+	productVariantRepository ports.ProductVariantRepository
+	productCache             ports.ProductCache
+}
+
+func NewCreateProductVariantUseCase(productRepository ports.ProductRepository, productVariantRepository ports.ProductVariantRepository, productCache ports.ProductCache) CreateProductVariantUseCase {
+	return &createProductVariantUseCase{productRepository: productRepository, productVariantRepository: productVariantRepository, productCache: productCache}
+}
+
+// Run adds a size to the product. From then on the product is ordered by variant.
+func (c *createProductVariantUseCase) Run(ctx context.Context, productID int, input dto.ProductVariantInput) (*entities.ProductVariant, error) {
+	product, err := c.productRepository.GetById(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	variant, err := prepareProductVariant(product, mappers.MapProductVariantInputToEntity(productID, input))
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := c.productVariantRepository.Create(ctx, variant)
+	if err != nil {
+		return nil, err
+	}
+	c.productCache.Invalidate(ctx)
+
+	return &created, nil
+}
+
+// prepareProductVariant fills the defaults that come from the product, the SKU and the currency,
+// and validates the variant.
+func prepareProductVariant(product entities.Product, variant entities.ProductVariant) (entities.ProductVariant, error) {
+	variant.Name = strings.TrimSpace(variant.Name)
+	variant.SKU = strings.TrimSpace(variant.SKU)
+	if variant.SKU == "" {
+		variant.SKU = entities.DefaultVariantSKU(product.SKU, variant.Name)
+	}
+
+	if variant.Price.Currency == "" {
+		variant.Price.Currency = product.Price.Currency
+	} else if !variant.Price.SameCurrency(product.Price) {
+		return entities.ProductVariant{}, domainError.NewEntityNotProcessableError("product variant", "variant price must be in "+product.Price.Currency+", the currency of the product")
+	}
+
+	if err := variant.Validate(); err != nil {
+		return entities.ProductVariant{}, domainError.NewEntityNotProcessableError("product variant", err.Error())
+	}
+
+	return variant, nil
+}
@@ -0,0 +1,84 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+	domainError "github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/error"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/dto"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+)
+
+type CreateRefundUseCase interface {
+	Run(ctx context.Context, orderID int, input dto.RefundInput) (*entities.Refund, error)
+}
+
+type createRefundUseCase struct {
+	orderRepository               ports.OrderRepository
+	refundRepository              ports.RefundRepository
+	gatewayResolver               ports.PaymentGatewayResolver
+	syncOrderLoyaltyPointsUseCase SyncOrderLoyaltyPointsUseCase
+}
+
+func NewCreateRefundUseCase(orderRepository ports.OrderRepository, refundRepository ports.RefundRepository, gatewayResolver ports.PaymentGatewayResolver, syncOrderLoyaltyPointsUseCase SyncOrderLoyaltyPointsUseCase) CreateRefundUseCase {
+	return &createRefundUseCase{orderRepository: orderRepository, refundRepository: refundRepository, gatewayResolver: gatewayResolver, syncOrderLoyaltyPointsUseCase: syncOrderLoyaltyPointsUseCase}
+}
+
+// Run refunds the whole remaining amount of the order payment when input.Amount is nil,
+// otherwise a partial refund of the given amount. The refund is reserved as pending before
+// the gateway is called, so a crash in between never loses track of returned money.
+func (c *createRefundUseCase) Run(ctx context.Context, orderID int, input dto.RefundInput) (*entities.Refund, error) {
+	order, err := c.orderRepository.GetByID(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	amount := order.Payment.RefundableAmount()
+	if input.Amount != nil {
+		amount = *input.Amount
+	}
+
+	paymentGateway, err := c.gatewayResolver.Resolve(order.Payment.Method)
+	if err != nil {
+		return nil, domainError.NewEntityNotProcessableError("refund", err.Error())
+	}
+
+	refund, err := c.refundRepository.Create(ctx, entities.Refund{
+		PaymentID: order.Payment.ID,
+		Amount:    amount,
+		Reason:    input.Reason,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := paymentGateway.Refund(ctx, &order.Payment, &refund); err != nil {
+		slog.Error("Gateway refused refund", "refund_id", refund.ID, "error", err)
+
+		refund.Status = entities.RefundStatusFailed
+		if _, updateErr := c.refundRepository.Update(ctx, refund); updateErr != nil {
+			return nil, updateErr
+		}
+
+		if errors.Is(err, &domainError.ServiceUnavailableError{}) {
+			return nil, err
+		}
+
+		return nil, domainError.NewEntityNotProcessableError("refund", err.Error())
+	}
+
+	updatedRefund, err := c.refundRepository.Update(ctx, refund)
+	if err != nil {
+		return nil, err
+	}
+
+	if updatedRefund.Status == entities.RefundStatusApproved {
+		if err := c.syncOrderLoyaltyPointsUseCase.Run(ctx, orderID); err != nil {
+			slog.Error("Error reversing loyalty points", "order_id", orderID, "error", err)
+		}
+	}
+
+	return &updatedRefund, nil
+}
@@ -0,0 +1,30 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+)
+
+type DeleteCategoryUseCase interface {
+	Run(ctx context.Context, id int) error
+}
+
+type deleteCategoryUseCase struct {
+	categoryRepository ports.CategoryRepository
+	productCache       ports.ProductCache
+}
+
+func NewDeleteCategoryUseCase(categoryRepository ports.CategoryRepository, productCache ports.ProductCache) DeleteCategoryUseCase {
+	return &deleteCategoryUseCase{categoryRepository: categoryRepository, productCache: productCache}
+}
+
+// Run deletes an empty category, categories that still have products are kept.
+func (d *deleteCategoryUseCase) Run(ctx context.Context, id int) error {
+	if err := d.categoryRepository.Delete(ctx, id); err != nil {
+		return err
+	}
+	d.productCache.Invalidate(ctx)
+
+	return nil
+}
@@ -0,0 +1,23 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+)
+
+type DeleteCouponUseCase interface {
+	Run(ctx context.Context, id int) error
+}
+
+type deleteCouponUseCase struct {
+	couponRepository ports.CouponRepository
+}
+
+func NewDeleteCouponUseCase(couponRepository ports.CouponRepository) DeleteCouponUseCase {
+	return &deleteCouponUseCase{couponRepository: couponRepository}
+}
+
+func (d *deleteCouponUseCase) Run(ctx context.Context, id int) error {
+	return d.couponRepository.Delete(ctx, id)
+}
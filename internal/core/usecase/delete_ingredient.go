@@ -0,0 +1,24 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+)
+
+type DeleteIngredientUseCase interface {
+	Run(ctx context.Context, id int) error
+}
+
+type deleteIngredientUseCase struct {
+	ingredientRepository ports.IngredientRepository
+}
+
+func NewDeleteIngredientUseCase(ingredientRepository ports.IngredientRepository) DeleteIngredientUseCase {
+	return &deleteIngredientUseCase{ingredientRepository: ingredientRepository}
+}
+
+// Run deletes an ingredient no recipe uses, its stock entries are kept for the records.
+func (d *deleteIngredientUseCase) Run(ctx context.Context, id int) error {
+	return d.ingredientRepository.Delete(ctx, id)
+}
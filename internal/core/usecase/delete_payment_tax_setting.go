@@ -0,0 +1,23 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+)
+
+type DeletePaymentTaxSettingUseCase interface {
+	Run(ctx context.Context, id int) error
+}
+
+type deletePaymentTaxSettingUseCase struct {
+	paymentTaxSettingsRepository ports.PaymentTaxSettingsRepository
+}
+
+func NewDeletePaymentTaxSettingUseCase(paymentTaxSettingsRepository ports.PaymentTaxSettingsRepository) DeletePaymentTaxSettingUseCase {
+	return &deletePaymentTaxSettingUseCase{paymentTaxSettingsRepository: paymentTaxSettingsRepository}
+}
+
+func (d *deletePaymentTaxSettingUseCase) Run(ctx context.Context, id int) error {
+	return d.paymentTaxSettingsRepository.Delete(ctx, id)
+}
@@ -0,0 +1,58 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+)
+
+type DeleteProductImageUseCase interface {
+	Run(ctx context.Context, productID int, imageID int) error
+}
+
+type deleteProductImageUseCase struct {
+	productRepository ports.ProductRepository
+	fileStorage       ports.FileStorage
+}
+
+func NewDeleteProductImageUseCase(productRepository ports.ProductRepository, fileStorage ports.FileStorage) DeleteProductImageUseCase {
+	return &deleteProductImageUseCase{productRepository: productRepository, fileStorage: fileStorage}
+}
+
+// Run removes the image from the product, then the files of uploaded images. A file left behind
+// by a storage failure is only logged, the image is already gone from the product.
+func (d *deleteProductImageUseCase) Run(ctx context.Context, productID int, imageID int) error {
+	image, err := d.productRepository.GetImage(ctx, productID, imageID)
+	if err != nil {
+		return err
+	}
+
+	if err := d.productRepository.DeleteImage(ctx, productID, imageID); err != nil {
+		return err
+	}
+
+	deleteStoredImageFiles(ctx, d.fileStorage, image)
+
+	return nil
+}
+
+// deleteStoredImageFiles removes the files of an uploaded image and its thumbnails, images added
+// by URL have none. Failures are only logged.
+func deleteStoredImageFiles(ctx context.Context, fileStorage ports.FileStorage, image entities.ProductImage) {
+	if image.StorageKey == "" {
+		return
+	}
+
+	keys := []string{image.StorageKey}
+	for _, thumbnail := range image.Thumbnails {
+		keys = append(keys, thumbnail.StorageKey)
+	}
+
+	for _, key := range keys {
+		if err := fileStorage.Delete(ctx, key); err != nil {
+			slog.Error("Error deleting stored file", "key", key, "error", err)
+		}
+	}
+}
@@ -0,0 +1,31 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+)
+
+type DeleteProductVariantUseCase interface {
+	Run(ctx context.Context, productID int, variantID int) error
+}
+
+type deleteProductVariantUseCase struct {
+	productVariantRepository ports.ProductVariantRepository
+	productCache             ports.ProductCache
+}
+
+func NewDeleteProductVariantUseCase(productVariantRepository ports.ProductVariantRepository, productCache ports.ProductCache) DeleteProductVariantUseCase {
+	return &deleteProductVariantUseCase{productVariantRepository: productVariantRepository, productCache: productCache}
+}
+
+// Run removes the variant from the menu. Once the last one is removed the product is ordered as
+// it is again, at the product price.
+func (d *deleteProductVariantUseCase) Run(ctx context.Context, productID int, variantID int) error {
+	if err := d.productVariantRepository.Delete(ctx, productID, variantID); err != nil {
+		return err
+	}
+	d.productCache.Invalidate(ctx)
+
+	return nil
+}
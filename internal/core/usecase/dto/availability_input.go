@@ -0,0 +1,19 @@
+package dto
+
+// AvailabilityWindowInput is a time range of a weekday, in the store timezone. An end at or before
+// the start runs past midnight, 00:00 to 00:00 covers the whole day.
+type AvailabilityWindowInput struct {
+	// Weekday goes from 0 (Sunday) to 6 (Saturday)
+	Weekday *int   `json:"weekday" validate:"required,min=0,max=6" example:"1"`
+	Start   string `json:"start" validate:"required" example:"06:00"`
+	End     string `json:"end" validate:"required" example:"11:00"`
+}
+
+// AvailabilityInput replaces the windows of a product or category, an empty list removes them.
+type AvailabilityInput struct {
+	Windows []AvailabilityWindowInput `json:"windows" validate:"dive"`
+}
+
+func ValidateAvailabilityInput(input AvailabilityInput) error {
+	return validate.Struct(input)
+}
@@ -0,0 +1,11 @@
+package dto
+
+type AvailabilityWindowOutput struct {
+	Weekday int    `json:"weekday" example:"1"`
+	Start   string `json:"start" example:"06:00"`
+	End     string `json:"end" example:"11:00"`
+}
+
+type AvailabilityOutput struct {
+	Windows []AvailabilityWindowOutput `json:"windows"`
+}
@@ -0,0 +1,20 @@
+package dto
+
+// CatalogProduct is a product of the catalog, imports and exports use the same fields in JSON and
+// CSV. The category is its handle and the price is in cents.
+type CatalogProduct struct {
+	SKU         string          `json:"sku" validate:"required,max=64" example:"XB-001"`
+	Name        string          `json:"name" validate:"required,min=2" example:"X-Burger"`
+	Description string          `json:"description" validate:"required,min=10" example:"Hambúrguer de carne bovina"`
+	Category    string          `json:"category" validate:"required,min=3" example:"lanche"`
+	PriceCents  int64           `json:"price_cents" validate:"gte=0" example:"1990"`
+	Currency    string          `json:"currency" validate:"omitempty,len=3" example:"BRL"`
+	Images      []string        `json:"images" validate:"omitempty,dive,url"`
+	Allergens   []string        `json:"allergens" example:"gluten,milk"`
+	DietaryTags []string        `json:"dietary_tags" example:"vegetarian"`
+	Nutrition   *NutritionInput `json:"nutrition,omitempty" validate:"omitempty"`
+}
+
+func ValidateCatalogProduct(input CatalogProduct) error {
+	return validate.Struct(input)
+}
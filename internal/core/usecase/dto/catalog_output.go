@@ -0,0 +1,18 @@
+package dto
+
+import "github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/validator"
+
+// CatalogImportOutput reports an import. Catalogs with errors are not imported at all
+type CatalogImportOutput struct {
+	DryRun  bool              `json:"dry_run"`
+	Created int               `json:"created" example:"3"`
+	Updated int               `json:"updated" example:"12"`
+	Errors  []CatalogRowError `json:"errors"`
+}
+
+// CatalogRowError lists the problems of a product of the catalog, Row 1 is the first one
+type CatalogRowError struct {
+	Row    int                       `json:"row" example:"4"`
+	SKU    string                    `json:"sku" example:"XB-001"`
+	Errors []validator.ErrorResponse `json:"errors"`
+}
@@ -0,0 +1,24 @@
+package dto
+
+type CategoryInput struct {
+	Name         string `json:"name" validate:"required,min=2,max=100" example:"Pães & Doces"`
+	Description  string `json:"description" validate:"omitempty,max=255"`
+	Image        string `json:"image" validate:"omitempty,url,max=255" example:"https://cdn.example.com/categorias/paes.png"`
+	DisplayOrder int    `json:"display_order" validate:"gte=0" example:"5"`
+	Active       *bool  `json:"active"`
+}
+
+// ReassignCategoryProductsInput moves the products to the target category, all of them when
+// product_ids is empty.
+type ReassignCategoryProductsInput struct {
+	TargetCategoryID int   `json:"target_category_id" validate:"required,gt=0"`
+	ProductIDs       []int `json:"product_ids" validate:"omitempty,dive,gt=0"`
+}
+
+func ValidateCategoryInput(input CategoryInput) error {
+	return validate.Struct(input)
+}
+
+func ValidateReassignCategoryProductsInput(input ReassignCategoryProductsInput) error {
+	return validate.Struct(input)
+}
@@ -0,0 +1,20 @@
+package dto
+
+import "time"
+
+type CategoryOutput struct {
+	ID           int       `json:"id"`
+	Name         string    `json:"name"`
+	Handle       string    `json:"handle"`
+	Description  string    `json:"description"`
+	Image        string    `json:"image"`
+	DisplayOrder int       `json:"display_order"`
+	Active       bool      `json:"active"`
+	ProductCount int       `json:"product_count"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+type ReassignCategoryProductsOutput struct {
+	Reassigned int `json:"reassigned"`
+}
@@ -0,0 +1,26 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+)
+
+type CouponInput struct {
+	Code                    string         `json:"code" validate:"required,min=3,max=50,excludesall=0x20"`
+	Description             string         `json:"description" validate:"omitempty,max=255"`
+	AmountType              string         `json:"amount_type" validate:"required,oneof=fixed percentage"`
+	AmountValue             float64        `json:"amount_value" validate:"gt=0"`
+	MinOrderAmount          entities.Money `json:"min_order_amount" validate:"gte=0"`
+	StartsAt                *time.Time     `json:"starts_at"`
+	EndsAt                  *time.Time     `json:"ends_at"`
+	MaxRedemptions          *int           `json:"max_redemptions" validate:"omitempty,gt=0"`
+	MaxRedemptionsPerClient *int           `json:"max_redemptions_per_client" validate:"omitempty,gt=0"`
+	ProductIDs              []int          `json:"product_ids" validate:"omitempty,dive,gt=0"`
+	CategoryIDs             []int          `json:"category_ids" validate:"omitempty,dive,gt=0"`
+	Active                  *bool          `json:"active"`
+}
+
+func ValidateCouponInput(input CouponInput) error {
+	return validate.Struct(input)
+}
@@ -0,0 +1,22 @@
+package dto
+
+import "time"
+
+type CouponOutput struct {
+	ID                      int        `json:"id"`
+	Code                    string     `json:"code"`
+	Description             string     `json:"description"`
+	AmountType              string     `json:"amount_type"`
+	AmountValue             float64    `json:"amount_value"`
+	MinOrderAmount          Money      `json:"min_order_amount"`
+	StartsAt                *time.Time `json:"starts_at"`
+	EndsAt                  *time.Time `json:"ends_at"`
+	MaxRedemptions          *int       `json:"max_redemptions"`
+	MaxRedemptionsPerClient *int       `json:"max_redemptions_per_client"`
+	RedemptionsCount        int        `json:"redemptions_count"`
+	ProductIDs              []int      `json:"product_ids"`
+	CategoryIDs             []int      `json:"category_ids"`
+	Active                  bool       `json:"active"`
+	CreatedAt               time.Time  `json:"created_at"`
+	UpdatedAt               time.Time  `json:"updated_at"`
+}
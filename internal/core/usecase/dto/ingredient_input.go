@@ -0,0 +1,44 @@
+package dto
+
+type IngredientInput struct {
+	Name string `json:"name" validate:"required,min=2,max=100" example:"Pão de hambúrguer"`
+	Unit string `json:"unit" validate:"required,oneof=g kg ml l unit" example:"unit"`
+}
+
+// IngredientReceiptInput is a stock-in entry, the quantity received in the unit of the ingredient.
+type IngredientReceiptInput struct {
+	Quantity    float64 `json:"quantity" validate:"required,gt=0" example:"120"`
+	Description string  `json:"description" validate:"omitempty,max=255" example:"NF 4521 - Padaria Central"`
+}
+
+// IngredientCountInput is the quantity found in a physical count, in the unit of the ingredient.
+type IngredientCountInput struct {
+	CountedQuantity *float64 `json:"counted_quantity" validate:"required,gte=0" example:"87.5"`
+	Description     string   `json:"description" validate:"omitempty,max=255" example:"Contagem semanal"`
+}
+
+type RecipeItemInput struct {
+	IngredientID int     `json:"ingredient_id" validate:"required,gt=0" example:"1"`
+	Quantity     float64 `json:"quantity" validate:"required,gt=0" example:"1"`
+}
+
+// RecipeInput replaces the recipe of a product, an empty list removes it.
+type RecipeInput struct {
+	Items []RecipeItemInput `json:"items" validate:"dive"`
+}
+
+func ValidateIngredientInput(input IngredientInput) error {
+	return validate.Struct(input)
+}
+
+func ValidateIngredientReceiptInput(input IngredientReceiptInput) error {
+	return validate.Struct(input)
+}
+
+func ValidateIngredientCountInput(input IngredientCountInput) error {
+	return validate.Struct(input)
+}
+
+func ValidateRecipeInput(input RecipeInput) error {
+	return validate.Struct(input)
+}
@@ -0,0 +1,58 @@
+package dto
+
+import "time"
+
+type IngredientOutput struct {
+	ID            int       `json:"id"`
+	Name          string    `json:"name"`
+	Unit          string    `json:"unit"`
+	StockQuantity float64   `json:"stock_quantity"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+type IngredientMovementOutput struct {
+	ID           int       `json:"id"`
+	IngredientID int       `json:"ingredient_id"`
+	OrderID      *int      `json:"order_id,omitempty"`
+	Type         string    `json:"type" example:"receipt"`
+	Quantity     float64   `json:"quantity"`
+	Description  string    `json:"description"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+type IngredientCountOutput struct {
+	ID                  int       `json:"id"`
+	IngredientID        int       `json:"ingredient_id"`
+	CountedQuantity     float64   `json:"counted_quantity"`
+	TheoreticalQuantity float64   `json:"theoretical_quantity"`
+	Variance            float64   `json:"variance"`
+	Description         string    `json:"description"`
+	CountedAt           time.Time `json:"counted_at"`
+}
+
+// InventoryReportOutput compares the theoretical stock of each ingredient with its last count.
+// Variance is negative when less was counted than expected.
+type InventoryReportOutput struct {
+	Ingredients []InventoryReportLineOutput `json:"ingredients"`
+}
+
+type InventoryReportLineOutput struct {
+	IngredientID        int                    `json:"ingredient_id"`
+	Name                string                 `json:"name"`
+	Unit                string                 `json:"unit"`
+	TheoreticalQuantity float64                `json:"theoretical_quantity"`
+	LastCount           *IngredientCountOutput `json:"last_count,omitempty"`
+}
+
+type RecipeItemOutput struct {
+	IngredientID   int     `json:"ingredient_id"`
+	IngredientName string  `json:"ingredient_name"`
+	Unit           string  `json:"unit"`
+	Quantity       float64 `json:"quantity"`
+}
+
+type RecipeOutput struct {
+	ProductID int                `json:"product_id"`
+	Items     []RecipeItemOutput `json:"items"`
+}
@@ -0,0 +1,21 @@
+package dto
+
+import "time"
+
+type ClientLoyaltyOutput struct {
+	ClientID     int                  `json:"client_id"`
+	CPF          string               `json:"cpf"`
+	Balance      int                  `json:"balance"`
+	BalanceValue Money                `json:"balance_value"`
+	PointValue   Money                `json:"point_value"`
+	Entries      []LoyaltyEntryOutput `json:"entries"`
+}
+
+type LoyaltyEntryOutput struct {
+	ID          int       `json:"id"`
+	OrderID     *int      `json:"order_id,omitempty"`
+	Type        string    `json:"type" example:"earn"`
+	Points      int       `json:"points"`
+	Description string    `json:"description"`
+	CreatedAt   time.Time `json:"created_at"`
+}
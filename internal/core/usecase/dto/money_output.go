@@ -0,0 +1,35 @@
+package dto
+
+import (
+	"sync/atomic"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+)
+
+type MoneyJSONFormat string
+
+const (
+	// MoneyJSONFormatLegacy writes money as a decimal number (19.9), like the API did before cents.
+	MoneyJSONFormatLegacy MoneyJSONFormat = "legacy"
+	// MoneyJSONFormatObject writes money as {"cents": 1990, "currency": "BRL"}.
+	MoneyJSONFormatObject MoneyJSONFormat = "object"
+)
+
+var moneyAsObject atomic.Bool
+
+// SetMoneyJSONFormat selects how Money is written to responses, legacy unless clients opted in to
+// the object format. Requests accept both.
+func SetMoneyJSONFormat(format MoneyJSONFormat) {
+	moneyAsObject.Store(format == MoneyJSONFormatObject)
+}
+
+// Money is an amount in a response, written in the format selected by SetMoneyJSONFormat.
+type Money entities.Money
+
+func (m Money) MarshalJSON() ([]byte, error) {
+	if moneyAsObject.Load() {
+		return entities.Money(m).MarshalJSON()
+	}
+
+	return []byte(entities.Money(m).String()), nil
+}
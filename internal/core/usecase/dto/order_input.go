@@ -1,16 +1,32 @@
 package dto
 
 type CreateOrderRequest struct {
-	ClientID int                      `json:"client_id"`
-	Items    []CreateOrderItemRequest `json:"items"`
-	Payment  CreatePaymentRequest     `json:"payment"`
+	ClientID      int                      `json:"client_id"`
+	Delivery      bool                     `json:"delivery"`
+	CouponCode    string                   `json:"coupon_code" example:"PROMO10"`
+	LoyaltyPoints int                      `json:"loyalty_points" binding:"omitempty,min=0"`
+	Items         []CreateOrderItemRequest `json:"items"`
+	Payment       CreatePaymentRequest     `json:"payment"`
 }
 
 type CreateOrderItemRequest struct {
 	ProductID int `json:"product_id" binding:"required"`
+	// VariantID is required for products with variants, like the size of a drink
+	VariantID int `json:"variant_id" binding:"omitempty,min=1" example:"3"`
 	Quantity  int `json:"quantity" binding:"required,min=1"`
 }
 
 type CreatePaymentRequest struct {
-	Method string `json:"method" binding:"required"`
+	Method string             `json:"method" binding:"required"`
+	Card   *CreateCardRequest `json:"card,omitempty"`
+}
+
+// CreateCardRequest is required for credit_card payments. The card is exchanged for a token at
+// checkout, only the brand and the last four digits are kept.
+type CreateCardRequest struct {
+	Number     string `json:"number" binding:"required" example:"4111111111111111"`
+	HolderName string `json:"holder_name" binding:"required" example:"MARIA SILVA"`
+	ExpMonth   int    `json:"exp_month" binding:"required,min=1,max=12" example:"12"`
+	ExpYear    int    `json:"exp_year" binding:"required" example:"2030"`
+	CVV        string `json:"cvv" binding:"required" example:"123"`
 }
@@ -3,34 +3,79 @@ package dto
 import "time"
 
 type OrderResponse struct {
-	ID        int                 `json:"id"`
-	ClientID  int                 `json:"client_id"`
-	Status    string              `json:"status"`
-	Items     []OrderItemResponse `json:"items"`
-	Payment   PaymentResponse     `json:"payment"`
-	CreatedAt time.Time           `json:"created_at"`
-	UpdatedAt time.Time           `json:"updated_at"`
+	ID        int                     `json:"id"`
+	ClientID  int                     `json:"client_id"`
+	Status    string                  `json:"status"`
+	Delivery  bool                    `json:"delivery"`
+	Items     []OrderItemResponse     `json:"items"`
+	Subtotal  Money                   `json:"subtotal"`
+	Discounts []OrderDiscountResponse `json:"discounts"`
+	Fees      []OrderFeeResponse      `json:"fees"`
+	Total     Money                   `json:"total"`
+	// NetTotal is the total minus what was refunded
+	NetTotal        Money           `json:"net_total"`
+	Payment         PaymentResponse `json:"payment"`
+	PaymentFailedAt *time.Time      `json:"payment_failed_at,omitempty"`
+	// AllergenWarnings lists the allergens in the order and the products containing them, only at checkout
+	AllergenWarnings []AllergenWarningResponse `json:"allergen_warnings,omitempty"`
+	CreatedAt        time.Time                 `json:"created_at"`
+	UpdatedAt        time.Time                 `json:"updated_at"`
+}
+
+type AllergenWarningResponse struct {
+	Allergen     string   `json:"allergen" example:"milk"`
+	ProductNames []string `json:"product_names" example:"X-Burger,Milkshake"`
+}
+
+type OrderFeeResponse struct {
+	ID                  int     `json:"id"`
+	PaymentTaxSettingID int     `json:"payment_tax_setting_id,omitempty"`
+	Name                string  `json:"name"`
+	AmountType          string  `json:"amount_type"`
+	AmountValue         float64 `json:"amount_value"`
+	ApplicableTo        string  `json:"applicable_to"`
+	Amount              Money   `json:"amount"`
+}
+
+type OrderDiscountResponse struct {
+	ID            int     `json:"id"`
+	CouponID      int     `json:"coupon_id,omitempty"`
+	Code          string  `json:"code"`
+	AmountType    string  `json:"amount_type"`
+	AmountValue   float64 `json:"amount_value"`
+	Amount        Money   `json:"amount"`
+	LoyaltyPoints int     `json:"loyalty_points,omitempty"`
 }
 
 type OrderItemResponse struct {
-	ID        int       `json:"id"`
-	OrderID   int       `json:"order_id"`
-	ProductID int       `json:"product_id"`
-	Quantity  int       `json:"quantity"`
-	Price     float64   `json:"price"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID        int `json:"id"`
+	OrderID   int `json:"order_id"`
+	ProductID int `json:"product_id"`
+	// VariantID and VariantName are the size ordered, omitted for products without variants
+	VariantID   int       `json:"variant_id,omitempty" example:"3"`
+	VariantName string    `json:"variant_name,omitempty" example:"500ml"`
+	Quantity    int       `json:"quantity"`
+	Price       Money     `json:"price"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
 }
 
 type PaymentResponse struct {
-	ID        int       `json:"id"`
-	OrderID   int       `json:"order_id"`
-	Status    string    `json:"status"`
-	Method    string    `json:"method"`
-	QRData    string    `json:"qr_data,omitempty"`
-	Amount    float64   `json:"amount"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID             int           `json:"id"`
+	OrderID        int           `json:"order_id"`
+	Status         string        `json:"status"`
+	Method         string        `json:"method"`
+	QRData         string        `json:"qr_data,omitempty"`
+	Card           *CardResponse `json:"card,omitempty"`
+	Amount         Money         `json:"amount"`
+	RefundedAmount Money         `json:"refunded_amount"`
+	CreatedAt      time.Time     `json:"created_at"`
+	UpdatedAt      time.Time     `json:"updated_at"`
+}
+
+type CardResponse struct {
+	Brand string `json:"brand" example:"visa"`
+	Last4 string `json:"last4" example:"1111"`
 }
 
 type OrderDTO struct {
@@ -39,7 +84,10 @@ type OrderDTO struct {
 	Client   ClientDTO      `json:"client"`
 	Status   string         `json:"status"`
 	Items    []OrderItemDTO `json:"items"`
-	Payment  PaymentDTO     `json:"payment"`
+	Total    Money          `json:"total"`
+	// NetTotal is the total minus what was refunded
+	NetTotal Money      `json:"net_total"`
+	Payment  PaymentDTO `json:"payment"`
 }
 
 type ClientDTO struct {
@@ -51,19 +99,22 @@ type ClientDTO struct {
 }
 
 type OrderItemDTO struct {
-	ID        int        `json:"id"`
-	OrderID   int        `json:"order_id"`
-	ProductID int        `json:"product_id"`
-	Product   ProductDTO `json:"product"`
-	Quantity  int        `json:"quantity"`
-	Price     float64    `json:"price"`
+	ID        int `json:"id"`
+	OrderID   int `json:"order_id"`
+	ProductID int `json:"product_id"`
+	// VariantID and VariantName are the size ordered, omitted for products without variants
+	VariantID   int        `json:"variant_id,omitempty" example:"3"`
+	VariantName string     `json:"variant_name,omitempty" example:"500ml"`
+	Product     ProductDTO `json:"product"`
+	Quantity    int        `json:"quantity"`
+	Price       Money      `json:"price"`
 }
 
 type ProductDTO struct {
 	ID             int         `json:"id"`
 	Name           string      `json:"name"`
 	Description    string      `json:"description"`
-	Price          float64     `json:"price"`
+	Price          Money       `json:"price"`
 	CategoryHandle string      `json:"category_handle"`
 	Images         interface{} `json:"images,omitempty"`
 	CreatedAt      time.Time   `json:"-"`
@@ -71,11 +122,12 @@ type ProductDTO struct {
 }
 
 type PaymentDTO struct {
-	ID      int     `json:"id"`
-	OrderID int     `json:"order_id"`
-	Status  string  `json:"status"`
-	Method  string  `json:"method"`
-	Amount  float64 `json:"amount"`
+	ID             int    `json:"id"`
+	OrderID        int    `json:"order_id"`
+	Status         string `json:"status"`
+	Method         string `json:"method"`
+	Amount         Money  `json:"amount"`
+	RefundedAmount Money  `json:"refunded_amount"`
 }
 
 type PaginatedOrdersDTO struct {
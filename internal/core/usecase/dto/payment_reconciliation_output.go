@@ -0,0 +1,24 @@
+package dto
+
+import "time"
+
+type PaymentReconciliationSummary struct {
+	StartedAt     time.Time            `json:"started_at"`
+	FinishedAt    time.Time            `json:"finished_at"`
+	Checked       int                  `json:"checked"`
+	Approved      int                  `json:"approved"`
+	Failed        int                  `json:"failed"`
+	StillPending  int                  `json:"still_pending"`
+	Errors        int                  `json:"errors"`
+	Discrepancies []PaymentDiscrepancy `json:"discrepancies"`
+}
+
+type PaymentDiscrepancy struct {
+	PaymentID         int    `json:"payment_id"`
+	OrderID           int    `json:"order_id"`
+	ExternalReference string `json:"external_reference"`
+	Method            string `json:"method"`
+	LocalStatus       string `json:"local_status"`
+	GatewayStatus     string `json:"gateway_status"`
+	Error             string `json:"error,omitempty"`
+}
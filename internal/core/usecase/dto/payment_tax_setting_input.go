@@ -0,0 +1,14 @@
+package dto
+
+type PaymentTaxSettingInput struct {
+	Name         string  `json:"name" validate:"required,min=2,max=100"`
+	Description  string  `json:"description" validate:"omitempty,max=255"`
+	AmountType   string  `json:"amount_type" validate:"required,oneof=fixed percentage"`
+	AmountValue  float64 `json:"amount_value" validate:"gte=0"`
+	ApplicableTo string  `json:"applicable_to" validate:"required,oneof=credit_card qr_code platform_fee delivery"`
+	Active       *bool   `json:"active"`
+}
+
+func ValidatePaymentTaxSettingInput(input PaymentTaxSettingInput) error {
+	return validate.Struct(input)
+}
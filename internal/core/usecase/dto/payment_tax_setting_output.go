@@ -0,0 +1,15 @@
+package dto
+
+import "time"
+
+type PaymentTaxSettingOutput struct {
+	ID           int       `json:"id"`
+	Name         string    `json:"name"`
+	Description  string    `json:"description"`
+	AmountType   string    `json:"amount_type"`
+	AmountValue  float64   `json:"amount_value"`
+	ApplicableTo string    `json:"applicable_to"`
+	Active       bool      `json:"active"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
@@ -0,0 +1,13 @@
+package dto
+
+// ProductCacheStatsOutput counts the product cache reads of this instance since it started
+type ProductCacheStatsOutput struct {
+	Enabled bool   `json:"enabled"`
+	Version int64  `json:"version" example:"42"`
+	Hits    uint64 `json:"hits" example:"1250"`
+	Misses  uint64 `json:"misses" example:"80"`
+	// HitRatio is hits over all reads, 0 before the first one
+	HitRatio      float64 `json:"hit_ratio" example:"0.94"`
+	Errors        uint64  `json:"errors" example:"0"`
+	Invalidations uint64  `json:"invalidations" example:"12"`
+}
@@ -1,28 +1,71 @@
 package dto
 
-import "github.com/go-playground/validator/v10"
+import (
+	"reflect"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+)
 
 type ProductInputUpdate struct {
-	ID          int      `json:"id" validate:"required"`
-	Name        string   `json:"name" validate:"omitempty,min=2"`
-	Price       float64  `json:"price" validate:"omitempty,gte=0"`
-	Description string   `json:"description" validate:"omitempty,min=10"`
-	Category    string   `json:"category" validate:"omitempty,min=3"`
-	Images      []string `json:"images" validate:"omitempty,dive,url"`
+	ID          int            `json:"id" validate:"required"`
+	SKU         string         `json:"sku" validate:"omitempty,max=64" example:"XB-001"`
+	Name        string         `json:"name" validate:"omitempty,min=2"`
+	Price       entities.Money `json:"price" validate:"omitempty,gte=0"`
+	Description string         `json:"description" validate:"omitempty,min=10"`
+	Category    string         `json:"category" validate:"omitempty,min=3"`
+	Images      []string       `json:"images" validate:"omitempty,dive,url"`
+	// Nutrition, Allergens and DietaryTags are kept when omitted, an empty list clears them
+	Nutrition   *NutritionInput `json:"nutrition" validate:"omitempty"`
+	Allergens   []string        `json:"allergens" example:"gluten,milk"`
+	DietaryTags []string        `json:"dietary_tags" example:"vegetarian"`
 }
 
 type ProductInputCreate struct {
-	Name        string   `json:"name" validate:"required,min=2"`
-	Price       float64  `json:"price" validate:"required,gte=0"`
-	Description string   `json:"description" validate:"required,min=10"`
-	Category    string   `json:"category" validate:"required,min=3"`
-	Images      []string `json:"images" validate:"required,dive,url"`
+	// SKU is optional, products without it get one like PRD-000042
+	SKU         string         `json:"sku" validate:"omitempty,max=64" example:"XB-001"`
+	Name        string         `json:"name" validate:"required,min=2"`
+	Price       entities.Money `json:"price" validate:"required,gte=0"`
+	Description string         `json:"description" validate:"required,min=10"`
+	Category    string         `json:"category" validate:"required,min=3"`
+	Images      []string       `json:"images" validate:"required,dive,url"`
+	// Stock is optional, products without it are not tracked
+	Stock *ProductStockInput `json:"stock" validate:"omitempty"`
+	// Nutrition is optional, allergens and dietary tags use the vocabulary listed in the docs
+	Nutrition   *NutritionInput `json:"nutrition" validate:"omitempty"`
+	Allergens   []string        `json:"allergens" example:"gluten,milk"`
+	DietaryTags []string        `json:"dietary_tags" example:"vegetarian"`
+}
+
+// NutritionInput has the nutrients of one serving, in grams unless named otherwise
+type NutritionInput struct {
+	ServingSizeG   float64 `json:"serving_size_g" validate:"gt=0" example:"180"`
+	EnergyKcal     float64 `json:"energy_kcal" validate:"gte=0" example:"520"`
+	CarbohydratesG float64 `json:"carbohydrates_g" validate:"gte=0" example:"42"`
+	SugarsG        float64 `json:"sugars_g" validate:"gte=0" example:"8.5"`
+	ProteinG       float64 `json:"protein_g" validate:"gte=0" example:"28"`
+	FatG           float64 `json:"fat_g" validate:"gte=0" example:"26"`
+	SaturatedFatG  float64 `json:"saturated_fat_g" validate:"gte=0" example:"11"`
+	FiberG         float64 `json:"fiber_g" validate:"gte=0" example:"3"`
+	SodiumMg       float64 `json:"sodium_mg" validate:"gte=0" example:"890"`
+}
+
+// ProductStockInput sets the stock of a product. The low stock threshold defaults to 5.
+type ProductStockInput struct {
+	TrackStock        bool `json:"track_stock"`
+	Quantity          int  `json:"quantity" validate:"gte=0"`
+	LowStockThreshold *int `json:"low_stock_threshold" validate:"omitempty,gte=0"`
 }
 
 var validate *validator.Validate
 
 func init() {
 	validate = validator.New()
+
+	// Money fields are validated by their amount in cents, so tags like gte=0 keep working
+	validate.RegisterCustomTypeFunc(func(field reflect.Value) interface{} {
+		return field.Interface().(entities.Money).Cents
+	}, entities.Money{})
 }
 
 func ValidateProductUpdate(input ProductInputUpdate) error {
@@ -32,3 +75,7 @@ func ValidateProductUpdate(input ProductInputUpdate) error {
 func ValidateProductCreate(input ProductInputCreate) error {
 	return validate.Struct(input)
 }
+
+func ValidateProductStock(input ProductStockInput) error {
+	return validate.Struct(input)
+}
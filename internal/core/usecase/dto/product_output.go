@@ -1,10 +1,63 @@
 package dto
 
+import "time"
+
 type ProductOutput struct {
 	ID          int      `json:"id"`
+	SKU         string   `json:"sku" example:"XB-001"`
 	Name        string   `json:"name"`
-	Price       float64  `json:"price"`
+	Price       Money    `json:"price"`
 	Description string   `json:"description"`
 	Category    string   `json:"category"`
 	Images      []string `json:"images"`
+	// ImageDetails carries the ids of the images, to delete them, and the thumbnails of uploaded ones
+	ImageDetails []ProductImageOutput `json:"image_details"`
+	// StockStatus is untracked, in_stock, low_stock or out_of_stock
+	StockStatus string `json:"stock_status" example:"in_stock"`
+	// StockQuantity is only sent for products with tracked stock
+	StockQuantity *int `json:"stock_quantity,omitempty" example:"12"`
+	// Available is false while an ingredient of the recipe is out, the product cannot be ordered
+	Available bool `json:"available"`
+	// Availability lists when the product can be ordered, in the store timezone. Empty means always
+	Availability []AvailabilityWindowOutput `json:"availability"`
+	// Nutrition is omitted when the facts were not informed
+	Nutrition   *NutritionOutput `json:"nutrition,omitempty"`
+	Allergens   []string         `json:"allergens" example:"gluten,milk"`
+	DietaryTags []string         `json:"dietary_tags" example:"vegetarian"`
+	// Variants are the sizes to choose from, order items send the id of the chosen one. Empty for products ordered as they are
+	Variants []ProductVariantOutput `json:"variants"`
+	// DeletedAt is only sent for deleted products, listed by admins
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+}
+
+type ProductVariantOutput struct {
+	ID    int    `json:"id" example:"3"`
+	Name  string `json:"name" example:"500ml"`
+	SKU   string `json:"sku" example:"COCA-500ML"`
+	Price Money  `json:"price"`
+	// StockStatus is untracked, in_stock, low_stock or out_of_stock
+	StockStatus string `json:"stock_status" example:"in_stock"`
+	// StockQuantity is only sent for variants with tracked stock
+	StockQuantity *int `json:"stock_quantity,omitempty" example:"12"`
+	DisplayOrder  int  `json:"display_order" example:"1"`
+}
+
+// NutritionOutput has the nutrients of one serving, in grams unless named otherwise
+type NutritionOutput struct {
+	ServingSizeG   float64 `json:"serving_size_g" example:"180"`
+	EnergyKcal     float64 `json:"energy_kcal" example:"520"`
+	CarbohydratesG float64 `json:"carbohydrates_g" example:"42"`
+	SugarsG        float64 `json:"sugars_g" example:"8.5"`
+	ProteinG       float64 `json:"protein_g" example:"28"`
+	FatG           float64 `json:"fat_g" example:"26"`
+	SaturatedFatG  float64 `json:"saturated_fat_g" example:"11"`
+	FiberG         float64 `json:"fiber_g" example:"3"`
+	SodiumMg       float64 `json:"sodium_mg" example:"890"`
+}
+
+type ProductImageOutput struct {
+	ID  int    `json:"id" example:"7"`
+	URL string `json:"url" example:"http://localhost:8080/api/v1/images/products/1/0b5c.../original.jpg"`
+	// Thumbnails maps each size, small (200px) and medium (600px), to its URL. Empty for external images
+	Thumbnails map[string]string `json:"thumbnails"`
 }
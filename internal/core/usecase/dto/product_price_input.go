@@ -0,0 +1,18 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+)
+
+// ProductPriceInput changes the price of a product. Without effective_at, or with a past one, the
+// price goes live at once, otherwise it is scheduled.
+type ProductPriceInput struct {
+	Price       entities.Money `json:"price" validate:"required,gte=0"`
+	EffectiveAt *time.Time     `json:"effective_at" example:"2025-01-01T00:00:00-03:00"`
+}
+
+func ValidateProductPriceInput(input ProductPriceInput) error {
+	return validate.Struct(input)
+}
@@ -0,0 +1,16 @@
+package dto
+
+import "time"
+
+type ProductPriceOutput struct {
+	ID          int       `json:"id"`
+	ProductID   int       `json:"product_id"`
+	Price       Money     `json:"price"`
+	EffectiveAt time.Time `json:"effective_at"`
+	// Status is scheduled, applied or canceled
+	Status     string     `json:"status" example:"scheduled"`
+	AppliedAt  *time.Time `json:"applied_at,omitempty"`
+	CanceledAt *time.Time `json:"canceled_at,omitempty"`
+	ChangedBy  string     `json:"changed_by,omitempty" example:"maria"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
@@ -0,0 +1,18 @@
+package dto
+
+import "github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+
+// ProductVariantInput creates or replaces a size of a product. The price is in the currency of the
+// product, the SKU defaults to the product SKU followed by the name.
+type ProductVariantInput struct {
+	Name         string         `json:"name" validate:"required,max=50" example:"500ml"`
+	SKU          string         `json:"sku" validate:"max=64" example:"COCA-500ML"`
+	Price        entities.Money `json:"price" validate:"required,gte=0"`
+	DisplayOrder int            `json:"display_order" example:"1"`
+	// Stock is optional, variants without it are not tracked
+	Stock *ProductStockInput `json:"stock" validate:"omitempty"`
+}
+
+func ValidateProductVariantInput(input ProductVariantInput) error {
+	return validate.Struct(input)
+}
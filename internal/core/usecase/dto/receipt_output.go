@@ -0,0 +1,48 @@
+package dto
+
+import "time"
+
+type ReceiptOutput struct {
+	Number    int                       `json:"number"`
+	IssuedAt  time.Time                 `json:"issued_at"`
+	Issuer    ReceiptIssuerOutput       `json:"issuer"`
+	Customer  ReceiptCustomerOutput     `json:"customer"`
+	Items     []ReceiptItemOutput       `json:"items"`
+	Subtotal  Money                     `json:"subtotal"`
+	Discounts []ReceiptAdjustmentOutput `json:"discounts"`
+	Fees      []ReceiptAdjustmentOutput `json:"fees"`
+	Total     Money                     `json:"total"`
+	Payment   ReceiptPaymentOutput      `json:"payment"`
+}
+
+type ReceiptIssuerOutput struct {
+	Name    string `json:"name"`
+	CNPJ    string `json:"cnpj,omitempty"`
+	Address string `json:"address,omitempty"`
+}
+
+type ReceiptCustomerOutput struct {
+	Name string `json:"name"`
+	CPF  string `json:"cpf"`
+}
+
+type ReceiptItemOutput struct {
+	ProductID int    `json:"product_id"`
+	Name      string `json:"name"`
+	Quantity  int    `json:"quantity"`
+	UnitPrice Money  `json:"unit_price"`
+	Total     Money  `json:"total"`
+}
+
+type ReceiptAdjustmentOutput struct {
+	Description string `json:"description"`
+	Amount      Money  `json:"amount"`
+}
+
+type ReceiptPaymentOutput struct {
+	Method         string `json:"method"`
+	Status         string `json:"status"`
+	Amount         Money  `json:"amount"`
+	RefundedAmount Money  `json:"refunded_amount"`
+	NetAmount      Money  `json:"net_amount"`
+}
@@ -0,0 +1,17 @@
+package dto
+
+import "github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+
+type RefundInput struct {
+	Amount *entities.Money `json:"amount" validate:"omitempty,gt=0"`
+	Reason string          `json:"reason" validate:"omitempty,max=255"`
+}
+
+type RefundWebhookInputDTO struct {
+	ExternalReference string                `json:"external_reference" validate:"required"`
+	Status            entities.RefundStatus `json:"status" validate:"required,oneof=approved failed"`
+}
+
+func ValidateRefundInput(input RefundInput) error {
+	return validate.Struct(input)
+}
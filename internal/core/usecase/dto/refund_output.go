@@ -0,0 +1,15 @@
+package dto
+
+import "time"
+
+type RefundOutput struct {
+	ID                int       `json:"id"`
+	PaymentID         int       `json:"payment_id"`
+	OrderID           int       `json:"order_id"`
+	Amount            Money     `json:"amount"`
+	Reason            string    `json:"reason,omitempty"`
+	Status            string    `json:"status"`
+	ExternalReference string    `json:"external_reference,omitempty"`
+	CreatedAt         time.Time `json:"created_at"`
+	UpdatedAt         time.Time `json:"updated_at"`
+}
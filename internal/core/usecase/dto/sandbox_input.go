@@ -0,0 +1,14 @@
+package dto
+
+// SandboxNotificationInput describes the webhook the sandbox delivers for a payment. Duplicates
+// are extra copies sent at the same time as the first one, to reproduce concurrent deliveries.
+type SandboxNotificationInput struct {
+	Status        string `json:"status" validate:"required,oneof=approved failed" example:"approved"`
+	PaymentMethod string `json:"payment_method" validate:"required" example:"qr_code"`
+	DelaySeconds  int    `json:"delay_seconds" validate:"gte=0,lte=300" example:"0"`
+	Duplicates    int    `json:"duplicates" validate:"gte=0,lte=10" example:"0"`
+}
+
+func ValidateSandboxNotificationInput(input SandboxNotificationInput) error {
+	return validate.Struct(input)
+}
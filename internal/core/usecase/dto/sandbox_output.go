@@ -0,0 +1,13 @@
+package dto
+
+import "time"
+
+type SandboxPaymentOutput struct {
+	ID                int       `json:"id"`
+	OrderID           int       `json:"order_id"`
+	ExternalReference string    `json:"external_reference"`
+	Method            string    `json:"method"`
+	Status            string    `json:"status"`
+	Amount            Money     `json:"amount"`
+	CreatedAt         time.Time `json:"created_at"`
+}
@@ -0,0 +1,49 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/config"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+)
+
+type ExpireFailedPaymentsUseCase interface {
+	Run(ctx context.Context) (int, error)
+}
+
+type expireFailedPaymentsUseCase struct {
+	orderRepository               ports.OrderRepository
+	syncOrderLoyaltyPointsUseCase SyncOrderLoyaltyPointsUseCase
+	window                        time.Duration
+	batchSize                     int
+}
+
+func NewExpireFailedPaymentsUseCase(cfg *config.Config, orderRepository ports.OrderRepository, syncOrderLoyaltyPointsUseCase SyncOrderLoyaltyPointsUseCase) ExpireFailedPaymentsUseCase {
+	return &expireFailedPaymentsUseCase{
+		orderRepository:               orderRepository,
+		syncOrderLoyaltyPointsUseCase: syncOrderLoyaltyPointsUseCase,
+		window:                        cfg.PaymentRetry.Window,
+		batchSize:                     cfg.PaymentRetry.BatchSize,
+	}
+}
+
+// Run cancels the orders whose payment failed and were not paid again within the retry window,
+// returning how many were canceled.
+func (e *expireFailedPaymentsUseCase) Run(ctx context.Context) (int, error) {
+	orderIDs, err := e.orderRepository.CancelPaymentFailedBefore(ctx, time.Now().Add(-e.window), e.batchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	// Canceled orders give back the points redeemed at checkout
+	for _, orderID := range orderIDs {
+		slog.Info("Order canceled after the payment retry window expired", "order_id", orderID)
+		if err := e.syncOrderLoyaltyPointsUseCase.Run(ctx, orderID); err != nil {
+			slog.Error("Error returning loyalty points", "order_id", orderID, "error", err)
+		}
+	}
+
+	return len(orderIDs), nil
+}
@@ -0,0 +1,42 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/dto"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/mappers"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+)
+
+const exportCatalogPageSize = 100
+
+type ExportCatalogUseCase interface {
+	Run(ctx context.Context) ([]dto.CatalogProduct, error)
+}
+
+type exportCatalogUseCase struct {
+	productRepository ports.ProductRepository
+}
+
+func NewExportCatalogUseCase(productRepository ports.ProductRepository) ExportCatalogUseCase {
+	return &exportCatalogUseCase{productRepository: productRepository}
+}
+
+// Run lists every product in the format imports read, so a menu can be moved to another environment.
+func (e *exportCatalogUseCase) Run(ctx context.Context) ([]dto.CatalogProduct, error) {
+	catalog := make([]dto.CatalogProduct, 0)
+	for page := 1; ; page++ {
+		products, total, err := e.productRepository.GetAll(ctx, &ports.ProductFilter{IncludeInactiveCategories: true, Page: page, PageSize: exportCatalogPageSize})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, product := range products {
+			catalog = append(catalog, mappers.ToCatalogProductDTO(product))
+		}
+
+		if len(products) < exportCatalogPageSize || page*exportCatalogPageSize >= total {
+			return catalog, nil
+		}
+	}
+}
@@ -0,0 +1,39 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/config"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+)
+
+type GetCartRecommendationsUseCase interface {
+	Run(ctx context.Context, productIDs []int, limit int) ([]entities.Product, error)
+}
+
+type getCartRecommendationsUseCase struct {
+	productRepository               ports.ProductRepository
+	productRecommendationRepository ports.ProductRecommendationRepository
+	location                        *time.Location
+}
+
+func NewGetCartRecommendationsUseCase(cfg *config.Config, productRepository ports.ProductRepository, productRecommendationRepository ports.ProductRecommendationRepository) GetCartRecommendationsUseCase {
+	return &getCartRecommendationsUseCase{
+		productRepository:               productRepository,
+		productRecommendationRepository: productRecommendationRepository,
+		location:                        cfg.Store.Location,
+	}
+}
+
+// Run suggests what else to add to a cart with the given products, never one already in it. Unknown
+// products in the cart just add nothing to the ranking.
+func (g *getCartRecommendationsUseCase) Run(ctx context.Context, productIDs []int, limit int) ([]entities.Product, error) {
+	ids, err := g.productRecommendationRepository.GetForProducts(ctx, productIDs, time.Now().In(g.location), limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return recommendedProducts(ctx, g.productRepository, ids)
+}
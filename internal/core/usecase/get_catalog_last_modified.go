@@ -0,0 +1,30 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+)
+
+type GetCatalogLastModifiedUseCase interface {
+	Run(ctx context.Context) (time.Time, error)
+}
+
+type getCatalogLastModifiedUseCase struct {
+	productRepository ports.ProductRepository
+}
+
+func NewGetCatalogLastModifiedUseCase(productRepository ports.ProductRepository) GetCatalogLastModifiedUseCase {
+	return &getCatalogLastModifiedUseCase{productRepository: productRepository}
+}
+
+// Run returns when the menu last changed, truncated to the second precision of Last-Modified.
+func (g *getCatalogLastModifiedUseCase) Run(ctx context.Context) (time.Time, error) {
+	lastModified, err := g.productRepository.LastModified(ctx)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return lastModified.Truncate(time.Second), nil
+}
@@ -0,0 +1,25 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+)
+
+type GetCategoriesUseCase interface {
+	Run(ctx context.Context, onlyActive bool) ([]entities.Category, error)
+}
+
+type getCategoriesUseCase struct {
+	categoryRepository ports.CategoryRepository
+}
+
+func NewGetCategoriesUseCase(categoryRepository ports.CategoryRepository) GetCategoriesUseCase {
+	return &getCategoriesUseCase{categoryRepository: categoryRepository}
+}
+
+// Run lists the categories in display order, the menu only shows the active ones.
+func (g *getCategoriesUseCase) Run(ctx context.Context, onlyActive bool) ([]entities.Category, error) {
+	return g.categoryRepository.GetAll(ctx, onlyActive)
+}
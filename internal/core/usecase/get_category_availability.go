@@ -0,0 +1,24 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+)
+
+type GetCategoryAvailabilityUseCase interface {
+	Run(ctx context.Context, categoryID int) (entities.AvailabilitySchedule, error)
+}
+
+type getCategoryAvailabilityUseCase struct {
+	availabilityRepository ports.AvailabilityRepository
+}
+
+func NewGetCategoryAvailabilityUseCase(availabilityRepository ports.AvailabilityRepository) GetCategoryAvailabilityUseCase {
+	return &getCategoryAvailabilityUseCase{availabilityRepository: availabilityRepository}
+}
+
+func (g *getCategoryAvailabilityUseCase) Run(ctx context.Context, categoryID int) (entities.AvailabilitySchedule, error) {
+	return g.availabilityRepository.GetByCategory(ctx, categoryID)
+}
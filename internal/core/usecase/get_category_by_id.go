@@ -0,0 +1,29 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+)
+
+type GetCategoryByIDUseCase interface {
+	Run(ctx context.Context, id int) (*entities.Category, error)
+}
+
+type getCategoryByIDUseCase struct {
+	categoryRepository ports.CategoryRepository
+}
+
+func NewGetCategoryByIDUseCase(categoryRepository ports.CategoryRepository) GetCategoryByIDUseCase {
+	return &getCategoryByIDUseCase{categoryRepository: categoryRepository}
+}
+
+func (g *getCategoryByIDUseCase) Run(ctx context.Context, id int) (*entities.Category, error) {
+	category, err := g.categoryRepository.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &category, nil
+}
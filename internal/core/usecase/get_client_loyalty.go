@@ -0,0 +1,45 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/config"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/dto"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/mappers"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+)
+
+type GetClientLoyaltyUseCase interface {
+	Run(ctx context.Context, cpf string) (*dto.ClientLoyaltyOutput, error)
+}
+
+type getClientLoyaltyUseCase struct {
+	clientRepository  ports.ClientRepository
+	loyaltyRepository ports.LoyaltyRepository
+	program           entities.LoyaltyProgram
+}
+
+func NewGetClientLoyaltyUseCase(cfg *config.Config, clientRepository ports.ClientRepository, loyaltyRepository ports.LoyaltyRepository) GetClientLoyaltyUseCase {
+	return &getClientLoyaltyUseCase{
+		clientRepository:  clientRepository,
+		loyaltyRepository: loyaltyRepository,
+		program:           loyaltyProgram(cfg),
+	}
+}
+
+func (g *getClientLoyaltyUseCase) Run(ctx context.Context, cpf string) (*dto.ClientLoyaltyOutput, error) {
+	client, err := g.clientRepository.GetByCpf(ctx, cpf)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := g.loyaltyRepository.GetByClientID(ctx, client.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	output := mappers.ToClientLoyaltyDTO(client, entries, g.program)
+
+	return &output, nil
+}
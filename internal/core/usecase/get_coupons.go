@@ -0,0 +1,24 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+)
+
+type GetCouponsUseCase interface {
+	Run(ctx context.Context) ([]entities.Coupon, error)
+}
+
+type getCouponsUseCase struct {
+	couponRepository ports.CouponRepository
+}
+
+func NewGetCouponsUseCase(couponRepository ports.CouponRepository) GetCouponsUseCase {
+	return &getCouponsUseCase{couponRepository: couponRepository}
+}
+
+func (g *getCouponsUseCase) Run(ctx context.Context) ([]entities.Coupon, error) {
+	return g.couponRepository.GetAll(ctx)
+}
@@ -0,0 +1,31 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+)
+
+const ingredientMovementsLimit = 100
+
+type GetIngredientMovementsUseCase interface {
+	Run(ctx context.Context, ingredientID int) ([]entities.IngredientMovement, error)
+}
+
+type getIngredientMovementsUseCase struct {
+	ingredientRepository ports.IngredientRepository
+}
+
+func NewGetIngredientMovementsUseCase(ingredientRepository ports.IngredientRepository) GetIngredientMovementsUseCase {
+	return &getIngredientMovementsUseCase{ingredientRepository: ingredientRepository}
+}
+
+// Run lists the latest receipts, consumptions and count adjustments of the ingredient, newest first.
+func (g *getIngredientMovementsUseCase) Run(ctx context.Context, ingredientID int) ([]entities.IngredientMovement, error) {
+	if _, err := g.ingredientRepository.GetByID(ctx, ingredientID); err != nil {
+		return nil, err
+	}
+
+	return g.ingredientRepository.GetMovements(ctx, ingredientID, ingredientMovementsLimit)
+}
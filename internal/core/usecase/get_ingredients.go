@@ -0,0 +1,25 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+)
+
+type GetIngredientsUseCase interface {
+	Run(ctx context.Context) ([]entities.Ingredient, error)
+}
+
+type getIngredientsUseCase struct {
+	ingredientRepository ports.IngredientRepository
+}
+
+func NewGetIngredientsUseCase(ingredientRepository ports.IngredientRepository) GetIngredientsUseCase {
+	return &getIngredientsUseCase{ingredientRepository: ingredientRepository}
+}
+
+// Run lists the ingredients by name with their theoretical stock.
+func (g *getIngredientsUseCase) Run(ctx context.Context) ([]entities.Ingredient, error) {
+	return g.ingredientRepository.GetAll(ctx)
+}
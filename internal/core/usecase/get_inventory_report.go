@@ -0,0 +1,25 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+)
+
+type GetInventoryReportUseCase interface {
+	Run(ctx context.Context) ([]entities.InventoryReportLine, error)
+}
+
+type getInventoryReportUseCase struct {
+	ingredientRepository ports.IngredientRepository
+}
+
+func NewGetInventoryReportUseCase(ingredientRepository ports.IngredientRepository) GetInventoryReportUseCase {
+	return &getInventoryReportUseCase{ingredientRepository: ingredientRepository}
+}
+
+// Run compares the theoretical stock of every ingredient with its last physical count.
+func (g *getInventoryReportUseCase) Run(ctx context.Context) ([]entities.InventoryReportLine, error) {
+	return g.ingredientRepository.GetInventoryReport(ctx)
+}
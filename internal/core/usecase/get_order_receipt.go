@@ -0,0 +1,68 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/config"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+	domainError "github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/error"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+)
+
+type GetOrderReceiptUseCase interface {
+	Run(ctx context.Context, orderID int) (*entities.Receipt, error)
+}
+
+type getOrderReceiptUseCase struct {
+	orderRepository   ports.OrderRepository
+	clientRepository  ports.ClientRepository
+	productRepository ports.ProductRepository
+	issuer            entities.ReceiptIssuer
+}
+
+func NewGetOrderReceiptUseCase(cfg *config.Config, orderRepository ports.OrderRepository, clientRepository ports.ClientRepository, productRepository ports.ProductRepository) GetOrderReceiptUseCase {
+	return &getOrderReceiptUseCase{
+		orderRepository:   orderRepository,
+		clientRepository:  clientRepository,
+		productRepository: productRepository,
+		issuer: entities.ReceiptIssuer{
+			Name:    cfg.Receipt.IssuerName,
+			CNPJ:    cfg.Receipt.IssuerCNPJ,
+			Address: cfg.Receipt.IssuerAddress,
+		},
+	}
+}
+
+func (g *getOrderReceiptUseCase) Run(ctx context.Context, orderID int) (*entities.Receipt, error) {
+	order, err := g.orderRepository.GetByID(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := g.clientRepository.GetByID(ctx, order.ClientID)
+	if err != nil {
+		return nil, err
+	}
+
+	productIds := make([]int, 0, len(order.Items))
+	for _, item := range order.Items {
+		productIds = append(productIds, item.ProductID)
+	}
+
+	products, _, err := g.productRepository.GetByIds(ctx, productIds)
+	if err != nil {
+		return nil, err
+	}
+
+	mappedProducts := make(map[int]entities.Product)
+	for _, product := range products {
+		mappedProducts[product.ID] = product
+	}
+
+	receipt, err := entities.NewReceipt(order, client, mappedProducts, g.issuer)
+	if err != nil {
+		return nil, domainError.NewEntityNotProcessableError("receipt", err.Error())
+	}
+
+	return &receipt, nil
+}
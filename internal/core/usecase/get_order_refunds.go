@@ -0,0 +1,29 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+)
+
+type GetOrderRefundsUseCase interface {
+	Run(ctx context.Context, orderID int) ([]entities.Refund, error)
+}
+
+type getOrderRefundsUseCase struct {
+	orderRepository  ports.OrderRepository
+	refundRepository ports.RefundRepository
+}
+
+func NewGetOrderRefundsUseCase(orderRepository ports.OrderRepository, refundRepository ports.RefundRepository) GetOrderRefundsUseCase {
+	return &getOrderRefundsUseCase{orderRepository: orderRepository, refundRepository: refundRepository}
+}
+
+func (g *getOrderRefundsUseCase) Run(ctx context.Context, orderID int) ([]entities.Refund, error) {
+	if _, err := g.orderRepository.GetByID(ctx, orderID); err != nil {
+		return nil, err
+	}
+
+	return g.refundRepository.GetByOrderID(ctx, orderID)
+}
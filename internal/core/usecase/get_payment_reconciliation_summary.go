@@ -0,0 +1,38 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/redis/go-redis/v9"
+	domainError "github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/error"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/dto"
+)
+
+type GetPaymentReconciliationSummaryUseCase interface {
+	Run(ctx context.Context) (*dto.PaymentReconciliationSummary, error)
+}
+
+type getPaymentReconciliationSummaryUseCase struct {
+	redisClient *redis.Client
+}
+
+func NewGetPaymentReconciliationSummaryUseCase(redisClient *redis.Client) GetPaymentReconciliationSummaryUseCase {
+	return &getPaymentReconciliationSummaryUseCase{redisClient: redisClient}
+}
+
+func (g *getPaymentReconciliationSummaryUseCase) Run(ctx context.Context) (*dto.PaymentReconciliationSummary, error) {
+	encoded, err := g.redisClient.Get(ctx, reconciliationSummaryKey).Bytes()
+	if err == redis.Nil {
+		return nil, domainError.ErrNotFound("payment reconciliation summary")
+	} else if err != nil {
+		return nil, err
+	}
+
+	var summary dto.PaymentReconciliationSummary
+	if err := json.Unmarshal(encoded, &summary); err != nil {
+		return nil, err
+	}
+
+	return &summary, nil
+}
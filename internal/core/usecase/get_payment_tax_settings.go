@@ -0,0 +1,24 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+)
+
+type GetPaymentTaxSettingsUseCase interface {
+	Run(ctx context.Context) ([]entities.PaymentTaxSettings, error)
+}
+
+type getPaymentTaxSettingsUseCase struct {
+	paymentTaxSettingsRepository ports.PaymentTaxSettingsRepository
+}
+
+func NewGetPaymentTaxSettingsUseCase(paymentTaxSettingsRepository ports.PaymentTaxSettingsRepository) GetPaymentTaxSettingsUseCase {
+	return &getPaymentTaxSettingsUseCase{paymentTaxSettingsRepository: paymentTaxSettingsRepository}
+}
+
+func (g *getPaymentTaxSettingsUseCase) Run(ctx context.Context) ([]entities.PaymentTaxSettings, error) {
+	return g.paymentTaxSettingsRepository.GetAll(ctx)
+}
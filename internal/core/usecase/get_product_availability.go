@@ -0,0 +1,24 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+)
+
+type GetProductAvailabilityUseCase interface {
+	Run(ctx context.Context, productID int) (entities.AvailabilitySchedule, error)
+}
+
+type getProductAvailabilityUseCase struct {
+	availabilityRepository ports.AvailabilityRepository
+}
+
+func NewGetProductAvailabilityUseCase(availabilityRepository ports.AvailabilityRepository) GetProductAvailabilityUseCase {
+	return &getProductAvailabilityUseCase{availabilityRepository: availabilityRepository}
+}
+
+func (g *getProductAvailabilityUseCase) Run(ctx context.Context, productID int) (entities.AvailabilitySchedule, error) {
+	return g.availabilityRepository.GetByProduct(ctx, productID)
+}
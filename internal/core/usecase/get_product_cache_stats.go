@@ -0,0 +1,38 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/dto"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+)
+
+type GetProductCacheStatsUseCase interface {
+	Run(ctx context.Context) *dto.ProductCacheStatsOutput
+}
+
+type getProductCacheStatsUseCase struct {
+	productCache ports.ProductCache
+}
+
+func NewGetProductCacheStatsUseCase(productCache ports.ProductCache) GetProductCacheStatsUseCase {
+	return &getProductCacheStatsUseCase{productCache: productCache}
+}
+
+func (g *getProductCacheStatsUseCase) Run(ctx context.Context) *dto.ProductCacheStatsOutput {
+	stats := g.productCache.Stats(ctx)
+
+	output := &dto.ProductCacheStatsOutput{
+		Enabled:       stats.Enabled,
+		Version:       stats.Version,
+		Hits:          stats.Hits,
+		Misses:        stats.Misses,
+		Errors:        stats.Errors,
+		Invalidations: stats.Invalidations,
+	}
+	if reads := stats.Hits + stats.Misses; reads > 0 {
+		output.HitRatio = float64(stats.Hits) / float64(reads)
+	}
+
+	return output
+}
@@ -0,0 +1,35 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+	domainError "github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/error"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+)
+
+type GetProductPricesUseCase interface {
+	Run(ctx context.Context, productID int) ([]entities.ProductPrice, error)
+}
+
+type getProductPricesUseCase struct {
+	productRepository      ports.ProductRepository
+	productPriceRepository ports.ProductPriceRepository
+}
+
+func NewGetProductPricesUseCase(productRepository ports.ProductRepository, productPriceRepository ports.ProductPriceRepository) GetProductPricesUseCase {
+	return &getProductPricesUseCase{productRepository: productRepository, productPriceRepository: productPriceRepository}
+}
+
+// Run lists the price history of the product, scheduled prices first, latest effective date first.
+func (g *getProductPricesUseCase) Run(ctx context.Context, productID int) ([]entities.ProductPrice, error) {
+	product, err := g.productRepository.GetById(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+	if product.ID == 0 {
+		return nil, domainError.ErrNotFound("product")
+	}
+
+	return g.productPriceRepository.GetByProduct(ctx, productID)
+}
@@ -0,0 +1,34 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+	domainError "github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/error"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+)
+
+type GetProductRecipeUseCase interface {
+	Run(ctx context.Context, productID int) ([]entities.RecipeItem, error)
+}
+
+type getProductRecipeUseCase struct {
+	productRepository    ports.ProductRepository
+	ingredientRepository ports.IngredientRepository
+}
+
+func NewGetProductRecipeUseCase(productRepository ports.ProductRepository, ingredientRepository ports.IngredientRepository) GetProductRecipeUseCase {
+	return &getProductRecipeUseCase{productRepository: productRepository, ingredientRepository: ingredientRepository}
+}
+
+func (g *getProductRecipeUseCase) Run(ctx context.Context, productID int) ([]entities.RecipeItem, error) {
+	product, err := g.productRepository.GetById(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+	if product.ID == 0 {
+		return nil, domainError.ErrNotFound("product")
+	}
+
+	return g.ingredientRepository.GetRecipe(ctx, productID)
+}
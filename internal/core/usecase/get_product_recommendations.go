@@ -0,0 +1,65 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/config"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+)
+
+type GetProductRecommendationsUseCase interface {
+	Run(ctx context.Context, productID int, limit int) ([]entities.Product, error)
+}
+
+type getProductRecommendationsUseCase struct {
+	productRepository               ports.ProductRepository
+	productRecommendationRepository ports.ProductRecommendationRepository
+	location                        *time.Location
+}
+
+func NewGetProductRecommendationsUseCase(cfg *config.Config, productRepository ports.ProductRepository, productRecommendationRepository ports.ProductRecommendationRepository) GetProductRecommendationsUseCase {
+	return &getProductRecommendationsUseCase{
+		productRepository:               productRepository,
+		productRecommendationRepository: productRecommendationRepository,
+		location:                        cfg.Store.Location,
+	}
+}
+
+// Run returns the products most often bought with the product that can be ordered now.
+func (g *getProductRecommendationsUseCase) Run(ctx context.Context, productID int, limit int) ([]entities.Product, error) {
+	if _, err := g.productRepository.GetById(ctx, productID); err != nil {
+		return nil, err
+	}
+
+	ids, err := g.productRecommendationRepository.GetForProducts(ctx, []int{productID}, time.Now().In(g.location), limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return recommendedProducts(ctx, g.productRepository, ids)
+}
+
+// recommendedProducts loads the recommended products keeping the ranking. A product deleted since
+// the ids were read is skipped.
+func recommendedProducts(ctx context.Context, productRepository ports.ProductRepository, ids []int) ([]entities.Product, error) {
+	products, _, err := productRepository.GetByIds(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[int]entities.Product, len(products))
+	for _, product := range products {
+		byID[product.ID] = product
+	}
+
+	recommended := make([]entities.Product, 0, len(ids))
+	for _, id := range ids {
+		if product, ok := byID[id]; ok {
+			recommended = append(recommended, product)
+		}
+	}
+
+	return recommended, nil
+}
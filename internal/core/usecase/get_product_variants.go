@@ -0,0 +1,25 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+)
+
+type GetProductVariantsUseCase interface {
+	Run(ctx context.Context, productID int) ([]entities.ProductVariant, error)
+}
+
+type getProductVariantsUseCase struct {
+	productVariantRepository ports.ProductVariantRepository
+}
+
+func NewGetProductVariantsUseCase(productVariantRepository ports.ProductVariantRepository) GetProductVariantsUseCase {
+	return &getProductVariantsUseCase{productVariantRepository: productVariantRepository}
+}
+
+// Run lists the variants of the product in display order, read from the database so the stock is current.
+func (g *getProductVariantsUseCase) Run(ctx context.Context, productID int) ([]entities.ProductVariant, error) {
+	return g.productVariantRepository.GetByProduct(ctx, productID)
+}
@@ -2,7 +2,9 @@ package usecase
 
 import (
 	"context"
+	"time"
 
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/config"
 	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
 	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
 )
@@ -13,13 +15,20 @@ type GetProductsUseCase interface {
 
 type getProductsUseCase struct {
 	productRepository ports.ProductRepository
+	location          *time.Location
 }
 
-func NewGetProductsUseCase(productRepository ports.ProductRepository) GetProductsUseCase {
-	return &getProductsUseCase{productRepository: productRepository}
+func NewGetProductsUseCase(cfg *config.Config, productRepository ports.ProductRepository) GetProductsUseCase {
+	return &getProductsUseCase{productRepository: productRepository, location: cfg.Store.Location}
 }
 
 func (c *getProductsUseCase) Run(ctx context.Context, filter *ports.ProductFilter) ([]entities.Product, int, error) {
+	// Availability windows are set in the store timezone, whatever the offset of the informed time
+	if filter.AvailableAt != nil {
+		availableAt := filter.AvailableAt.In(c.location)
+		filter.AvailableAt = &availableAt
+	}
+
 	products, total, err := c.productRepository.GetAll(ctx, filter)
 	if err != nil {
 		return nil, 0, err
@@ -0,0 +1,36 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	domainError "github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/error"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+)
+
+type GetStoredImageUseCase interface {
+	Run(ctx context.Context, key string) (ports.StoredFile, error)
+}
+
+type getStoredImageUseCase struct {
+	fileStorage ports.FileStorage
+}
+
+func NewGetStoredImageUseCase(fileStorage ports.FileStorage) GetStoredImageUseCase {
+	return &getStoredImageUseCase{fileStorage: fileStorage}
+}
+
+// Run opens an uploaded product image or thumbnail, only keys under products/ are served.
+func (g *getStoredImageUseCase) Run(ctx context.Context, key string) (ports.StoredFile, error) {
+	if !strings.HasPrefix(key, "products/") {
+		return ports.StoredFile{}, domainError.ErrNotFound("image")
+	}
+
+	file, err := g.fileStorage.Open(ctx, key)
+	if errors.Is(err, ports.ErrFileNotFound) {
+		return ports.StoredFile{}, domainError.ErrNotFound("image")
+	}
+
+	return file, err
+}
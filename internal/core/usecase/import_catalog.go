@@ -0,0 +1,121 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+	domainError "github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/error"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/validator"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/dto"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/mappers"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+)
+
+// MaxCatalogImportProducts limits the products of an import, they are stored in one transaction.
+const MaxCatalogImportProducts = 5000
+
+type ImportCatalogUseCase interface {
+	Run(ctx context.Context, catalog []dto.CatalogProduct, dryRun bool, changedBy string) (*dto.CatalogImportOutput, error)
+}
+
+type importCatalogUseCase struct {
+	productRepository  ports.ProductRepository
+	categoryRepository ports.CategoryRepository
+}
+
+func NewImportCatalogUseCase(productRepository ports.ProductRepository, categoryRepository ports.CategoryRepository) ImportCatalogUseCase {
+	return &importCatalogUseCase{productRepository: productRepository, categoryRepository: categoryRepository}
+}
+
+// Run validates every product of the catalog and, when none has errors, creates or updates them by
+// SKU. A dry run reports what would be created and updated without storing anything.
+func (i *importCatalogUseCase) Run(ctx context.Context, catalog []dto.CatalogProduct, dryRun bool, changedBy string) (*dto.CatalogImportOutput, error) {
+	if len(catalog) == 0 {
+		return nil, domainError.NewEntityNotProcessableError("catalog", "the catalog has no products")
+	}
+
+	if len(catalog) > MaxCatalogImportProducts {
+		return nil, domainError.NewEntityNotProcessableError("catalog", fmt.Sprintf("the catalog must have at most %d products", MaxCatalogImportProducts))
+	}
+
+	categories, err := i.categoryRepository.GetAll(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+
+	handles := make(map[string]bool, len(categories))
+	for _, category := range categories {
+		handles[category.Handle] = true
+	}
+
+	output := &dto.CatalogImportOutput{DryRun: dryRun, Errors: []dto.CatalogRowError{}}
+	products := make([]entities.Product, 0, len(catalog))
+	rows := make(map[string]int, len(catalog))
+	for index, item := range catalog {
+		row := index + 1
+		product := mappers.MapCatalogProductToEntity(item)
+
+		errors := validator.HandleValidationError(dto.ValidateCatalogProduct(item))
+		if first, ok := rows[product.SKU]; ok && product.SKU != "" {
+			errors = append(errors, validator.ErrorResponse{Field: "SKU", Message: fmt.Sprintf("Repeats the SKU of row %d", first)})
+		} else {
+			rows[product.SKU] = row
+		}
+
+		if item.Category != "" && !handles[item.Category] {
+			errors = append(errors, validator.ErrorResponse{Field: "Category", Message: "Category not found"})
+		}
+
+		errors = append(errors, dietaryInformationErrors(product)...)
+
+		if len(errors) > 0 {
+			output.Errors = append(output.Errors, dto.CatalogRowError{Row: row, SKU: item.SKU, Errors: errors})
+			continue
+		}
+
+		products = append(products, product)
+	}
+
+	if len(output.Errors) > 0 {
+		return output, nil
+	}
+
+	result, err := i.productRepository.ImportCatalog(ctx, products, changedBy, dryRun)
+	if err != nil {
+		return nil, err
+	}
+
+	output.Created = result.Created
+	output.Updated = result.Updated
+
+	return output, nil
+}
+
+// dietaryInformationErrors reports the nutrition facts, allergens and dietary tags of the product
+// each under its own field.
+func dietaryInformationErrors(product entities.Product) []validator.ErrorResponse {
+	var errors []validator.ErrorResponse
+	if product.Nutrition != nil {
+		if err := product.Nutrition.Validate(); err != nil {
+			errors = append(errors, validator.ErrorResponse{Field: "Nutrition", Message: capitalize(err.Error())})
+		}
+	}
+
+	if err := entities.ValidateDietaryInformation(product.Allergens, nil); err != nil {
+		errors = append(errors, validator.ErrorResponse{Field: "Allergens", Message: capitalize(err.Error())})
+	} else if err := entities.ValidateDietaryInformation(product.Allergens, product.DietaryTags); err != nil {
+		errors = append(errors, validator.ErrorResponse{Field: "DietaryTags", Message: capitalize(err.Error())})
+	}
+
+	return errors
+}
+
+func capitalize(message string) string {
+	if message == "" {
+		return message
+	}
+
+	return strings.ToUpper(message[:1]) + message[1:]
+}
@@ -0,0 +1,24 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+)
+
+type InvalidateProductCacheUseCase interface {
+	Run(ctx context.Context)
+}
+
+type invalidateProductCacheUseCase struct {
+	productCache ports.ProductCache
+}
+
+func NewInvalidateProductCacheUseCase(productCache ports.ProductCache) InvalidateProductCacheUseCase {
+	return &invalidateProductCacheUseCase{productCache: productCache}
+}
+
+// Run drops the cached product reads, for changes made straight in the database.
+func (i *invalidateProductCacheUseCase) Run(ctx context.Context) {
+	i.productCache.Invalidate(ctx)
+}
@@ -0,0 +1,28 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+)
+
+const sandboxPaymentsLimit = 100
+
+type ListSandboxPaymentsUseCase interface {
+	Run(ctx context.Context) ([]entities.Payment, error)
+}
+
+type listSandboxPaymentsUseCase struct {
+	paymentRepository ports.PaymentRepository
+}
+
+func NewListSandboxPaymentsUseCase(paymentRepository ports.PaymentRepository) ListSandboxPaymentsUseCase {
+	return &listSandboxPaymentsUseCase{paymentRepository: paymentRepository}
+}
+
+// Run lists the payments still waiting for the gateway, oldest first.
+func (l *listSandboxPaymentsUseCase) Run(ctx context.Context) ([]entities.Payment, error) {
+	return l.paymentRepository.GetPendingCreatedBefore(ctx, time.Now(), sandboxPaymentsLimit)
+}
@@ -0,0 +1,48 @@
+package mappers
+
+import (
+	"time"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/dto"
+)
+
+func MapAvailabilityInputToEntity(input dto.AvailabilityInput) (entities.AvailabilitySchedule, error) {
+	schedule := make(entities.AvailabilitySchedule, 0, len(input.Windows))
+	for _, window := range input.Windows {
+		start, err := entities.ParseClockMinute(window.Start)
+		if err != nil {
+			return nil, err
+		}
+
+		end, err := entities.ParseClockMinute(window.End)
+		if err != nil {
+			return nil, err
+		}
+
+		schedule = append(schedule, entities.AvailabilityWindow{
+			Weekday:     time.Weekday(*window.Weekday),
+			StartMinute: start,
+			EndMinute:   end,
+		})
+	}
+
+	return schedule, nil
+}
+
+func ToAvailabilityWindowsDTO(schedule entities.AvailabilitySchedule) []dto.AvailabilityWindowOutput {
+	windows := make([]dto.AvailabilityWindowOutput, 0, len(schedule))
+	for _, window := range schedule {
+		windows = append(windows, dto.AvailabilityWindowOutput{
+			Weekday: int(window.Weekday),
+			Start:   entities.FormatClockMinute(window.StartMinute),
+			End:     entities.FormatClockMinute(window.EndMinute),
+		})
+	}
+
+	return windows
+}
+
+func ToAvailabilityDTO(schedule entities.AvailabilitySchedule) dto.AvailabilityOutput {
+	return dto.AvailabilityOutput{Windows: ToAvailabilityWindowsDTO(schedule)}
+}
@@ -0,0 +1,73 @@
+package mappers
+
+import (
+	"strings"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/dto"
+)
+
+func MapCatalogProductToEntity(input dto.CatalogProduct) entities.Product {
+	images := make([]entities.ProductImage, 0, len(input.Images))
+	for _, image := range input.Images {
+		images = append(images, entities.ProductImage{ImageURL: image})
+	}
+
+	currency := strings.ToUpper(input.Currency)
+	if currency == "" {
+		currency = entities.DefaultCurrency
+	}
+
+	product := entities.Product{
+		SKU:         strings.TrimSpace(input.SKU),
+		Name:        input.Name,
+		Description: input.Description,
+		Price:       entities.Money{Cents: input.PriceCents, Currency: currency},
+		Category:    entities.ProductCategory{Handle: input.Category},
+		Images:      images,
+		Allergens:   MapAllergens(input.Allergens),
+		DietaryTags: MapDietaryTags(input.DietaryTags),
+	}
+	if input.Nutrition != nil {
+		nutrition := MapNutritionInputToEntity(*input.Nutrition)
+		product.Nutrition = &nutrition
+	}
+
+	return product
+}
+
+func ToCatalogProductDTO(product entities.Product) dto.CatalogProduct {
+	images := make([]string, 0, len(product.Images))
+	for _, image := range product.Images {
+		if image.ImageURL != "" {
+			images = append(images, image.ImageURL)
+		}
+	}
+
+	output := dto.CatalogProduct{
+		SKU:         product.SKU,
+		Name:        product.Name,
+		Description: product.Description,
+		Category:    product.Category.Handle,
+		PriceCents:  product.Price.Cents,
+		Currency:    product.Price.Currency,
+		Images:      images,
+		Allergens:   ToAllergensDTO(product.Allergens),
+		DietaryTags: ToDietaryTagsDTO(product.DietaryTags),
+	}
+	if product.Nutrition != nil {
+		output.Nutrition = &dto.NutritionInput{
+			ServingSizeG:   product.Nutrition.ServingSizeG,
+			EnergyKcal:     product.Nutrition.EnergyKcal,
+			CarbohydratesG: product.Nutrition.CarbohydratesG,
+			SugarsG:        product.Nutrition.SugarsG,
+			ProteinG:       product.Nutrition.ProteinG,
+			FatG:           product.Nutrition.FatG,
+			SaturatedFatG:  product.Nutrition.SaturatedFatG,
+			FiberG:         product.Nutrition.FiberG,
+			SodiumMg:       product.Nutrition.SodiumMg,
+		}
+	}
+
+	return output
+}
@@ -0,0 +1,48 @@
+package mappers
+
+import (
+	"strings"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/dto"
+)
+
+func ToCategoriesDTO(categories []entities.Category) []dto.CategoryOutput {
+	outputs := make([]dto.CategoryOutput, 0, len(categories))
+	for _, category := range categories {
+		outputs = append(outputs, ToCategoryDTO(category))
+	}
+
+	return outputs
+}
+
+func ToCategoryDTO(category entities.Category) dto.CategoryOutput {
+	return dto.CategoryOutput{
+		ID:           category.ID,
+		Name:         category.Name,
+		Handle:       category.Handle,
+		Description:  category.Description,
+		Image:        category.Image,
+		DisplayOrder: category.DisplayOrder,
+		Active:       category.Active,
+		ProductCount: category.ProductCount,
+		CreatedAt:    category.CreatedAt,
+		UpdatedAt:    category.UpdatedAt,
+	}
+}
+
+func MapCategoryInputToEntity(id int, input dto.CategoryInput) entities.Category {
+	active := true
+	if input.Active != nil {
+		active = *input.Active
+	}
+
+	return entities.Category{
+		ID:           id,
+		Name:         strings.TrimSpace(input.Name),
+		Description:  input.Description,
+		Image:        input.Image,
+		DisplayOrder: input.DisplayOrder,
+		Active:       active,
+	}
+}
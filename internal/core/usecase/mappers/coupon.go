@@ -0,0 +1,69 @@
+package mappers
+
+import (
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/dto"
+)
+
+func ToCouponsDTO(coupons []entities.Coupon) []dto.CouponOutput {
+	outputs := make([]dto.CouponOutput, 0, len(coupons))
+	for _, coupon := range coupons {
+		outputs = append(outputs, ToCouponDTO(coupon))
+	}
+
+	return outputs
+}
+
+func ToCouponDTO(coupon entities.Coupon) dto.CouponOutput {
+	return dto.CouponOutput{
+		ID:                      coupon.ID,
+		Code:                    coupon.Code,
+		Description:             coupon.Description,
+		AmountType:              string(coupon.AmountType),
+		AmountValue:             coupon.AmountValue,
+		MinOrderAmount:          dto.Money(coupon.MinOrderAmount),
+		StartsAt:                coupon.StartsAt,
+		EndsAt:                  coupon.EndsAt,
+		MaxRedemptions:          coupon.MaxRedemptions,
+		MaxRedemptionsPerClient: coupon.MaxRedemptionsPerClient,
+		RedemptionsCount:        coupon.RedemptionsCount,
+		ProductIDs:              coupon.ProductIDs,
+		CategoryIDs:             coupon.CategoryIDs,
+		Active:                  coupon.Active,
+		CreatedAt:               coupon.CreatedAt,
+		UpdatedAt:               coupon.UpdatedAt,
+	}
+}
+
+func MapCouponInputToEntity(id int, input dto.CouponInput) entities.Coupon {
+	active := true
+	if input.Active != nil {
+		active = *input.Active
+	}
+
+	productIDs := input.ProductIDs
+	if productIDs == nil {
+		productIDs = make([]int, 0)
+	}
+
+	categoryIDs := input.CategoryIDs
+	if categoryIDs == nil {
+		categoryIDs = make([]int, 0)
+	}
+
+	return entities.Coupon{
+		ID:                      id,
+		Code:                    entities.NormalizeCouponCode(input.Code),
+		Description:             input.Description,
+		AmountType:              entities.AmountType(input.AmountType),
+		AmountValue:             input.AmountValue,
+		MinOrderAmount:          entities.NewMoney(input.MinOrderAmount.Cents),
+		StartsAt:                input.StartsAt,
+		EndsAt:                  input.EndsAt,
+		MaxRedemptions:          input.MaxRedemptions,
+		MaxRedemptionsPerClient: input.MaxRedemptionsPerClient,
+		ProductIDs:              productIDs,
+		CategoryIDs:             categoryIDs,
+		Active:                  active,
+	}
+}
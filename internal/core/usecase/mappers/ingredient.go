@@ -0,0 +1,115 @@
+package mappers
+
+import (
+	"strings"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/dto"
+)
+
+func ToIngredientsDTO(ingredients []entities.Ingredient) []dto.IngredientOutput {
+	outputs := make([]dto.IngredientOutput, 0, len(ingredients))
+	for _, ingredient := range ingredients {
+		outputs = append(outputs, ToIngredientDTO(ingredient))
+	}
+
+	return outputs
+}
+
+func ToIngredientDTO(ingredient entities.Ingredient) dto.IngredientOutput {
+	return dto.IngredientOutput{
+		ID:            ingredient.ID,
+		Name:          ingredient.Name,
+		Unit:          string(ingredient.Unit),
+		StockQuantity: ingredient.StockQuantity,
+		CreatedAt:     ingredient.CreatedAt,
+		UpdatedAt:     ingredient.UpdatedAt,
+	}
+}
+
+func MapIngredientInputToEntity(id int, input dto.IngredientInput) entities.Ingredient {
+	return entities.Ingredient{
+		ID:   id,
+		Name: strings.TrimSpace(input.Name),
+		Unit: entities.IngredientUnit(input.Unit),
+	}
+}
+
+func ToIngredientMovementsDTO(movements []entities.IngredientMovement) []dto.IngredientMovementOutput {
+	outputs := make([]dto.IngredientMovementOutput, 0, len(movements))
+	for _, movement := range movements {
+		outputs = append(outputs, ToIngredientMovementDTO(movement))
+	}
+
+	return outputs
+}
+
+func ToIngredientMovementDTO(movement entities.IngredientMovement) dto.IngredientMovementOutput {
+	return dto.IngredientMovementOutput{
+		ID:           movement.ID,
+		IngredientID: movement.IngredientID,
+		OrderID:      movement.OrderID,
+		Type:         string(movement.Type),
+		Quantity:     movement.Quantity,
+		Description:  movement.Description,
+		CreatedAt:    movement.CreatedAt,
+	}
+}
+
+func ToIngredientCountDTO(count entities.IngredientCount) dto.IngredientCountOutput {
+	return dto.IngredientCountOutput{
+		ID:                  count.ID,
+		IngredientID:        count.IngredientID,
+		CountedQuantity:     count.CountedQuantity,
+		TheoreticalQuantity: count.TheoreticalQuantity,
+		Variance:            count.Variance(),
+		Description:         count.Description,
+		CountedAt:           count.CountedAt,
+	}
+}
+
+func ToInventoryReportDTO(lines []entities.InventoryReportLine) dto.InventoryReportOutput {
+	outputs := make([]dto.InventoryReportLineOutput, 0, len(lines))
+	for _, line := range lines {
+		output := dto.InventoryReportLineOutput{
+			IngredientID:        line.Ingredient.ID,
+			Name:                line.Ingredient.Name,
+			Unit:                string(line.Ingredient.Unit),
+			TheoreticalQuantity: line.Ingredient.StockQuantity,
+		}
+		if line.LastCount != nil {
+			count := ToIngredientCountDTO(*line.LastCount)
+			output.LastCount = &count
+		}
+		outputs = append(outputs, output)
+	}
+
+	return dto.InventoryReportOutput{Ingredients: outputs}
+}
+
+func ToRecipeDTO(productID int, items []entities.RecipeItem) dto.RecipeOutput {
+	outputs := make([]dto.RecipeItemOutput, 0, len(items))
+	for _, item := range items {
+		outputs = append(outputs, dto.RecipeItemOutput{
+			IngredientID:   item.IngredientID,
+			IngredientName: item.IngredientName,
+			Unit:           string(item.Unit),
+			Quantity:       item.Quantity,
+		})
+	}
+
+	return dto.RecipeOutput{ProductID: productID, Items: outputs}
+}
+
+func MapRecipeInputToEntity(productID int, input dto.RecipeInput) []entities.RecipeItem {
+	items := make([]entities.RecipeItem, 0, len(input.Items))
+	for _, item := range input.Items {
+		items = append(items, entities.RecipeItem{
+			ProductID:    productID,
+			IngredientID: item.IngredientID,
+			Quantity:     entities.RoundIngredientQuantity(item.Quantity),
+		})
+	}
+
+	return items
+}
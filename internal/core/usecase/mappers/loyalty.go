@@ -0,0 +1,31 @@
+package mappers
+
+import (
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/dto"
+)
+
+func ToClientLoyaltyDTO(client entities.Client, entries []entities.LoyaltyEntry, program entities.LoyaltyProgram) dto.ClientLoyaltyOutput {
+	outputs := make([]dto.LoyaltyEntryOutput, 0, len(entries))
+	for _, entry := range entries {
+		outputs = append(outputs, dto.LoyaltyEntryOutput{
+			ID:          entry.ID,
+			OrderID:     entry.OrderID,
+			Type:        string(entry.Type),
+			Points:      entry.Points,
+			Description: entry.Description,
+			CreatedAt:   entry.CreatedAt,
+		})
+	}
+
+	balance := entities.LoyaltyBalance(entries)
+
+	return dto.ClientLoyaltyOutput{
+		ClientID:     client.ID,
+		CPF:          client.CPF,
+		Balance:      balance,
+		BalanceValue: dto.Money(program.PointValue.Multiply(balance)),
+		PointValue:   dto.Money(program.PointValue),
+		Entries:      outputs,
+	}
+}
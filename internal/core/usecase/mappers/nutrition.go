@@ -0,0 +1,83 @@
+package mappers
+
+import (
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/dto"
+)
+
+func MapNutritionInputToEntity(input dto.NutritionInput) entities.NutritionFacts {
+	return entities.NutritionFacts{
+		ServingSizeG:   input.ServingSizeG,
+		EnergyKcal:     input.EnergyKcal,
+		CarbohydratesG: input.CarbohydratesG,
+		SugarsG:        input.SugarsG,
+		ProteinG:       input.ProteinG,
+		FatG:           input.FatG,
+		SaturatedFatG:  input.SaturatedFatG,
+		FiberG:         input.FiberG,
+		SodiumMg:       input.SodiumMg,
+	}
+}
+
+func ToNutritionDTO(nutrition *entities.NutritionFacts) *dto.NutritionOutput {
+	if nutrition == nil {
+		return nil
+	}
+
+	return &dto.NutritionOutput{
+		ServingSizeG:   nutrition.ServingSizeG,
+		EnergyKcal:     nutrition.EnergyKcal,
+		CarbohydratesG: nutrition.CarbohydratesG,
+		SugarsG:        nutrition.SugarsG,
+		ProteinG:       nutrition.ProteinG,
+		FatG:           nutrition.FatG,
+		SaturatedFatG:  nutrition.SaturatedFatG,
+		FiberG:         nutrition.FiberG,
+		SodiumMg:       nutrition.SodiumMg,
+	}
+}
+
+// MapAllergens keeps a nil list nil, so updates can tell an omitted list from an empty one.
+func MapAllergens(allergens []string) []entities.Allergen {
+	if allergens == nil {
+		return nil
+	}
+
+	mapped := make([]entities.Allergen, 0, len(allergens))
+	for _, allergen := range allergens {
+		mapped = append(mapped, entities.Allergen(allergen))
+	}
+
+	return mapped
+}
+
+func MapDietaryTags(tags []string) []entities.DietaryTag {
+	if tags == nil {
+		return nil
+	}
+
+	mapped := make([]entities.DietaryTag, 0, len(tags))
+	for _, tag := range tags {
+		mapped = append(mapped, entities.DietaryTag(tag))
+	}
+
+	return mapped
+}
+
+func ToAllergensDTO(allergens []entities.Allergen) []string {
+	output := make([]string, 0, len(allergens))
+	for _, allergen := range allergens {
+		output = append(output, string(allergen))
+	}
+
+	return output
+}
+
+func ToDietaryTagsDTO(tags []entities.DietaryTag) []string {
+	output := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		output = append(output, string(tag))
+	}
+
+	return output
+}
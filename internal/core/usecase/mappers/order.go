@@ -1,8 +1,6 @@
 package mappers
 
 import (
-	"log/slog"
-
 	fiapRestaurantDb "github.com/tupizz/restaurant-food-golang-api-fiap/database/sqlc"
 	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
 	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/dto"
@@ -12,16 +10,11 @@ func ToCompleteOrdersDTO(rawOrders []fiapRestaurantDb.GetAllOrdersRow) []dto.Ord
 	var mapOrderIdToItems = make(map[int][]dto.OrderDTO)
 
 	for _, order := range rawOrders {
-		productPrice, _ := order.ProductPrice.Float64Value()
-
-		if !productPrice.Valid {
-			slog.Error("product price is not valid")
-		}
-
-		paymentAmount, _ := order.PaymentAmount.Float64Value()
-		if !paymentAmount.Valid {
-			slog.Error("payment amount is not valid")
-		}
+		productPrice := dto.Money{Cents: order.ProductPriceCents, Currency: order.PaymentCurrency}
+		itemPrice := dto.Money{Cents: order.ItemPriceCents, Currency: order.PaymentCurrency}
+		paymentAmount := dto.Money{Cents: order.PaymentAmountCents, Currency: order.PaymentCurrency}
+		paymentRefundedAmount := dto.Money{Cents: order.PaymentRefundedAmountCents, Currency: order.PaymentCurrency}
+		paymentNetAmount := dto.Money{Cents: order.PaymentNetAmountCents, Currency: order.PaymentCurrency}
 
 		mapOrderIdToItems[int(order.OrderID)] = append(mapOrderIdToItems[int(order.OrderID)], dto.OrderDTO{
 			ID:       int(order.OrderID),
@@ -34,26 +27,31 @@ func ToCompleteOrdersDTO(rawOrders []fiapRestaurantDb.GetAllOrdersRow) []dto.Ord
 			Status: string(order.OrderStatus.String),
 			Items: []dto.OrderItemDTO{
 				{
-					ID:        int(order.ProductID),
-					OrderID:   int(order.OrderID),
-					ProductID: int(order.ProductID),
-					Quantity:  int(order.ProductQuantity),
-					Price:     productPrice.Float64,
+					ID:          int(order.ProductID),
+					OrderID:     int(order.OrderID),
+					ProductID:   int(order.ProductID),
+					VariantID:   int(order.VariantID),
+					VariantName: order.VariantName,
+					Quantity:    int(order.ProductQuantity),
+					Price:       itemPrice,
 					Product: dto.ProductDTO{
 						ID:             int(order.ProductID),
 						Name:           order.ProductName,
 						CategoryHandle: order.CategoryHandle,
 						Description:    order.ProductDescription,
-						Price:          productPrice.Float64,
+						Price:          productPrice,
 					},
 				},
 			},
+			Total:    paymentAmount,
+			NetTotal: paymentNetAmount,
 			Payment: dto.PaymentDTO{
-				ID:      int(order.PaymentID),
-				OrderID: int(order.OrderID),
-				Status:  string(order.PaymentStatus.String),
-				Amount:  paymentAmount.Float64,
-				Method:  string(order.PaymentMethod),
+				ID:             int(order.PaymentID),
+				OrderID:        int(order.OrderID),
+				Status:         string(order.PaymentStatus.String),
+				Amount:         paymentAmount,
+				RefundedAmount: paymentRefundedAmount,
+				Method:         string(order.PaymentMethod),
 			},
 		})
 	}
@@ -77,55 +75,121 @@ func MapCreateOrderRequestToEntity(dto dto.CreateOrderRequest) entities.Order {
 	for i, itemDTO := range dto.Items {
 		items[i] = entities.OrderItem{
 			ProductID: itemDTO.ProductID,
+			VariantID: itemDTO.VariantID,
 			Quantity:  itemDTO.Quantity,
 		}
 	}
 
+	return entities.Order{
+		ClientID:      dto.ClientID,
+		Status:        entities.OrderStatusPending,
+		Delivery:      dto.Delivery,
+		CouponCode:    dto.CouponCode,
+		LoyaltyPoints: dto.LoyaltyPoints,
+		Items:         items,
+		Payment:       MapCreatePaymentRequestToEntity(dto.Payment),
+	}
+}
+
+func MapCreatePaymentRequestToEntity(dto dto.CreatePaymentRequest) entities.Payment {
 	payment := entities.Payment{
-		Method: entities.PaymentMethod(dto.Payment.Method),
+		Method: entities.PaymentMethod(dto.Method),
 		Status: entities.PaymentStatusPending,
 	}
 
-	return entities.Order{
-		ClientID: dto.ClientID,
-		Status:   entities.OrderStatusPending,
-		Items:    items,
-		Payment:  payment,
+	if dto.Card != nil {
+		payment.CardData = &entities.CardData{
+			Number:     dto.Card.Number,
+			HolderName: dto.Card.HolderName,
+			ExpMonth:   dto.Card.ExpMonth,
+			ExpYear:    dto.Card.ExpYear,
+			CVV:        dto.Card.CVV,
+		}
 	}
+
+	return payment
 }
 
 func MapOrderEntityToResponse(order entities.Order) dto.OrderResponse {
 	items := make([]dto.OrderItemResponse, len(order.Items))
 	for i, item := range order.Items {
 		items[i] = dto.OrderItemResponse{
-			ID:        item.ID,
-			OrderID:   item.OrderID,
-			ProductID: item.ProductID,
-			Quantity:  item.Quantity,
-			Price:     item.Price,
-			CreatedAt: item.CreatedAt,
-			UpdatedAt: item.UpdatedAt,
+			ID:          item.ID,
+			OrderID:     item.OrderID,
+			ProductID:   item.ProductID,
+			VariantID:   item.VariantID,
+			VariantName: item.VariantName,
+			Quantity:    item.Quantity,
+			Price:       dto.Money(item.Price),
+			CreatedAt:   item.CreatedAt,
+			UpdatedAt:   item.UpdatedAt,
+		}
+	}
+
+	fees := make([]dto.OrderFeeResponse, len(order.Fees))
+	for i, fee := range order.Fees {
+		fees[i] = dto.OrderFeeResponse{
+			ID:                  fee.ID,
+			PaymentTaxSettingID: fee.PaymentTaxSettingID,
+			Name:                fee.Name,
+			AmountType:          string(fee.AmountType),
+			AmountValue:         fee.AmountValue,
+			ApplicableTo:        string(fee.ApplicableTo),
+			Amount:              dto.Money(fee.Amount),
+		}
+	}
+
+	discounts := make([]dto.OrderDiscountResponse, len(order.Discounts))
+	for i, discount := range order.Discounts {
+		discounts[i] = dto.OrderDiscountResponse{
+			ID:            discount.ID,
+			CouponID:      discount.CouponID,
+			Code:          discount.Code,
+			AmountType:    string(discount.AmountType),
+			AmountValue:   discount.AmountValue,
+			Amount:        dto.Money(discount.Amount),
+			LoyaltyPoints: discount.LoyaltyPoints,
 		}
 	}
 
 	payment := dto.PaymentResponse{
-		ID:        order.Payment.ID,
-		OrderID:   order.Payment.OrderID,
-		Status:    string(order.Payment.Status),
-		Method:    string(order.Payment.Method),
-		QRData:    order.Payment.QRData,
-		Amount:    order.Payment.Amount,
-		CreatedAt: order.Payment.CreatedAt,
-		UpdatedAt: order.Payment.UpdatedAt,
+		ID:             order.Payment.ID,
+		OrderID:        order.Payment.OrderID,
+		Status:         string(order.Payment.Status),
+		Method:         string(order.Payment.Method),
+		QRData:         order.Payment.QRData,
+		Amount:         dto.Money(order.Payment.Amount),
+		RefundedAmount: dto.Money(order.Payment.RefundedAmount),
+		CreatedAt:      order.Payment.CreatedAt,
+		UpdatedAt:      order.Payment.UpdatedAt,
+	}
+	if order.Payment.Card != nil {
+		payment.Card = &dto.CardResponse{Brand: order.Payment.Card.Brand, Last4: order.Payment.Card.Last4}
+	}
+
+	var allergenWarnings []dto.AllergenWarningResponse
+	for _, warning := range order.AllergenWarnings {
+		allergenWarnings = append(allergenWarnings, dto.AllergenWarningResponse{
+			Allergen:     string(warning.Allergen),
+			ProductNames: warning.ProductNames,
+		})
 	}
 
 	return dto.OrderResponse{
-		ID:        order.ID,
-		ClientID:  order.ClientID,
-		Status:    string(order.Status),
-		Items:     items,
-		Payment:   payment,
-		CreatedAt: order.CreatedAt,
-		UpdatedAt: order.UpdatedAt,
+		ID:               order.ID,
+		ClientID:         order.ClientID,
+		Status:           string(order.Status),
+		Delivery:         order.Delivery,
+		Items:            items,
+		Subtotal:         dto.Money(order.Subtotal()),
+		Discounts:        discounts,
+		Fees:             fees,
+		Total:            dto.Money(order.Payment.Amount),
+		NetTotal:         dto.Money(order.Payment.NetAmount()),
+		Payment:          payment,
+		PaymentFailedAt:  order.PaymentFailedAt,
+		AllergenWarnings: allergenWarnings,
+		CreatedAt:        order.CreatedAt,
+		UpdatedAt:        order.UpdatedAt,
 	}
 }
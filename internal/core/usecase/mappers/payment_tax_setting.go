@@ -0,0 +1,46 @@
+package mappers
+
+import (
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/dto"
+)
+
+func ToPaymentTaxSettingsDTO(settings []entities.PaymentTaxSettings) []dto.PaymentTaxSettingOutput {
+	outputs := make([]dto.PaymentTaxSettingOutput, 0, len(settings))
+	for _, setting := range settings {
+		outputs = append(outputs, ToPaymentTaxSettingDTO(setting))
+	}
+
+	return outputs
+}
+
+func ToPaymentTaxSettingDTO(setting entities.PaymentTaxSettings) dto.PaymentTaxSettingOutput {
+	return dto.PaymentTaxSettingOutput{
+		ID:           setting.ID,
+		Name:         setting.Name,
+		Description:  setting.Description,
+		AmountType:   string(setting.AmountType),
+		AmountValue:  setting.AmountValue,
+		ApplicableTo: string(setting.ApplicableTo),
+		Active:       setting.Active,
+		CreatedAt:    setting.CreatedAt,
+		UpdatedAt:    setting.UpdatedAt,
+	}
+}
+
+func MapPaymentTaxSettingInputToEntity(id int, input dto.PaymentTaxSettingInput) entities.PaymentTaxSettings {
+	active := true
+	if input.Active != nil {
+		active = *input.Active
+	}
+
+	return entities.PaymentTaxSettings{
+		ID:           id,
+		Name:         input.Name,
+		Description:  input.Description,
+		AmountType:   entities.AmountType(input.AmountType),
+		AmountValue:  input.AmountValue,
+		ApplicableTo: entities.ApplicableTo(input.ApplicableTo),
+		Active:       active,
+	}
+}
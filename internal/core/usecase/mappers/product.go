@@ -20,18 +20,58 @@ func ToProductsDTO(products []entities.Product) []dto.ProductOutput {
 
 func ToProductDTO(product entities.Product) dto.ProductOutput {
 	images := make([]string, 0, len(product.Images))
+	imageDetails := make([]dto.ProductImageOutput, 0, len(product.Images))
 	for _, image := range product.Images {
 		if image.ImageURL != "" {
 			images = append(images, image.ImageURL)
+			imageDetails = append(imageDetails, ToProductImageDTO(image))
 		}
 	}
 
-	return dto.ProductOutput{
-		ID:          product.ID,
-		Name:        product.Name,
-		Price:       product.Price,
-		Description: product.Description,
-		Category:    product.Category.Name,
-		Images:      images,
+	output := dto.ProductOutput{
+		ID:           product.ID,
+		SKU:          product.SKU,
+		Name:         product.Name,
+		Price:        dto.Money(product.Price),
+		Description:  product.Description,
+		Category:     product.Category.Name,
+		Images:       images,
+		ImageDetails: imageDetails,
+		StockStatus:  string(product.Stock.Status()),
+		Available:    product.IngredientsAvailable,
+		Availability: ToAvailabilityWindowsDTO(product.Availability),
+		Nutrition:    ToNutritionDTO(product.Nutrition),
+		Allergens:    ToAllergensDTO(product.Allergens),
+		DietaryTags:  ToDietaryTagsDTO(product.DietaryTags),
+		Variants:     ToProductVariantsDTO(product.Variants),
+		DeletedAt:    product.DeletedAt,
 	}
+	if product.Stock.Tracked {
+		quantity := product.Stock.Quantity
+		output.StockQuantity = &quantity
+	}
+
+	return output
+}
+
+func ToProductImageDTO(image entities.ProductImage) dto.ProductImageOutput {
+	thumbnails := make(map[string]string, len(image.Thumbnails))
+	for _, thumbnail := range image.Thumbnails {
+		thumbnails[thumbnail.Name] = thumbnail.URL
+	}
+
+	return dto.ProductImageOutput{ID: image.ID, URL: image.ImageURL, Thumbnails: thumbnails}
+}
+
+func MapProductStockInputToEntity(input dto.ProductStockInput) entities.ProductStock {
+	stock := entities.ProductStock{
+		Tracked:           input.TrackStock,
+		Quantity:          input.Quantity,
+		LowStockThreshold: entities.DefaultLowStockThreshold,
+	}
+	if input.LowStockThreshold != nil {
+		stock.LowStockThreshold = *input.LowStockThreshold
+	}
+
+	return stock
 }
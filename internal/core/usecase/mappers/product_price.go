@@ -0,0 +1,29 @@
+package mappers
+
+import (
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/dto"
+)
+
+func ToProductPriceDTO(price entities.ProductPrice) dto.ProductPriceOutput {
+	return dto.ProductPriceOutput{
+		ID:          price.ID,
+		ProductID:   price.ProductID,
+		Price:       dto.Money(price.Price),
+		EffectiveAt: price.EffectiveAt,
+		Status:      string(price.Status()),
+		AppliedAt:   price.AppliedAt,
+		CanceledAt:  price.CanceledAt,
+		ChangedBy:   price.ChangedBy,
+		CreatedAt:   price.CreatedAt,
+	}
+}
+
+func ToProductPricesDTO(prices []entities.ProductPrice) []dto.ProductPriceOutput {
+	outputs := make([]dto.ProductPriceOutput, 0, len(prices))
+	for _, price := range prices {
+		outputs = append(outputs, ToProductPriceDTO(price))
+	}
+
+	return outputs
+}
@@ -0,0 +1,48 @@
+package mappers
+
+import (
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/dto"
+)
+
+func ToProductVariantsDTO(variants []entities.ProductVariant) []dto.ProductVariantOutput {
+	outputs := make([]dto.ProductVariantOutput, 0, len(variants))
+	for _, variant := range variants {
+		outputs = append(outputs, ToProductVariantDTO(variant))
+	}
+
+	return outputs
+}
+
+func ToProductVariantDTO(variant entities.ProductVariant) dto.ProductVariantOutput {
+	output := dto.ProductVariantOutput{
+		ID:           variant.ID,
+		Name:         variant.Name,
+		SKU:          variant.SKU,
+		Price:        dto.Money(variant.Price),
+		StockStatus:  string(variant.Stock.Status()),
+		DisplayOrder: variant.DisplayOrder,
+	}
+	if variant.Stock.Tracked {
+		quantity := variant.Stock.Quantity
+		output.StockQuantity = &quantity
+	}
+
+	return output
+}
+
+func MapProductVariantInputToEntity(productID int, input dto.ProductVariantInput) entities.ProductVariant {
+	variant := entities.ProductVariant{
+		ProductID:    productID,
+		Name:         input.Name,
+		SKU:          input.SKU,
+		Price:        input.Price,
+		DisplayOrder: input.DisplayOrder,
+		Stock:        entities.ProductStock{LowStockThreshold: entities.DefaultLowStockThreshold},
+	}
+	if input.Stock != nil {
+		variant.Stock = MapProductStockInputToEntity(*input.Stock)
+	}
+
+	return variant
+}
@@ -0,0 +1,54 @@
+package mappers
+
+import (
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/dto"
+)
+
+func ToReceiptDTO(receipt entities.Receipt) dto.ReceiptOutput {
+	items := make([]dto.ReceiptItemOutput, 0, len(receipt.Items))
+	for _, item := range receipt.Items {
+		items = append(items, dto.ReceiptItemOutput{
+			ProductID: item.ProductID,
+			Name:      item.Name,
+			Quantity:  item.Quantity,
+			UnitPrice: dto.Money(item.UnitPrice),
+			Total:     dto.Money(item.Total),
+		})
+	}
+
+	return dto.ReceiptOutput{
+		Number:   receipt.Number,
+		IssuedAt: receipt.IssuedAt,
+		Issuer: dto.ReceiptIssuerOutput{
+			Name:    receipt.Issuer.Name,
+			CNPJ:    receipt.Issuer.CNPJ,
+			Address: receipt.Issuer.Address,
+		},
+		Customer: dto.ReceiptCustomerOutput{
+			Name: receipt.CustomerName,
+			CPF:  receipt.CustomerCPF,
+		},
+		Items:     items,
+		Subtotal:  dto.Money(receipt.Subtotal),
+		Discounts: toReceiptAdjustmentsDTO(receipt.Discounts),
+		Fees:      toReceiptAdjustmentsDTO(receipt.Fees),
+		Total:     dto.Money(receipt.Total),
+		Payment: dto.ReceiptPaymentOutput{
+			Method:         string(receipt.PaymentMethod),
+			Status:         string(receipt.PaymentStatus),
+			Amount:         dto.Money(receipt.Total),
+			RefundedAmount: dto.Money(receipt.RefundedAmount),
+			NetAmount:      dto.Money(receipt.NetTotal),
+		},
+	}
+}
+
+func toReceiptAdjustmentsDTO(adjustments []entities.ReceiptAdjustment) []dto.ReceiptAdjustmentOutput {
+	outputs := make([]dto.ReceiptAdjustmentOutput, 0, len(adjustments))
+	for _, adjustment := range adjustments {
+		outputs = append(outputs, dto.ReceiptAdjustmentOutput{Description: adjustment.Description, Amount: dto.Money(adjustment.Amount)})
+	}
+
+	return outputs
+}
@@ -0,0 +1,29 @@
+package mappers
+
+import (
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/dto"
+)
+
+func ToRefundsDTO(refunds []entities.Refund) []dto.RefundOutput {
+	refundOutputs := make([]dto.RefundOutput, 0, len(refunds))
+	for _, refund := range refunds {
+		refundOutputs = append(refundOutputs, ToRefundDTO(refund))
+	}
+
+	return refundOutputs
+}
+
+func ToRefundDTO(refund entities.Refund) dto.RefundOutput {
+	return dto.RefundOutput{
+		ID:                refund.ID,
+		PaymentID:         refund.PaymentID,
+		OrderID:           refund.OrderID,
+		Amount:            dto.Money(refund.Amount),
+		Reason:            refund.Reason,
+		Status:            string(refund.Status),
+		ExternalReference: refund.ExternalReference,
+		CreatedAt:         refund.CreatedAt,
+		UpdatedAt:         refund.UpdatedAt,
+	}
+}
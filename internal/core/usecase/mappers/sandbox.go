@@ -0,0 +1,23 @@
+package mappers
+
+import (
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/dto"
+)
+
+func MapPaymentsToSandboxOutput(payments []entities.Payment) []dto.SandboxPaymentOutput {
+	output := make([]dto.SandboxPaymentOutput, len(payments))
+	for i, payment := range payments {
+		output[i] = dto.SandboxPaymentOutput{
+			ID:                payment.ID,
+			OrderID:           payment.OrderID,
+			ExternalReference: payment.ExternalReference,
+			Method:            string(payment.Method),
+			Status:            string(payment.Status),
+			Amount:            dto.Money(payment.Amount),
+			CreatedAt:         payment.CreatedAt,
+		}
+	}
+
+	return output
+}
@@ -0,0 +1,16 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+)
+
+// AvailabilityRepository keeps the windows set on each product and category. Products read
+// through the ProductRepository come with the windows that apply to them.
+type AvailabilityRepository interface {
+	GetByProduct(ctx context.Context, productID int) (entities.AvailabilitySchedule, error)
+	ReplaceForProduct(ctx context.Context, productID int, schedule entities.AvailabilitySchedule) error
+	GetByCategory(ctx context.Context, categoryID int) (entities.AvailabilitySchedule, error)
+	ReplaceForCategory(ctx context.Context, categoryID int, schedule entities.AvailabilitySchedule) error
+}
@@ -0,0 +1,18 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+)
+
+type CategoryRepository interface {
+	GetAll(ctx context.Context, onlyActive bool) ([]entities.Category, error)
+	GetByID(ctx context.Context, id int) (entities.Category, error)
+	GetByName(ctx context.Context, name string) (entities.Category, error)
+	Create(ctx context.Context, category entities.Category) (entities.Category, error)
+	Update(ctx context.Context, category entities.Category) (entities.Category, error)
+	Delete(ctx context.Context, id int) error
+	// ReassignProducts moves products of the category to another one, every product when productIDs is empty.
+	ReassignProducts(ctx context.Context, fromID, toID int, productIDs []int) (int, error)
+}
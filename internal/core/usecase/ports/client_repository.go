@@ -9,4 +9,5 @@ import (
 type ClientRepository interface {
 	Create(ctx context.Context, client entities.Client) (entities.Client, error)
 	GetByCpf(ctx context.Context, cpf string) (entities.Client, error)
+	GetByID(ctx context.Context, id int) (entities.Client, error)
 }
@@ -0,0 +1,16 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+)
+
+type CouponRepository interface {
+	GetAll(ctx context.Context) ([]entities.Coupon, error)
+	GetByID(ctx context.Context, id int) (entities.Coupon, error)
+	GetByCode(ctx context.Context, code string) (entities.Coupon, error)
+	Create(ctx context.Context, coupon entities.Coupon) (entities.Coupon, error)
+	Update(ctx context.Context, coupon entities.Coupon) (entities.Coupon, error)
+	Delete(ctx context.Context, id int) error
+}
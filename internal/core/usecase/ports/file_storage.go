@@ -0,0 +1,33 @@
+package ports
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+)
+
+var ErrFileNotFound = errors.New("file not found")
+
+// StoredFile is a file read back from the storage, the caller closes Body.
+type StoredFile struct {
+	Body        io.ReadCloser
+	ContentType string
+	Size        int64
+}
+
+// FileStorage keeps uploaded files under keys like products/12/<uuid>/original.jpg. Open returns
+// ErrFileNotFound for unknown keys and Delete ignores them.
+type FileStorage interface {
+	Put(ctx context.Context, key string, contentType string, data []byte) error
+	Open(ctx context.Context, key string) (StoredFile, error)
+	Delete(ctx context.Context, key string) error
+}
+
+type ImageProcessor interface {
+	// Inspect sniffs the type and reads the dimensions of an image without decoding it whole.
+	Inspect(data []byte) (entities.ImageUpload, error)
+	// Thumbnails decodes the image once and resizes it to each size.
+	Thumbnails(image entities.ImageUpload, sizes []entities.ThumbnailSize) ([]entities.Thumbnail, error)
+}
@@ -0,0 +1,27 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+)
+
+type IngredientRepository interface {
+	GetAll(ctx context.Context) ([]entities.Ingredient, error)
+	GetByID(ctx context.Context, id int) (entities.Ingredient, error)
+	GetByName(ctx context.Context, name string) (entities.Ingredient, error)
+	Create(ctx context.Context, ingredient entities.Ingredient) (entities.Ingredient, error)
+	// Update replaces the ingredient. The unit can only change while no recipe or movement uses it.
+	Update(ctx context.Context, ingredient entities.Ingredient) (entities.Ingredient, error)
+	Delete(ctx context.Context, id int) error
+	// Receive adds a receipt to the stock of the ingredient, enabling the products it was holding back.
+	Receive(ctx context.Context, movement entities.IngredientMovement) (entities.IngredientMovement, error)
+	// Count records a physical count and sets the theoretical stock to the counted quantity.
+	Count(ctx context.Context, count entities.IngredientCount) (entities.IngredientCount, error)
+	GetMovements(ctx context.Context, ingredientID int, limit int) ([]entities.IngredientMovement, error)
+	// GetInventoryReport lists every ingredient with its last count.
+	GetInventoryReport(ctx context.Context) ([]entities.InventoryReportLine, error)
+	GetRecipe(ctx context.Context, productID int) ([]entities.RecipeItem, error)
+	// ReplaceRecipe sets the recipe of the product and whether its ingredients are available.
+	ReplaceRecipe(ctx context.Context, productID int, items []entities.RecipeItem) ([]entities.RecipeItem, error)
+}
@@ -0,0 +1,14 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+)
+
+type LoyaltyRepository interface {
+	GetByClientID(ctx context.Context, clientID int) ([]entities.LoyaltyEntry, error)
+	GetByOrderID(ctx context.Context, orderID int) ([]entities.LoyaltyEntry, error)
+	// Append adds entries to the ledger. Entries an order already has are skipped, so retries are safe.
+	Append(ctx context.Context, entries []entities.LoyaltyEntry) error
+}
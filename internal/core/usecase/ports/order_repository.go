@@ -2,6 +2,7 @@ package ports
 
 import (
 	"context"
+	"time"
 
 	sqlcDB "github.com/tupizz/restaurant-food-golang-api-fiap/database/sqlc"
 	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
@@ -19,4 +20,6 @@ type OrderRepository interface {
 	Delete(ctx context.Context, id int) error
 	GetAll(ctx context.Context, filter *OrderFilter) ([]sqlcDB.GetAllOrdersRow, error)
 	UpdateStatus(ctx context.Context, id int, status string) error
+	RetryPayment(ctx context.Context, order entities.Order, failedAfter time.Time) (entities.Order, error)
+	CancelPaymentFailedBefore(ctx context.Context, before time.Time, limit int) ([]int, error)
 }
@@ -1,7 +1,22 @@
 package ports
 
-import "github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+import (
+	"context"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+)
 
 type PaymentGateway interface {
-	Authorize(payment *entities.Payment) error
+	Authorize(ctx context.Context, payment *entities.Payment) error
+	Refund(ctx context.Context, payment *entities.Payment, refund *entities.Refund) error
+	GetStatus(ctx context.Context, payment *entities.Payment) (entities.PaymentStatus, error)
+}
+
+type PaymentGatewayResolver interface {
+	Resolve(method entities.PaymentMethod) (PaymentGateway, error)
+}
+
+// CardTokenizer exchanges card data for a token, so the card number never reaches storage.
+type CardTokenizer interface {
+	Tokenize(ctx context.Context, card entities.CardData) (entities.PaymentCard, error)
 }
@@ -0,0 +1,15 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+)
+
+// PaymentNotifier delivers a payment status notification the way the gateway does, through the webhook.
+type PaymentNotifier interface {
+	// Settle records the status on the gateway side, so status queries like the reconciler's see it
+	// even while the webhook is delayed or lost.
+	Settle(ctx context.Context, externalReference string, method entities.PaymentMethod, status entities.PaymentStatus) error
+	Notify(ctx context.Context, externalReference string, method entities.PaymentMethod, status entities.PaymentStatus) error
+}
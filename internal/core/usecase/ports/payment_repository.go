@@ -2,10 +2,12 @@ package ports
 
 import (
 	"context"
+	"time"
 
 	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
 )
 
 type PaymentRepository interface {
-	UpdateOrderPaymentStatus(ctx context.Context, externalReference string, paymentMethod string, status entities.PaymentStatus) error
+	UpdateOrderPaymentStatus(ctx context.Context, externalReference string, paymentMethod string, status entities.PaymentStatus) (int, error)
+	GetPendingCreatedBefore(ctx context.Context, createdBefore time.Time, limit int) ([]entities.Payment, error)
 }
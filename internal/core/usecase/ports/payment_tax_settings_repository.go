@@ -0,0 +1,16 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+)
+
+type PaymentTaxSettingsRepository interface {
+	GetAll(ctx context.Context) ([]entities.PaymentTaxSettings, error)
+	GetActive(ctx context.Context) ([]entities.PaymentTaxSettings, error)
+	GetByID(ctx context.Context, id int) (entities.PaymentTaxSettings, error)
+	Create(ctx context.Context, setting entities.PaymentTaxSettings) (entities.PaymentTaxSettings, error)
+	Update(ctx context.Context, setting entities.PaymentTaxSettings) (entities.PaymentTaxSettings, error)
+	Delete(ctx context.Context, id int) error
+}
@@ -0,0 +1,22 @@
+package ports
+
+import "context"
+
+// ProductCache caches product reads. Writes through the product repository invalidate it on their
+// own, writes elsewhere that change what products show, like prices, availability windows or
+// categories, must invalidate it.
+type ProductCache interface {
+	// Invalidate drops every cached read, failures are logged and left to the TTL.
+	Invalidate(ctx context.Context)
+	Stats(ctx context.Context) ProductCacheStats
+}
+
+type ProductCacheStats struct {
+	Enabled bool
+	// Version is bumped by each invalidation, reads are cached under the current one.
+	Version       int64
+	Hits          uint64
+	Misses        uint64
+	Errors        uint64
+	Invalidations uint64
+}
@@ -0,0 +1,18 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+)
+
+type ProductPriceRepository interface {
+	GetByProduct(ctx context.Context, productID int) ([]entities.ProductPrice, error)
+	// Apply changes the product price at once and records it in the history.
+	Apply(ctx context.Context, price entities.ProductPrice) (entities.ProductPrice, error)
+	Schedule(ctx context.Context, price entities.ProductPrice) (entities.ProductPrice, error)
+	Cancel(ctx context.Context, productID int, priceID int) error
+	// ApplyDue applies up to limit scheduled prices effective at or before now, in effective order.
+	ApplyDue(ctx context.Context, now time.Time, limit int) ([]entities.ProductPrice, error)
+}
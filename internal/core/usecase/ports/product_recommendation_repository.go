@@ -0,0 +1,17 @@
+package ports
+
+import (
+	"context"
+	"time"
+)
+
+type ProductRecommendationRepository interface {
+	// Rebuild replaces the pairs of products bought together with those of the orders paid in the
+	// last lookbackDays, keeping the pairs seen in at least minOrders orders. It returns how many
+	// pairs were stored.
+	Rebuild(ctx context.Context, lookbackDays int, minOrders int) (int, error)
+	// GetForProducts returns the ids of the products most often bought with the given ones, best
+	// first. The given products, deleted ones and those that cannot be ordered at availableAt, in
+	// the store timezone, are left out.
+	GetForProducts(ctx context.Context, productIDs []int, availableAt time.Time, limit int) ([]int, error)
+}
@@ -2,21 +2,58 @@ package ports
 
 import (
 	"context"
+	"time"
 
 	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
 )
 
 type ProductFilter struct {
 	Category string
-	Page     int
-	PageSize int
+	// Query searches name, category and description, ranking the best matches first.
+	Query string
+	// AvailableAt keeps only the products that can be ordered at that time, in the store timezone.
+	AvailableAt *time.Time
+	// ExcludeAllergens drops the products containing any of them
+	ExcludeAllergens []string
+	// DietaryTags keeps only the products with all of them
+	DietaryTags []string
+	// IncludeDeleted lists soft-deleted products along with the others, OnlyDeleted lists just them.
+	// Both are admin filters, the menu never sets them.
+	IncludeDeleted bool
+	OnlyDeleted    bool
+	// IncludeInactiveCategories lists the products of inactive categories too, hidden from the menu.
+	IncludeInactiveCategories bool
+	Page                      int
+	PageSize                  int
 }
 
 type ProductRepository interface {
 	GetAll(ctx context.Context, filter *ProductFilter) ([]entities.Product, int, error)
-	Create(ctx context.Context, product entities.Product) (entities.Product, error)
+	// Create and Update record price changes in the price history with changedBy as the author.
+	Create(ctx context.Context, product entities.Product, changedBy string) (entities.Product, error)
 	GetById(ctx context.Context, id int) (entities.Product, error)
+	// Delete soft deletes the product with its images and variants.
 	Delete(ctx context.Context, id int) error
-	Update(ctx context.Context, product entities.Product) (entities.Product, error)
+	// Restore undoes Delete, failing when the SKU or the category are no longer available.
+	Restore(ctx context.Context, id int) (entities.Product, error)
+	// Purge removes the product and everything that belongs to it for good, returning the images
+	// so their files can be removed. Products ever ordered cannot be purged.
+	Purge(ctx context.Context, id int) ([]entities.ProductImage, error)
+	Update(ctx context.Context, product entities.Product, changedBy string) (entities.Product, error)
+	UpdateStock(ctx context.Context, id int, stock entities.ProductStock) (entities.Product, error)
 	GetByIds(ctx context.Context, ids []int) ([]entities.Product, int, error)
+	AddImage(ctx context.Context, productID int, image entities.ProductImage) (entities.ProductImage, error)
+	GetImage(ctx context.Context, productID int, imageID int) (entities.ProductImage, error)
+	DeleteImage(ctx context.Context, productID int, imageID int) error
+	// ImportCatalog creates or updates the products by SKU in a single transaction, rolled back
+	// when dryRun is set. Stock is kept on updates and untracked on new products.
+	ImportCatalog(ctx context.Context, products []entities.Product, changedBy string, dryRun bool) (CatalogImportResult, error)
+	// LastModified is the latest change to products, categories, images, nutrition facts or
+	// variants, deleted rows included, zero when the catalog is empty.
+	LastModified(ctx context.Context) (time.Time, error)
+}
+
+type CatalogImportResult struct {
+	Created int
+	Updated int
 }
@@ -0,0 +1,14 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+)
+
+type ProductVariantRepository interface {
+	GetByProduct(ctx context.Context, productID int) ([]entities.ProductVariant, error)
+	Create(ctx context.Context, variant entities.ProductVariant) (entities.ProductVariant, error)
+	Update(ctx context.Context, variant entities.ProductVariant) (entities.ProductVariant, error)
+	Delete(ctx context.Context, productID int, variantID int) error
+}
@@ -0,0 +1,12 @@
+package ports
+
+import "github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+
+type ReceiptRenderer interface {
+	ContentType() string
+	Render(receipt entities.Receipt) ([]byte, error)
+}
+
+type ReceiptRendererResolver interface {
+	Resolve(format string) (ReceiptRenderer, error)
+}
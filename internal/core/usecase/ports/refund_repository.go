@@ -0,0 +1,14 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+)
+
+type RefundRepository interface {
+	Create(ctx context.Context, refund entities.Refund) (entities.Refund, error)
+	Update(ctx context.Context, refund entities.Refund) (entities.Refund, error)
+	GetByExternalReference(ctx context.Context, externalReference string) (entities.Refund, error)
+	GetByOrderID(ctx context.Context, orderID int) ([]entities.Refund, error)
+}
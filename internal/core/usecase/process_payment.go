@@ -39,5 +39,8 @@ func (p *processPaymentUseCase) Run(ctx context.Context, ExternalReference strin
 	}
 	defer p.redisClient.Del(ctx, lockKey)
 
-	return p.paymentRepository.UpdateOrderPaymentStatus(ctx, ExternalReference, paymentMethod, status)
+	// Failed payments leave the order in payment_failed, ExpireFailedPaymentsUseCase cancels it once the retry window ends
+	_, err = p.paymentRepository.UpdateOrderPaymentStatus(ctx, ExternalReference, paymentMethod, status)
+
+	return err
 }
@@ -0,0 +1,59 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+)
+
+type ProcessRefundUseCase interface {
+	Run(ctx context.Context, externalReference string, status entities.RefundStatus) error
+}
+
+type processRefundUseCase struct {
+	refundRepository              ports.RefundRepository
+	syncOrderLoyaltyPointsUseCase SyncOrderLoyaltyPointsUseCase
+	redisClient                   *redis.Client
+}
+
+func NewProcessRefundUseCase(refundRepository ports.RefundRepository, syncOrderLoyaltyPointsUseCase SyncOrderLoyaltyPointsUseCase, redisClient *redis.Client) ProcessRefundUseCase {
+	return &processRefundUseCase{
+		refundRepository:              refundRepository,
+		syncOrderLoyaltyPointsUseCase: syncOrderLoyaltyPointsUseCase,
+		redisClient:                   redisClient,
+	}
+}
+
+func (p *processRefundUseCase) Run(ctx context.Context, externalReference string, status entities.RefundStatus) error {
+	lockKey := fmt.Sprintf("lock:refund:%s", externalReference)
+
+	locked, err := p.redisClient.SetNX(ctx, lockKey, 1, lockTTL).Result()
+	if err != nil {
+		return fmt.Errorf("error acquiring lock: %w", err)
+	} else if !locked {
+		return fmt.Errorf("refund processing is already in progress for %s", externalReference)
+	}
+	defer p.redisClient.Del(ctx, lockKey)
+
+	refund, err := p.refundRepository.GetByExternalReference(ctx, externalReference)
+	if err != nil {
+		return err
+	}
+
+	refund.Status = status
+	if _, err = p.refundRepository.Update(ctx, refund); err != nil {
+		return err
+	}
+
+	if status == entities.RefundStatusApproved {
+		if err := p.syncOrderLoyaltyPointsUseCase.Run(ctx, refund.OrderID); err != nil {
+			slog.Error("Error reversing loyalty points", "order_id", refund.OrderID, "error", err)
+		}
+	}
+
+	return nil
+}
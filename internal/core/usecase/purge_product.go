@@ -0,0 +1,35 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+)
+
+type PurgeProductUseCase interface {
+	Run(ctx context.Context, id int) error
+}
+
+type purgeProductUseCase struct {
+	productRepository ports.ProductRepository
+	fileStorage       ports.FileStorage
+}
+
+func NewPurgeProductUseCase(productRepository ports.ProductRepository, fileStorage ports.FileStorage) PurgeProductUseCase {
+	return &purgeProductUseCase{productRepository: productRepository, fileStorage: fileStorage}
+}
+
+// Run erases a deleted product that was never ordered, then the files of its uploaded images.
+// Ordered products stay soft deleted, their orders keep pointing at them.
+func (p *purgeProductUseCase) Run(ctx context.Context, id int) error {
+	images, err := p.productRepository.Purge(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	for _, image := range images {
+		deleteStoredImageFiles(ctx, p.fileStorage, image)
+	}
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package usecase
+
+import (
+	"context"
+
+	domainError "github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/error"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/dto"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+)
+
+type ReassignCategoryProductsUseCase interface {
+	Run(ctx context.Context, categoryID int, input dto.ReassignCategoryProductsInput) (int, error)
+}
+
+type reassignCategoryProductsUseCase struct {
+	categoryRepository ports.CategoryRepository
+	productCache       ports.ProductCache
+}
+
+func NewReassignCategoryProductsUseCase(categoryRepository ports.CategoryRepository, productCache ports.ProductCache) ReassignCategoryProductsUseCase {
+	return &reassignCategoryProductsUseCase{categoryRepository: categoryRepository, productCache: productCache}
+}
+
+// Run moves products of the category to the target category and returns how many were moved.
+// Products that are not in the category are left alone.
+func (r *reassignCategoryProductsUseCase) Run(ctx context.Context, categoryID int, input dto.ReassignCategoryProductsInput) (int, error) {
+	if input.TargetCategoryID == categoryID {
+		return 0, domainError.NewEntityNotProcessableError("category", "target category must be another category")
+	}
+
+	if _, err := r.categoryRepository.GetByID(ctx, categoryID); err != nil {
+		return 0, err
+	}
+
+	moved, err := r.categoryRepository.ReassignProducts(ctx, categoryID, input.TargetCategoryID, input.ProductIDs)
+	if err != nil {
+		return 0, err
+	}
+	r.productCache.Invalidate(ctx)
+
+	return moved, nil
+}
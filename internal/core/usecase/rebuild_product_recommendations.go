@@ -0,0 +1,40 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/config"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+)
+
+type RebuildProductRecommendationsUseCase interface {
+	Run(ctx context.Context) (int, error)
+}
+
+type rebuildProductRecommendationsUseCase struct {
+	productRecommendationRepository ports.ProductRecommendationRepository
+	lookbackDays                    int
+	minOrders                       int
+}
+
+func NewRebuildProductRecommendationsUseCase(cfg *config.Config, productRecommendationRepository ports.ProductRecommendationRepository) RebuildProductRecommendationsUseCase {
+	return &rebuildProductRecommendationsUseCase{
+		productRecommendationRepository: productRecommendationRepository,
+		lookbackDays:                    cfg.Recommendations.LookbackDays,
+		minOrders:                       cfg.Recommendations.MinOrders,
+	}
+}
+
+// Run recounts the products bought together in the recent paid orders, returning how many pairs
+// can be recommended.
+func (r *rebuildProductRecommendationsUseCase) Run(ctx context.Context) (int, error) {
+	pairs, err := r.productRecommendationRepository.Rebuild(ctx, r.lookbackDays, r.minOrders)
+	if err != nil {
+		return 0, err
+	}
+
+	slog.Info("Product recommendations rebuilt", "pairs", pairs, "lookback_days", r.lookbackDays)
+
+	return pairs, nil
+}
@@ -0,0 +1,45 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+	domainError "github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/error"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/dto"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+)
+
+type ReceiveIngredientUseCase interface {
+	Run(ctx context.Context, ingredientID int, input dto.IngredientReceiptInput) (*entities.IngredientMovement, error)
+}
+
+type receiveIngredientUseCase struct {
+	ingredientRepository ports.IngredientRepository
+	productCache         ports.ProductCache
+}
+
+func NewReceiveIngredientUseCase(ingredientRepository ports.IngredientRepository, productCache ports.ProductCache) ReceiveIngredientUseCase {
+	return &receiveIngredientUseCase{ingredientRepository: ingredientRepository, productCache: productCache}
+}
+
+// Run adds the quantity received to the stock of the ingredient. Products disabled for lack of it
+// are enabled again once every ingredient of their recipe is back.
+func (r *receiveIngredientUseCase) Run(ctx context.Context, ingredientID int, input dto.IngredientReceiptInput) (*entities.IngredientMovement, error) {
+	movement := entities.IngredientMovement{
+		IngredientID: ingredientID,
+		Type:         entities.IngredientMovementReceipt,
+		Quantity:     entities.RoundIngredientQuantity(input.Quantity),
+		Description:  input.Description,
+	}
+	if movement.Quantity <= 0 {
+		return nil, domainError.NewEntityNotProcessableError("ingredient", "quantity received must be at least 0.001")
+	}
+
+	createdMovement, err := r.ingredientRepository.Receive(ctx, movement)
+	if err != nil {
+		return nil, err
+	}
+	r.productCache.Invalidate(ctx)
+
+	return &createdMovement, nil
+}
@@ -0,0 +1,131 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/config"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/dto"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+)
+
+const (
+	reconciliationLockKey    = "lock:payment-reconciliation"
+	reconciliationLockTTL    = 5 * time.Minute
+	reconciliationSummaryKey = "payment-reconciliation:last-summary"
+)
+
+type ReconcilePendingPaymentsUseCase interface {
+	Run(ctx context.Context) (*dto.PaymentReconciliationSummary, error)
+}
+
+type reconcilePendingPaymentsUseCase struct {
+	paymentRepository     ports.PaymentRepository
+	gatewayResolver       ports.PaymentGatewayResolver
+	processPaymentUseCase ProcessPaymentUseCase
+	redisClient           *redis.Client
+	pendingAge            time.Duration
+	batchSize             int
+}
+
+func NewReconcilePendingPaymentsUseCase(cfg *config.Config, paymentRepository ports.PaymentRepository, gatewayResolver ports.PaymentGatewayResolver, processPaymentUseCase ProcessPaymentUseCase, redisClient *redis.Client) ReconcilePendingPaymentsUseCase {
+	return &reconcilePendingPaymentsUseCase{
+		paymentRepository:     paymentRepository,
+		gatewayResolver:       gatewayResolver,
+		processPaymentUseCase: processPaymentUseCase,
+		redisClient:           redisClient,
+		pendingAge:            cfg.Reconciler.PendingAge,
+		batchSize:             cfg.Reconciler.BatchSize,
+	}
+}
+
+// Run asks the gateway for the current status of every payment pending for longer than the
+// configured age. Status changes are applied through ProcessPaymentUseCase, exactly like a
+// webhook would, and the resulting summary is kept in Redis for the admin API.
+func (r *reconcilePendingPaymentsUseCase) Run(ctx context.Context) (*dto.PaymentReconciliationSummary, error) {
+	locked, err := r.redisClient.SetNX(ctx, reconciliationLockKey, 1, reconciliationLockTTL).Result()
+	if err != nil {
+		return nil, fmt.Errorf("error acquiring lock: %w", err)
+	} else if !locked {
+		return nil, fmt.Errorf("payment reconciliation is already in progress")
+	}
+	defer r.redisClient.Del(ctx, reconciliationLockKey)
+
+	summary := dto.PaymentReconciliationSummary{
+		StartedAt:     time.Now(),
+		Discrepancies: make([]dto.PaymentDiscrepancy, 0),
+	}
+
+	payments, err := r.paymentRepository.GetPendingCreatedBefore(ctx, time.Now().Add(-r.pendingAge), r.batchSize)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, payment := range payments {
+		summary.Checked++
+
+		discrepancy, err := r.reconcile(ctx, payment)
+		if err != nil {
+			summary.Errors++
+			slog.Error("Unable to reconcile payment", "payment_id", payment.ID, "external_reference", payment.ExternalReference, "error", err)
+			discrepancy.Error = err.Error()
+			summary.Discrepancies = append(summary.Discrepancies, discrepancy)
+			continue
+		}
+
+		switch entities.PaymentStatus(discrepancy.GatewayStatus) {
+		case entities.PaymentStatusApproved:
+			summary.Approved++
+		case entities.PaymentStatusFailed:
+			summary.Failed++
+		default:
+			summary.StillPending++
+			continue
+		}
+
+		slog.Warn("Payment status diverged from gateway", "payment_id", payment.ID, "order_id", payment.OrderID, "local_status", discrepancy.LocalStatus, "gateway_status", discrepancy.GatewayStatus)
+		summary.Discrepancies = append(summary.Discrepancies, discrepancy)
+	}
+
+	summary.FinishedAt = time.Now()
+
+	slog.Info("Payment reconciliation finished", "checked", summary.Checked, "approved", summary.Approved, "failed", summary.Failed, "still_pending", summary.StillPending, "errors", summary.Errors)
+
+	if encoded, err := json.Marshal(summary); err == nil {
+		_ = r.redisClient.Set(ctx, reconciliationSummaryKey, encoded, 0).Err()
+	}
+
+	return &summary, nil
+}
+
+func (r *reconcilePendingPaymentsUseCase) reconcile(ctx context.Context, payment entities.Payment) (dto.PaymentDiscrepancy, error) {
+	discrepancy := dto.PaymentDiscrepancy{
+		PaymentID:         payment.ID,
+		OrderID:           payment.OrderID,
+		ExternalReference: payment.ExternalReference,
+		Method:            string(payment.Method),
+		LocalStatus:       string(payment.Status),
+	}
+
+	paymentGateway, err := r.gatewayResolver.Resolve(payment.Method)
+	if err != nil {
+		return discrepancy, err
+	}
+
+	gatewayStatus, err := paymentGateway.GetStatus(ctx, &payment)
+	if err != nil {
+		return discrepancy, err
+	}
+	discrepancy.GatewayStatus = string(gatewayStatus)
+
+	if gatewayStatus == entities.PaymentStatusApproved || gatewayStatus == entities.PaymentStatusFailed {
+		err = r.processPaymentUseCase.Run(ctx, payment.ExternalReference, string(payment.Method), gatewayStatus)
+	}
+
+	return discrepancy, err
+}
@@ -0,0 +1,357 @@
+package usecase
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	gateways "github.com/tupizz/restaurant-food-golang-api-fiap/internal/adapters/gateways/payment"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/config"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/dto"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+)
+
+func TestReconcilePendingPaymentsAppliesGatewayStatuses(t *testing.T) {
+	env := newReconciliationEnv(t)
+	approved := env.addPayment(t, 1, entities.PaymentMethodQRCode)
+	failed := env.addPayment(t, 2, entities.PaymentMethodCreditCard)
+	pending := env.addPayment(t, 3, entities.PaymentMethodQRCode)
+
+	env.gateway.SetStatus(approved, entities.PaymentStatusApproved)
+	env.gateway.SetStatus(failed, entities.PaymentStatusFailed)
+
+	summary, err := env.reconciler.Run(context.Background())
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	assertSummaryCounts(t, summary, 3, 1, 1, 1, 0)
+	assertOrderStatus(t, env.payments, 1, entities.OrderStatusPreparing)
+	assertOrderStatus(t, env.payments, 2, entities.OrderStatusPaymentFailed)
+	assertOrderStatus(t, env.payments, 3, entities.OrderStatusPending)
+
+	if len(summary.Discrepancies) != 2 {
+		t.Fatalf("discrepancies = %d, want 2", len(summary.Discrepancies))
+	}
+	for _, discrepancy := range summary.Discrepancies {
+		if discrepancy.LocalStatus != string(entities.PaymentStatusPending) || discrepancy.ExternalReference == pending {
+			t.Errorf("unexpected discrepancy %+v", discrepancy)
+		}
+	}
+
+	stored := env.storedSummary(t)
+	assertSummaryCounts(t, stored, 3, 1, 1, 1, 0)
+
+	// Settled payments are no longer pending, the next run only checks the one left
+	summary, err = env.reconciler.Run(context.Background())
+	if err != nil {
+		t.Fatalf("second Run() error = %v", err)
+	}
+	assertSummaryCounts(t, summary, 1, 0, 0, 1, 0)
+}
+
+func TestReconcilePendingPaymentsReportsGatewayErrors(t *testing.T) {
+	env := newReconciliationEnv(t)
+	unreachable := env.addPayment(t, 1, entities.PaymentMethodCreditCard)
+	approved := env.addPayment(t, 2, entities.PaymentMethodQRCode)
+
+	env.gateway.SetError(unreachable, errors.New("connection refused"))
+	env.gateway.SetStatus(approved, entities.PaymentStatusApproved)
+
+	summary, err := env.reconciler.Run(context.Background())
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	// One unreachable payment does not stop the others from being reconciled
+	assertSummaryCounts(t, summary, 2, 1, 0, 0, 1)
+	assertOrderStatus(t, env.payments, 1, entities.OrderStatusPending)
+	assertOrderStatus(t, env.payments, 2, entities.OrderStatusPreparing)
+
+	var reported *dto.PaymentDiscrepancy
+	for i, discrepancy := range summary.Discrepancies {
+		if discrepancy.ExternalReference == unreachable {
+			reported = &summary.Discrepancies[i]
+		}
+	}
+	if reported == nil || !strings.Contains(reported.Error, "connection refused") {
+		t.Fatalf("discrepancy of the unreachable payment = %+v, want its error", reported)
+	}
+
+	stored := env.storedSummary(t)
+	assertSummaryCounts(t, stored, 2, 1, 0, 0, 1)
+}
+
+type reconciliationEnv struct {
+	gateway    *gateways.LocalStandInGateway
+	resolver   ports.PaymentGatewayResolver
+	payments   *inMemoryPaymentRepository
+	reconciler ReconcilePendingPaymentsUseCase
+	summary    GetPaymentReconciliationSummaryUseCase
+}
+
+func newReconciliationEnv(t *testing.T) *reconciliationEnv {
+	t.Helper()
+
+	redisClient := redis.NewClient(&redis.Options{Addr: startFakeRedis(t), DisableIndentity: true})
+	t.Cleanup(func() { redisClient.Close() })
+
+	cfg := &config.Config{
+		PaymentGateway: config.PaymentGateway{
+			Timeout:                 time.Second,
+			MaxAttempts:             1,
+			BreakerFailureThreshold: 100,
+			BreakerOpenTimeout:      time.Second,
+		},
+		Reconciler: config.Reconciler{PendingAge: 0, BatchSize: 100},
+	}
+
+	gateway := gateways.NewLocalStandInGateway()
+	payments := &inMemoryPaymentRepository{payments: make(map[string]*entities.Payment), orders: make(map[int]entities.OrderStatus)}
+	resolver := gateways.NewLocalGatewayResolver(gateway, cfg.PaymentGateway)
+	processPayment := NewProcessPaymentUseCase(payments, redisClient)
+
+	return &reconciliationEnv{
+		gateway:    gateway,
+		resolver:   resolver,
+		payments:   payments,
+		reconciler: NewReconcilePendingPaymentsUseCase(cfg, payments, resolver, processPayment, redisClient),
+		summary:    NewGetPaymentReconciliationSummaryUseCase(redisClient),
+	}
+}
+
+// addPayment authorizes a pending payment of a new order on the stand-in, returning its external reference.
+func (e *reconciliationEnv) addPayment(t *testing.T, orderID int, method entities.PaymentMethod) string {
+	t.Helper()
+
+	gateway, err := e.resolver.Resolve(method)
+	if err != nil {
+		t.Fatalf("Resolve(%s) error = %v", method, err)
+	}
+
+	payment := &entities.Payment{ID: orderID, OrderID: orderID, Method: method, Status: entities.PaymentStatusPending}
+	if err := gateway.Authorize(context.Background(), payment); err != nil {
+		t.Fatalf("Authorize() error = %v", err)
+	}
+	payment.CreatedAt = time.Now().Add(-time.Minute)
+
+	e.payments.add(payment)
+
+	return payment.ExternalReference
+}
+
+func (e *reconciliationEnv) storedSummary(t *testing.T) *dto.PaymentReconciliationSummary {
+	t.Helper()
+
+	summary, err := e.summary.Run(context.Background())
+	if err != nil {
+		t.Fatalf("stored summary error = %v", err)
+	}
+
+	return summary
+}
+
+func assertSummaryCounts(t *testing.T, summary *dto.PaymentReconciliationSummary, checked, approved, failed, stillPending, errors int) {
+	t.Helper()
+
+	got := [5]int{summary.Checked, summary.Approved, summary.Failed, summary.StillPending, summary.Errors}
+	want := [5]int{checked, approved, failed, stillPending, errors}
+	if got != want {
+		t.Errorf("summary checked/approved/failed/still_pending/errors = %v, want %v", got, want)
+	}
+}
+
+func assertOrderStatus(t *testing.T, payments *inMemoryPaymentRepository, orderID int, want entities.OrderStatus) {
+	t.Helper()
+
+	if got := payments.orderStatus(orderID); got != want {
+		t.Errorf("order %d status = %s, want %s", orderID, got, want)
+	}
+}
+
+// inMemoryPaymentRepository settles payments with the rules of the database repository: only
+// pending payments change, approving one starts the order and a failure opens the retry window,
+// as long as the order still waits for a payment.
+type inMemoryPaymentRepository struct {
+	mu       sync.Mutex
+	payments map[string]*entities.Payment
+	orders   map[int]entities.OrderStatus
+}
+
+func (r *inMemoryPaymentRepository) add(payment *entities.Payment) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.payments[payment.ExternalReference] = payment
+	r.orders[payment.OrderID] = entities.OrderStatusPending
+}
+
+func (r *inMemoryPaymentRepository) orderStatus(orderID int) entities.OrderStatus {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.orders[orderID]
+}
+
+func (r *inMemoryPaymentRepository) UpdateOrderPaymentStatus(ctx context.Context, externalReference string, paymentMethod string, status entities.PaymentStatus) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	payment, ok := r.payments[externalReference]
+	if !ok || string(payment.Method) != paymentMethod {
+		return 0, fmt.Errorf("payment %s not found", externalReference)
+	}
+
+	if payment.Status != entities.PaymentStatusPending {
+		return payment.OrderID, nil
+	}
+	payment.Status = status
+
+	if order := r.orders[payment.OrderID]; order != entities.OrderStatusPending && order != entities.OrderStatusPaymentFailed {
+		return payment.OrderID, nil
+	}
+
+	if status == entities.PaymentStatusApproved {
+		r.orders[payment.OrderID] = entities.OrderStatusPreparing
+	} else {
+		r.orders[payment.OrderID] = entities.OrderStatusPaymentFailed
+	}
+
+	return payment.OrderID, nil
+}
+
+func (r *inMemoryPaymentRepository) GetPendingCreatedBefore(ctx context.Context, createdBefore time.Time, limit int) ([]entities.Payment, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var pending []entities.Payment
+	for _, payment := range r.payments {
+		if payment.Status == entities.PaymentStatusPending && payment.CreatedAt.Before(createdBefore) && len(pending) < limit {
+			pending = append(pending, *payment)
+		}
+	}
+
+	return pending, nil
+}
+
+// startFakeRedis serves the few commands the use cases send, SET (with NX and expiry), GET and
+// DEL, so the tests need no Redis server. Expiries are ignored, the tests are shorter than them.
+func startFakeRedis(t *testing.T) string {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	t.Cleanup(func() { listener.Close() })
+
+	var mu sync.Mutex
+	values := make(map[string]string)
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+
+			go func() {
+				defer conn.Close()
+				reader := bufio.NewReader(conn)
+				for {
+					args, err := readRESPCommand(reader)
+					if err != nil {
+						return
+					}
+
+					mu.Lock()
+					reply := fakeRedisReply(values, args)
+					mu.Unlock()
+
+					if _, err := io.WriteString(conn, reply); err != nil {
+						return
+					}
+				}
+			}()
+		}
+	}()
+
+	return listener.Addr().String()
+}
+
+func fakeRedisReply(values map[string]string, args []string) string {
+	switch strings.ToUpper(args[0]) {
+	case "PING":
+		return "+PONG\r\n"
+	case "SET":
+		nx := false
+		for _, option := range args[3:] {
+			nx = nx || strings.EqualFold(option, "NX")
+		}
+		if _, exists := values[args[1]]; nx && exists {
+			return "$-1\r\n"
+		}
+		values[args[1]] = args[2]
+		return "+OK\r\n"
+	case "GET":
+		value, ok := values[args[1]]
+		if !ok {
+			return "$-1\r\n"
+		}
+		return "$" + strconv.Itoa(len(value)) + "\r\n" + value + "\r\n"
+	case "DEL":
+		deleted := 0
+		for _, key := range args[1:] {
+			if _, ok := values[key]; ok {
+				delete(values, key)
+				deleted++
+			}
+		}
+		return ":" + strconv.Itoa(deleted) + "\r\n"
+	default:
+		return "-ERR unknown command '" + args[0] + "'\r\n"
+	}
+}
+
+func readRESPCommand(reader *bufio.Reader) ([]string, error) {
+	header, err := reader.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := strconv.Atoi(strings.TrimSpace(strings.TrimPrefix(header, "*")))
+	if err != nil || count < 1 {
+		return nil, fmt.Errorf("unexpected command header %q", header)
+	}
+
+	args := make([]string, 0, count)
+	for i := 0; i < count; i++ {
+		lengthLine, err := reader.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+
+		length, err := strconv.Atoi(strings.TrimSpace(strings.TrimPrefix(lengthLine, "$")))
+		if err != nil {
+			return nil, fmt.Errorf("unexpected bulk header %q", lengthLine)
+		}
+
+		value := make([]byte, length+2)
+		if _, err := io.ReadFull(reader, value); err != nil {
+			return nil, err
+		}
+		args = append(args, string(value[:length]))
+	}
+
+	return args, nil
+}
@@ -0,0 +1,30 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+)
+
+type RestoreProductUseCase interface {
+	Run(ctx context.Context, id int) (*entities.Product, error)
+}
+
+type restoreProductUseCase struct {
+	productRepository ports.ProductRepository
+}
+
+func NewRestoreProductUseCase(productRepository ports.ProductRepository) RestoreProductUseCase {
+	return &restoreProductUseCase{productRepository: productRepository}
+}
+
+// Run puts a deleted product back on the menu, with the images and variants it had when deleted.
+func (r *restoreProductUseCase) Run(ctx context.Context, id int) (*entities.Product, error) {
+	product, err := r.productRepository.Restore(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &product, nil
+}
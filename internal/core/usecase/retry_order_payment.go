@@ -0,0 +1,85 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/config"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+	domainError "github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/error"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+)
+
+type RetryOrderPaymentUseCase interface {
+	Run(ctx context.Context, orderID int, payment entities.Payment) (*entities.Order, error)
+}
+
+type retryOrderPaymentUseCase struct {
+	orderRepository              ports.OrderRepository
+	paymentTaxSettingsRepository ports.PaymentTaxSettingsRepository
+	gatewayResolver              ports.PaymentGatewayResolver
+	cardTokenizer                ports.CardTokenizer
+	window                       time.Duration
+}
+
+func NewRetryOrderPaymentUseCase(cfg *config.Config, orderRepository ports.OrderRepository, paymentTaxSettingsRepository ports.PaymentTaxSettingsRepository, gatewayResolver ports.PaymentGatewayResolver, cardTokenizer ports.CardTokenizer) RetryOrderPaymentUseCase {
+	return &retryOrderPaymentUseCase{
+		orderRepository:              orderRepository,
+		paymentTaxSettingsRepository: paymentTaxSettingsRepository,
+		gatewayResolver:              gatewayResolver,
+		cardTokenizer:                cardTokenizer,
+		window:                       cfg.PaymentRetry.Window,
+	}
+}
+
+// Run pays an order whose payment failed again, with the same or another method. Items and
+// discounts are kept, fees are charged again since they depend on the payment method.
+func (r *retryOrderPaymentUseCase) Run(ctx context.Context, orderID int, payment entities.Payment) (*entities.Order, error) {
+	order, err := r.orderRepository.GetByID(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if err := order.CheckPaymentRetry(now, r.window); err != nil {
+		return nil, domainError.NewEntityNotProcessableError("order", err.Error())
+	}
+
+	paymentTaxes, err := r.paymentTaxSettingsRepository.GetActive(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	order.Payment = entities.Payment{
+		OrderID:  order.ID,
+		Status:   entities.PaymentStatusPending,
+		Method:   payment.Method,
+		CardData: payment.CardData,
+	}
+	order.ApplyFees(paymentTaxes)
+
+	paymentGateway, err := r.gatewayResolver.Resolve(order.Payment.Method)
+	if err != nil {
+		return nil, domainError.NewEntityNotProcessableError("payment", err.Error())
+	}
+
+	if err := tokenizeCard(ctx, r.cardTokenizer, &order.Payment); err != nil {
+		return nil, err
+	}
+
+	err = paymentGateway.Authorize(ctx, &order.Payment)
+	if errors.Is(err, &domainError.ServiceUnavailableError{}) || errors.Is(err, &domainError.PaymentDeclinedError{}) {
+		return nil, err
+	} else if err != nil {
+		return nil, domainError.NewEntityNotProcessableError("payment", err.Error())
+	}
+
+	updatedOrder, err := r.orderRepository.RetryPayment(ctx, order, now.Add(-r.window))
+	if err != nil {
+		voidAuthorization(ctx, paymentGateway, &order.Payment)
+		return nil, err
+	}
+
+	return &updatedOrder, nil
+}
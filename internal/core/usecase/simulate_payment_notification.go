@@ -0,0 +1,71 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/dto"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+)
+
+type SimulatePaymentNotificationUseCase interface {
+	Run(ctx context.Context, externalReference string, input dto.SandboxNotificationInput) error
+}
+
+type simulatePaymentNotificationUseCase struct {
+	paymentNotifier ports.PaymentNotifier
+}
+
+func NewSimulatePaymentNotificationUseCase(paymentNotifier ports.PaymentNotifier) SimulatePaymentNotificationUseCase {
+	return &simulatePaymentNotificationUseCase{paymentNotifier: paymentNotifier}
+}
+
+// Run settles the payment on the gateway side at once, then delivers its webhook right away and
+// returns the delivery errors. Delayed notifications are scheduled instead and their outcome is
+// only logged, the request that asked for them is long gone when they are sent. Meanwhile the
+// reconciler can already find the settled status.
+func (s *simulatePaymentNotificationUseCase) Run(ctx context.Context, externalReference string, input dto.SandboxNotificationInput) error {
+	method, status := entities.PaymentMethod(input.PaymentMethod), entities.PaymentStatus(input.Status)
+	if err := s.paymentNotifier.Settle(ctx, externalReference, method, status); err != nil {
+		return err
+	}
+
+	if input.DelaySeconds == 0 {
+		return s.deliver(ctx, externalReference, input)
+	}
+
+	ctx = context.WithoutCancel(ctx)
+	time.AfterFunc(time.Duration(input.DelaySeconds)*time.Second, func() {
+		_ = s.deliver(ctx, externalReference, input)
+	})
+
+	return nil
+}
+
+// deliver sends the notification and its duplicates concurrently, so they race each other in the
+// webhook like repeated gateway deliveries do.
+func (s *simulatePaymentNotificationUseCase) deliver(ctx context.Context, externalReference string, input dto.SandboxNotificationInput) error {
+	copies := input.Duplicates + 1
+	errs := make([]error, copies)
+
+	var wg sync.WaitGroup
+	for idx := range copies {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[idx] = s.paymentNotifier.Notify(ctx, externalReference, entities.PaymentMethod(input.PaymentMethod), entities.PaymentStatus(input.Status))
+			if errs[idx] != nil {
+				slog.Warn("Sandbox webhook delivery failed", "externalReference", externalReference, "status", input.Status, "copy", idx+1, "error", errs[idx])
+			} else {
+				slog.Info("Sandbox webhook delivered", "externalReference", externalReference, "status", input.Status, "copy", idx+1)
+			}
+		}()
+	}
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
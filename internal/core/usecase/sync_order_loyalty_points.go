@@ -0,0 +1,65 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/config"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+)
+
+type SyncOrderLoyaltyPointsUseCase interface {
+	Run(ctx context.Context, orderID int) error
+}
+
+type syncOrderLoyaltyPointsUseCase struct {
+	orderRepository   ports.OrderRepository
+	loyaltyRepository ports.LoyaltyRepository
+	redisClient       *redis.Client
+	program           entities.LoyaltyProgram
+}
+
+func NewSyncOrderLoyaltyPointsUseCase(cfg *config.Config, orderRepository ports.OrderRepository, loyaltyRepository ports.LoyaltyRepository, redisClient *redis.Client) SyncOrderLoyaltyPointsUseCase {
+	return &syncOrderLoyaltyPointsUseCase{
+		orderRepository:   orderRepository,
+		loyaltyRepository: loyaltyRepository,
+		redisClient:       redisClient,
+		program:           loyaltyProgram(cfg),
+	}
+}
+
+// Run posts the ledger entries the order is still missing: points earned on delivery, reversals
+// after refunds and the return of redeemed points when the order is canceled. It is idempotent,
+// so it is called after every order, payment and refund change.
+func (s *syncOrderLoyaltyPointsUseCase) Run(ctx context.Context, orderID int) error {
+	lockKey := fmt.Sprintf("lock:loyalty:order:%d", orderID)
+
+	locked, err := s.redisClient.SetNX(ctx, lockKey, 1, lockTTL).Result()
+	if err != nil {
+		return fmt.Errorf("error acquiring lock: %w", err)
+	} else if !locked {
+		return fmt.Errorf("loyalty points are already being updated for order %d", orderID)
+	}
+	defer s.redisClient.Del(ctx, lockKey)
+
+	order, err := s.orderRepository.GetByID(ctx, orderID)
+	if err != nil {
+		return err
+	}
+
+	posted, err := s.loyaltyRepository.GetByOrderID(ctx, orderID)
+	if err != nil {
+		return err
+	}
+
+	return s.loyaltyRepository.Append(ctx, s.program.PendingEntries(order, posted))
+}
+
+func loyaltyProgram(cfg *config.Config) entities.LoyaltyProgram {
+	return entities.LoyaltyProgram{
+		PointsPerCurrencyUnit: cfg.Loyalty.PointsPerCurrencyUnit,
+		PointValue:            entities.NewMoney(cfg.Loyalty.PointValueCents),
+	}
+}
@@ -0,0 +1,40 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/dto"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/mappers"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+)
+
+type UpdateCategoryUseCase interface {
+	Run(ctx context.Context, id int, input dto.CategoryInput) (*entities.Category, error)
+}
+
+type updateCategoryUseCase struct {
+	categoryRepository ports.CategoryRepository
+	productCache       ports.ProductCache
+}
+
+func NewUpdateCategoryUseCase(categoryRepository ports.CategoryRepository, productCache ports.ProductCache) UpdateCategoryUseCase {
+	return &updateCategoryUseCase{categoryRepository: categoryRepository, productCache: productCache}
+}
+
+// Run replaces the category. Its handle is kept when the name changes.
+func (u *updateCategoryUseCase) Run(ctx context.Context, id int, input dto.CategoryInput) (*entities.Category, error) {
+	category := mappers.MapCategoryInputToEntity(id, input)
+	if err := validateCategory(ctx, u.categoryRepository, category); err != nil {
+		return nil, err
+	}
+
+	updatedCategory, err := u.categoryRepository.Update(ctx, category)
+	if err != nil {
+		return nil, err
+	}
+	// Products show the name of their category
+	u.productCache.Invalidate(ctx)
+
+	return &updatedCategory, nil
+}
@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+	domainError "github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/error"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/dto"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/mappers"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+)
+
+type UpdateCategoryAvailabilityUseCase interface {
+	Run(ctx context.Context, categoryID int, input dto.AvailabilityInput) (entities.AvailabilitySchedule, error)
+}
+
+type updateCategoryAvailabilityUseCase struct {
+	availabilityRepository ports.AvailabilityRepository
+	productCache           ports.ProductCache
+}
+
+func NewUpdateCategoryAvailabilityUseCase(availabilityRepository ports.AvailabilityRepository, productCache ports.ProductCache) UpdateCategoryAvailabilityUseCase {
+	return &updateCategoryAvailabilityUseCase{availabilityRepository: availabilityRepository, productCache: productCache}
+}
+
+// Run replaces the windows of the category, followed by its products that have none of their own.
+func (u *updateCategoryAvailabilityUseCase) Run(ctx context.Context, categoryID int, input dto.AvailabilityInput) (entities.AvailabilitySchedule, error) {
+	schedule, err := mappers.MapAvailabilityInputToEntity(input)
+	if err != nil {
+		return nil, domainError.NewEntityNotProcessableError("availability", err.Error())
+	}
+
+	if err := schedule.Validate(); err != nil {
+		return nil, domainError.NewEntityNotProcessableError("availability", err.Error())
+	}
+
+	if err := u.availabilityRepository.ReplaceForCategory(ctx, categoryID, schedule); err != nil {
+		return nil, err
+	}
+	u.productCache.Invalidate(ctx)
+
+	return u.availabilityRepository.GetByCategory(ctx, categoryID)
+}
@@ -0,0 +1,37 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/dto"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/mappers"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+)
+
+type UpdateCouponUseCase interface {
+	Run(ctx context.Context, id int, input dto.CouponInput) (*entities.Coupon, error)
+}
+
+type updateCouponUseCase struct {
+	couponRepository ports.CouponRepository
+}
+
+func NewUpdateCouponUseCase(couponRepository ports.CouponRepository) UpdateCouponUseCase {
+	return &updateCouponUseCase{couponRepository: couponRepository}
+}
+
+// Run replaces the coupon. Orders already placed are not affected, they keep their discount snapshot.
+func (u *updateCouponUseCase) Run(ctx context.Context, id int, input dto.CouponInput) (*entities.Coupon, error) {
+	coupon := mappers.MapCouponInputToEntity(id, input)
+	if err := validateCoupon(ctx, u.couponRepository, coupon); err != nil {
+		return nil, err
+	}
+
+	updatedCoupon, err := u.couponRepository.Update(ctx, coupon)
+	if err != nil {
+		return nil, err
+	}
+
+	return &updatedCoupon, nil
+}
@@ -0,0 +1,38 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/dto"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/mappers"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+)
+
+type UpdateIngredientUseCase interface {
+	Run(ctx context.Context, id int, input dto.IngredientInput) (*entities.Ingredient, error)
+}
+
+type updateIngredientUseCase struct {
+	ingredientRepository ports.IngredientRepository
+}
+
+func NewUpdateIngredientUseCase(ingredientRepository ports.IngredientRepository) UpdateIngredientUseCase {
+	return &updateIngredientUseCase{ingredientRepository: ingredientRepository}
+}
+
+// Run replaces the name and unit of the ingredient, stock only changes through receipts,
+// consumption and counts.
+func (u *updateIngredientUseCase) Run(ctx context.Context, id int, input dto.IngredientInput) (*entities.Ingredient, error) {
+	ingredient := mappers.MapIngredientInputToEntity(id, input)
+	if err := validateIngredient(ctx, u.ingredientRepository, ingredient); err != nil {
+		return nil, err
+	}
+
+	updatedIngredient, err := u.ingredientRepository.Update(ctx, ingredient)
+	if err != nil {
+		return nil, err
+	}
+
+	return &updatedIngredient, nil
+}
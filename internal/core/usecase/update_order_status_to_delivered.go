@@ -3,6 +3,7 @@ package usecase
 import (
 	"context"
 	"errors"
+	"log/slog"
 
 	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
 	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
@@ -13,11 +14,12 @@ type UpdateOrderStatusToDeliveredUseCase interface {
 }
 
 type updateOrderStatusToDeliveredUseCase struct {
-	orderRepository ports.OrderRepository
+	orderRepository               ports.OrderRepository
+	syncOrderLoyaltyPointsUseCase SyncOrderLoyaltyPointsUseCase
 }
 
-func NewUpdateOrderStatusToDeliveredUseCase(orderRepository ports.OrderRepository) UpdateOrderStatusToDeliveredUseCase {
-	return &updateOrderStatusToDeliveredUseCase{orderRepository: orderRepository}
+func NewUpdateOrderStatusToDeliveredUseCase(orderRepository ports.OrderRepository, syncOrderLoyaltyPointsUseCase SyncOrderLoyaltyPointsUseCase) UpdateOrderStatusToDeliveredUseCase {
+	return &updateOrderStatusToDeliveredUseCase{orderRepository: orderRepository, syncOrderLoyaltyPointsUseCase: syncOrderLoyaltyPointsUseCase}
 }
 
 func (c *updateOrderStatusToDeliveredUseCase) Run(ctx context.Context, id int) error {
@@ -30,5 +32,14 @@ func (c *updateOrderStatusToDeliveredUseCase) Run(ctx context.Context, id int) e
 		return errors.New("order status is not ready")
 	}
 
-	return c.orderRepository.UpdateStatus(ctx, id, "delivered")
+	if err := c.orderRepository.UpdateStatus(ctx, id, "delivered"); err != nil {
+		return err
+	}
+
+	// The order is delivered even if crediting points fails, the next sync posts them
+	if err := c.syncOrderLoyaltyPointsUseCase.Run(ctx, id); err != nil {
+		slog.Error("Error crediting loyalty points", "order_id", id, "error", err)
+	}
+
+	return nil
 }
@@ -0,0 +1,38 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+	domainError "github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/error"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/dto"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/mappers"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+)
+
+type UpdatePaymentTaxSettingUseCase interface {
+	Run(ctx context.Context, id int, input dto.PaymentTaxSettingInput) (*entities.PaymentTaxSettings, error)
+}
+
+type updatePaymentTaxSettingUseCase struct {
+	paymentTaxSettingsRepository ports.PaymentTaxSettingsRepository
+}
+
+func NewUpdatePaymentTaxSettingUseCase(paymentTaxSettingsRepository ports.PaymentTaxSettingsRepository) UpdatePaymentTaxSettingUseCase {
+	return &updatePaymentTaxSettingUseCase{paymentTaxSettingsRepository: paymentTaxSettingsRepository}
+}
+
+// Run replaces the setting. Orders already placed are not affected, they keep their fee snapshot.
+func (u *updatePaymentTaxSettingUseCase) Run(ctx context.Context, id int, input dto.PaymentTaxSettingInput) (*entities.PaymentTaxSettings, error) {
+	setting := mappers.MapPaymentTaxSettingInputToEntity(id, input)
+	if err := setting.Validate(); err != nil {
+		return nil, domainError.NewEntityNotProcessableError("payment tax setting", err.Error())
+	}
+
+	updatedSetting, err := u.paymentTaxSettingsRepository.Update(ctx, setting)
+	if err != nil {
+		return nil, err
+	}
+
+	return &updatedSetting, nil
+}
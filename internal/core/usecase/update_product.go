@@ -2,14 +2,17 @@ package usecase
 
 import (
 	"context"
+	"strings"
 
 	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+	domainError "github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/error"
 	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/dto"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/mappers"
 	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
 )
 
 type UpdateProductUseCase interface {
-	Run(ctx context.Context, id int, input dto.ProductInputUpdate) (*entities.Product, error)
+	Run(ctx context.Context, id int, input dto.ProductInputUpdate, changedBy string) (*entities.Product, error)
 }
 
 type updateProductUseCase struct {
@@ -20,7 +23,9 @@ func NewUpdateProductUseCase(productRepository ports.ProductRepository) UpdatePr
 	return &updateProductUseCase{productRepository: productRepository}
 }
 
-func (c *updateProductUseCase) Run(ctx context.Context, id int, input dto.ProductInputUpdate) (*entities.Product, error) {
+// Run changes the informed fields, a new price goes live at once and is recorded in the price
+// history with changedBy as its author.
+func (c *updateProductUseCase) Run(ctx context.Context, id int, input dto.ProductInputUpdate, changedBy string) (*entities.Product, error) {
 	images := make([]entities.ProductImage, 0, len(input.Images))
 	for _, image := range input.Images {
 		images = append(images, entities.ProductImage{
@@ -30,19 +35,60 @@ func (c *updateProductUseCase) Run(ctx context.Context, id int, input dto.Produc
 
 	product := entities.Product{
 		ID:          id,
+		SKU:         strings.TrimSpace(input.SKU),
 		Name:        input.Name,
 		Description: input.Description,
 		Price:       input.Price,
 		Category: entities.ProductCategory{
 			Handle: input.Category,
 		},
-		Images: images,
+		Images:      images,
+		Allergens:   mappers.MapAllergens(input.Allergens),
+		DietaryTags: mappers.MapDietaryTags(input.DietaryTags),
+	}
+	if input.Nutrition != nil {
+		nutrition := mappers.MapNutritionInputToEntity(*input.Nutrition)
+		product.Nutrition = &nutrition
+	}
+
+	if err := c.validateDietaryInformation(ctx, product); err != nil {
+		return nil, err
 	}
 
-	updatedProduct, err := c.productRepository.Update(ctx, product)
+	updatedProduct, err := c.productRepository.Update(ctx, product, changedBy)
 	if err != nil {
 		return nil, err
 	}
 
 	return &updatedProduct, nil
 }
+
+// validateDietaryInformation checks the informed allergens and dietary tags together with the
+// stored ones they are kept with, so a vegan tag cannot be added to a product with milk.
+func (c *updateProductUseCase) validateDietaryInformation(ctx context.Context, product entities.Product) error {
+	informed := product.Allergens != nil || product.DietaryTags != nil
+	if informed && (product.Allergens == nil || product.DietaryTags == nil) {
+		current, err := c.productRepository.GetById(ctx, product.ID)
+		if err != nil {
+			return err
+		}
+
+		if current.ID == 0 {
+			return domainError.ErrNotFound("product")
+		}
+
+		if product.Allergens == nil {
+			product.Allergens = current.Allergens
+		}
+
+		if product.DietaryTags == nil {
+			product.DietaryTags = current.DietaryTags
+		}
+	}
+
+	if err := product.ValidateDietaryInformation(); err != nil {
+		return domainError.NewEntityNotProcessableError("product", err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+	domainError "github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/error"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/dto"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/mappers"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+)
+
+type UpdateProductAvailabilityUseCase interface {
+	Run(ctx context.Context, productID int, input dto.AvailabilityInput) (entities.AvailabilitySchedule, error)
+}
+
+type updateProductAvailabilityUseCase struct {
+	availabilityRepository ports.AvailabilityRepository
+	productCache           ports.ProductCache
+}
+
+func NewUpdateProductAvailabilityUseCase(availabilityRepository ports.AvailabilityRepository, productCache ports.ProductCache) UpdateProductAvailabilityUseCase {
+	return &updateProductAvailabilityUseCase{availabilityRepository: availabilityRepository, productCache: productCache}
+}
+
+// Run replaces the windows of the product, without any the product follows those of its category.
+func (u *updateProductAvailabilityUseCase) Run(ctx context.Context, productID int, input dto.AvailabilityInput) (entities.AvailabilitySchedule, error) {
+	schedule, err := mappers.MapAvailabilityInputToEntity(input)
+	if err != nil {
+		return nil, domainError.NewEntityNotProcessableError("availability", err.Error())
+	}
+
+	if err := schedule.Validate(); err != nil {
+		return nil, domainError.NewEntityNotProcessableError("availability", err.Error())
+	}
+
+	if err := u.availabilityRepository.ReplaceForProduct(ctx, productID, schedule); err != nil {
+		return nil, err
+	}
+	u.productCache.Invalidate(ctx)
+
+	return u.availabilityRepository.GetByProduct(ctx, productID)
+}
@@ -0,0 +1,42 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+	domainError "github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/error"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/dto"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/mappers"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+)
+
+type UpdateProductRecipeUseCase interface {
+	Run(ctx context.Context, productID int, input dto.RecipeInput) ([]entities.RecipeItem, error)
+}
+
+type updateProductRecipeUseCase struct {
+	ingredientRepository ports.IngredientRepository
+	productCache         ports.ProductCache
+}
+
+func NewUpdateProductRecipeUseCase(ingredientRepository ports.IngredientRepository, productCache ports.ProductCache) UpdateProductRecipeUseCase {
+	return &updateProductRecipeUseCase{ingredientRepository: ingredientRepository, productCache: productCache}
+}
+
+// Run replaces the recipe of the product. Orders already in preparation keep the consumption
+// posted with the previous recipe.
+func (u *updateProductRecipeUseCase) Run(ctx context.Context, productID int, input dto.RecipeInput) ([]entities.RecipeItem, error) {
+	items := mappers.MapRecipeInputToEntity(productID, input)
+	if err := entities.ValidateRecipe(items); err != nil {
+		return nil, domainError.NewEntityNotProcessableError("recipe", err.Error())
+	}
+
+	recipe, err := u.ingredientRepository.ReplaceRecipe(ctx, productID, items)
+	if err != nil {
+		return nil, err
+	}
+	// The recipe decides whether the product is available
+	u.productCache.Invalidate(ctx)
+
+	return recipe, nil
+}
@@ -0,0 +1,39 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+	domainError "github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/error"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/dto"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/mappers"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+)
+
+type UpdateProductStockUseCase interface {
+	Run(ctx context.Context, id int, input dto.ProductStockInput) (*entities.Product, error)
+}
+
+type updateProductStockUseCase struct {
+	productRepository ports.ProductRepository
+}
+
+func NewUpdateProductStockUseCase(productRepository ports.ProductRepository) UpdateProductStockUseCase {
+	return &updateProductStockUseCase{productRepository: productRepository}
+}
+
+// Run sets the stock on hand of the product, after a count or a restock. Stock reserved by open
+// orders is already discounted from the quantity, so it should be the quantity available to sell.
+func (u *updateProductStockUseCase) Run(ctx context.Context, id int, input dto.ProductStockInput) (*entities.Product, error) {
+	stock := mappers.MapProductStockInputToEntity(input)
+	if err := stock.Validate(); err != nil {
+		return nil, domainError.NewEntityNotProcessableError("product", err.Error())
+	}
+
+	product, err := u.productRepository.UpdateStock(ctx, id, stock)
+	if err != nil {
+		return nil, err
+	}
+
+	return &product, nil
+}
@@ -0,0 +1,47 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/dto"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/mappers"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+)
+
+type UpdateProductVariantUseCase interface {
+	Run(ctx context.Context, productID int, variantID int, input dto.ProductVariantInput) (*entities.ProductVariant, error)
+}
+
+type updateProductVariantUseCase struct {
+	productRepository        ports.ProductRepository
+	productVariantRepository ports.ProductVariantRepository
+	productCache             ports.ProductCache
+}
+
+func NewUpdateProductVariantUseCase(productRepository ports.ProductRepository, productVariantRepository ports.ProductVariantRepository, productCache ports.ProductCache) UpdateProductVariantUseCase {
+	return &updateProductVariantUseCase{productRepository: productRepository, productVariantRepository: productVariantRepository, productCache: productCache}
+}
+
+// Run replaces the variant. Orders already placed keep the price they were charged.
+func (u *updateProductVariantUseCase) Run(ctx context.Context, productID int, variantID int, input dto.ProductVariantInput) (*entities.ProductVariant, error) {
+	product, err := u.productRepository.GetById(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	variant := mappers.MapProductVariantInputToEntity(productID, input)
+	variant.ID = variantID
+	variant, err = prepareProductVariant(product, variant)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := u.productVariantRepository.Update(ctx, variant)
+	if err != nil {
+		return nil, err
+	}
+	u.productCache.Invalidate(ctx)
+
+	return &updated, nil
+}
@@ -0,0 +1,108 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/config"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/entities"
+	domainError "github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/domain/error"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+)
+
+// ImagesPath is the route uploaded images are served from, followed by their storage key.
+const ImagesPath = "/api/v1/images/"
+
+type UploadProductImageUseCase interface {
+	Run(ctx context.Context, productID int, data []byte) (*entities.ProductImage, error)
+}
+
+type uploadProductImageUseCase struct {
+	productRepository ports.ProductRepository
+	fileStorage       ports.FileStorage
+	imageProcessor    ports.ImageProcessor
+	cfg               *config.Config
+}
+
+func NewUploadProductImageUseCase(productRepository ports.ProductRepository, fileStorage ports.FileStorage, imageProcessor ports.ImageProcessor, cfg *config.Config) UploadProductImageUseCase {
+	return &uploadProductImageUseCase{productRepository: productRepository, fileStorage: fileStorage, imageProcessor: imageProcessor, cfg: cfg}
+}
+
+// Run stores the image and its thumbnails under a new key, so their URLs never change and can be
+// cached forever. The files are removed again when the image cannot be saved.
+func (u *uploadProductImageUseCase) Run(ctx context.Context, productID int, data []byte) (*entities.ProductImage, error) {
+	product, err := u.productRepository.GetById(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	if product.ID == 0 {
+		return nil, domainError.ErrNotFound("product")
+	}
+
+	upload, err := u.imageProcessor.Inspect(data)
+	if err != nil {
+		return nil, domainError.NewEntityNotProcessableError("product image", "image could not be read")
+	}
+
+	if err := upload.Validate(u.cfg.Storage.MaxUploadBytes); err != nil {
+		return nil, domainError.NewEntityNotProcessableError("product image", err.Error())
+	}
+
+	thumbnails, err := u.imageProcessor.Thumbnails(upload, entities.ThumbnailSizes)
+	if err != nil {
+		return nil, domainError.NewEntityNotProcessableError("product image", "image could not be read")
+	}
+
+	prefix := fmt.Sprintf("products/%d/%s/", productID, uuid.New().String())
+	extension, _ := entities.ImageExtension(upload.ContentType)
+	image := entities.ProductImage{
+		StorageKey:  prefix + "original." + extension,
+		ContentType: upload.ContentType,
+	}
+	image.ImageURL = u.url(image.StorageKey)
+
+	var stored []string
+	if err := u.fileStorage.Put(ctx, image.StorageKey, upload.ContentType, upload.Data); err != nil {
+		return nil, err
+	}
+	stored = append(stored, image.StorageKey)
+
+	for _, thumbnail := range thumbnails {
+		extension, _ := entities.ImageExtension(thumbnail.ContentType)
+		key := prefix + thumbnail.Size.Name + "." + extension
+		if err := u.fileStorage.Put(ctx, key, thumbnail.ContentType, thumbnail.Data); err != nil {
+			u.deleteFiles(ctx, stored)
+			return nil, err
+		}
+		stored = append(stored, key)
+
+		image.Thumbnails = append(image.Thumbnails, entities.ProductImageThumbnail{
+			Name:       thumbnail.Size.Name,
+			StorageKey: key,
+			URL:        u.url(key),
+		})
+	}
+
+	image, err = u.productRepository.AddImage(ctx, productID, image)
+	if err != nil {
+		u.deleteFiles(ctx, stored)
+		return nil, err
+	}
+
+	return &image, nil
+}
+
+func (u *uploadProductImageUseCase) url(key string) string {
+	return u.cfg.Storage.PublicBaseURL + ImagesPath + key
+}
+
+func (u *uploadProductImageUseCase) deleteFiles(ctx context.Context, keys []string) {
+	for _, key := range keys {
+		if err := u.fileStorage.Delete(ctx, key); err != nil {
+			slog.Error("Error deleting stored file", "key", key, "error", err)
+		}
+	}
+}
@@ -2,8 +2,13 @@ package di
 
 import (
 	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/adapters/db/repository"
+	gateways "github.com/tupizz/restaurant-food-golang-api-fiap/internal/adapters/gateways/payment"
 	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/adapters/http"
 	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/adapters/http/handler"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/adapters/imaging"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/adapters/receipt"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/adapters/storage"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/adapters/worker"
 	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/config"
 	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase"
 	"go.uber.org/dig"
@@ -22,15 +27,38 @@ func BuildContainer() *dig.Container {
 	// Cache Connection
 	container.Provide(NewRedisConnection)
 
+	// Payment Gateways
+	container.Provide(gateways.NewPaymentGatewayResolver)
+	container.Provide(gateways.NewMockCardVault)
+	container.Provide(gateways.NewCardTokenizer)
+	container.Provide(gateways.NewWebhookPaymentNotifier)
+
+	// Receipt Renderers
+	container.Provide(receipt.NewReceiptRendererResolver)
+
+	// File Storage
+	container.Provide(storage.NewFileStorage)
+	container.Provide(imaging.NewImageProcessor)
+
 	// Router
 	container.Provide(http.NewRouter)
 
 	// Repositories
 	container.Provide(repository.NewClientRepository)
 	container.Provide(repository.NewHealthCheckRepository)
-	container.Provide(repository.NewProductRepository)
+	container.Provide(NewProductRepository)
 	container.Provide(repository.NewOrderRepository)
 	container.Provide(repository.NewPaymentRepository)
+	container.Provide(repository.NewRefundRepository)
+	container.Provide(repository.NewPaymentTaxSettingsRepository)
+	container.Provide(repository.NewCouponRepository)
+	container.Provide(repository.NewCategoryRepository)
+	container.Provide(repository.NewLoyaltyRepository)
+	container.Provide(repository.NewIngredientRepository)
+	container.Provide(repository.NewProductPriceRepository)
+	container.Provide(repository.NewAvailabilityRepository)
+	container.Provide(repository.NewProductVariantRepository)
+	container.Provide(repository.NewProductRecommendationRepository)
 
 	// UseCases
 	container.Provide(usecase.NewHealthCheckPingUseCase)
@@ -38,6 +66,9 @@ func BuildContainer() *dig.Container {
 	container.Provide(usecase.NewCreateProductUseCase)
 	container.Provide(usecase.NewUpdateProductUseCase)
 	container.Provide(usecase.NewDeleteProductUseCase)
+	container.Provide(usecase.NewRestoreProductUseCase)
+	container.Provide(usecase.NewPurgeProductUseCase)
+	container.Provide(usecase.NewUpdateProductStockUseCase)
 	container.Provide(usecase.NewOrderUseCase)
 	container.Provide(usecase.NewCreateOrderUseCase)
 	container.Provide(usecase.NewGetOrderByIDUseCase)
@@ -46,6 +77,65 @@ func BuildContainer() *dig.Container {
 	container.Provide(usecase.NewGetClientByCPFUseCase)
 	container.Provide(usecase.NewUpdateOrderStatusToReadyUseCase)
 	container.Provide(usecase.NewUpdateOrderStatusToDeliveredUseCase)
+	container.Provide(usecase.NewCreateRefundUseCase)
+	container.Provide(usecase.NewProcessRefundUseCase)
+	container.Provide(usecase.NewGetOrderRefundsUseCase)
+	container.Provide(usecase.NewReconcilePendingPaymentsUseCase)
+	container.Provide(usecase.NewGetPaymentReconciliationSummaryUseCase)
+	container.Provide(usecase.NewGetPaymentTaxSettingsUseCase)
+	container.Provide(usecase.NewCreatePaymentTaxSettingUseCase)
+	container.Provide(usecase.NewUpdatePaymentTaxSettingUseCase)
+	container.Provide(usecase.NewDeletePaymentTaxSettingUseCase)
+	container.Provide(usecase.NewGetCouponsUseCase)
+	container.Provide(usecase.NewCreateCouponUseCase)
+	container.Provide(usecase.NewUpdateCouponUseCase)
+	container.Provide(usecase.NewDeleteCouponUseCase)
+	container.Provide(usecase.NewGetCategoriesUseCase)
+	container.Provide(usecase.NewGetCategoryByIDUseCase)
+	container.Provide(usecase.NewCreateCategoryUseCase)
+	container.Provide(usecase.NewUpdateCategoryUseCase)
+	container.Provide(usecase.NewDeleteCategoryUseCase)
+	container.Provide(usecase.NewReassignCategoryProductsUseCase)
+	container.Provide(usecase.NewSyncOrderLoyaltyPointsUseCase)
+	container.Provide(usecase.NewGetClientLoyaltyUseCase)
+	container.Provide(usecase.NewGetOrderReceiptUseCase)
+	container.Provide(usecase.NewRetryOrderPaymentUseCase)
+	container.Provide(usecase.NewExpireFailedPaymentsUseCase)
+	container.Provide(usecase.NewListSandboxPaymentsUseCase)
+	container.Provide(usecase.NewSimulatePaymentNotificationUseCase)
+	container.Provide(usecase.NewGetIngredientsUseCase)
+	container.Provide(usecase.NewCreateIngredientUseCase)
+	container.Provide(usecase.NewUpdateIngredientUseCase)
+	container.Provide(usecase.NewDeleteIngredientUseCase)
+	container.Provide(usecase.NewReceiveIngredientUseCase)
+	container.Provide(usecase.NewCountIngredientUseCase)
+	container.Provide(usecase.NewGetIngredientMovementsUseCase)
+	container.Provide(usecase.NewGetInventoryReportUseCase)
+	container.Provide(usecase.NewGetProductRecipeUseCase)
+	container.Provide(usecase.NewUpdateProductRecipeUseCase)
+	container.Provide(usecase.NewUploadProductImageUseCase)
+	container.Provide(usecase.NewDeleteProductImageUseCase)
+	container.Provide(usecase.NewGetStoredImageUseCase)
+	container.Provide(usecase.NewGetProductPricesUseCase)
+	container.Provide(usecase.NewChangeProductPriceUseCase)
+	container.Provide(usecase.NewCancelProductPriceUseCase)
+	container.Provide(usecase.NewApplyScheduledPricesUseCase)
+	container.Provide(usecase.NewRebuildProductRecommendationsUseCase)
+	container.Provide(usecase.NewGetProductRecommendationsUseCase)
+	container.Provide(usecase.NewGetCartRecommendationsUseCase)
+	container.Provide(usecase.NewGetProductAvailabilityUseCase)
+	container.Provide(usecase.NewUpdateProductAvailabilityUseCase)
+	container.Provide(usecase.NewGetCategoryAvailabilityUseCase)
+	container.Provide(usecase.NewUpdateCategoryAvailabilityUseCase)
+	container.Provide(usecase.NewImportCatalogUseCase)
+	container.Provide(usecase.NewExportCatalogUseCase)
+	container.Provide(usecase.NewGetProductCacheStatsUseCase)
+	container.Provide(usecase.NewGetCatalogLastModifiedUseCase)
+	container.Provide(usecase.NewInvalidateProductCacheUseCase)
+	container.Provide(usecase.NewGetProductVariantsUseCase)
+	container.Provide(usecase.NewCreateProductVariantUseCase)
+	container.Provide(usecase.NewUpdateProductVariantUseCase)
+	container.Provide(usecase.NewDeleteProductVariantUseCase)
 
 	// Handlers
 	container.Provide(handler.NewClientHandler)
@@ -55,6 +145,30 @@ func BuildContainer() *dig.Container {
 	container.Provide(handler.NewOrderHandler)
 	container.Provide(handler.NewCheckoutHandler)
 	container.Provide(handler.NewWebhookHandler)
+	container.Provide(handler.NewRefundHandler)
+	container.Provide(handler.NewPaymentAdminHandler)
+	container.Provide(handler.NewPaymentTaxSettingsAdminHandler)
+	container.Provide(handler.NewCouponAdminHandler)
+	container.Provide(handler.NewCategoryHandler)
+	container.Provide(handler.NewCategoryAdminHandler)
+	container.Provide(handler.NewReceiptHandler)
+	container.Provide(handler.NewSandboxHandler)
+	container.Provide(handler.NewIngredientAdminHandler)
+	container.Provide(handler.NewRecipeAdminHandler)
+	container.Provide(handler.NewProductImageHandler)
+	container.Provide(handler.NewProductPriceAdminHandler)
+	container.Provide(handler.NewAvailabilityAdminHandler)
+	container.Provide(handler.NewCatalogAdminHandler)
+	container.Provide(handler.NewProductCacheAdminHandler)
+	container.Provide(handler.NewProductVariantAdminHandler)
+	container.Provide(handler.NewProductRecommendationHandler)
+
+	// Workers
+	container.Provide(worker.NewPaymentReconciler)
+	container.Provide(worker.NewPaymentRetryExpirer)
+	container.Provide(worker.NewPriceScheduler)
+	container.Provide(worker.NewRecommendationBuilder)
+	container.Provide(worker.NewWorkers)
 
 	return container
 }
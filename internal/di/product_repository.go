@@ -0,0 +1,21 @@
+package di
+
+import (
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/redis/go-redis/v9"
+	fiapRestaurantDb "github.com/tupizz/restaurant-food-golang-api-fiap/database/sqlc"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/adapters/cache"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/adapters/db/repository"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/config"
+	"github.com/tupizz/restaurant-food-golang-api-fiap/internal/core/usecase/ports"
+)
+
+// NewProductRepository wraps the product repository with the Redis cache, unless it is disabled.
+func NewProductRepository(cfg *config.Config, db *pgxpool.Pool, sqlcDb *fiapRestaurantDb.Queries, redisClient *redis.Client) (ports.ProductRepository, ports.ProductCache) {
+	productRepository := repository.NewProductRepository(db, sqlcDb)
+	if !cfg.ProductCache.Enabled {
+		return productRepository, cache.NewDisabledProductCache()
+	}
+
+	return cache.NewProductRepository(productRepository, redisClient, cfg.ProductCache.TTL)
+}
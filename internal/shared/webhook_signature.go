@@ -0,0 +1,59 @@
+package shared
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"time"
+)
+
+const (
+	WebhookTimestampHeader = "X-Webhook-Timestamp"
+	WebhookSignatureHeader = "X-Webhook-Signature"
+	// WebhookSignatureTolerance is how old a signed notification can be, older ones are rejected as replays.
+	WebhookSignatureTolerance = 5 * time.Minute
+)
+
+var (
+	ErrWebhookSignatureMissing = errors.New("webhook signature is missing")
+	ErrWebhookSignatureInvalid = errors.New("webhook signature is invalid")
+	ErrWebhookSignatureExpired = errors.New("webhook signature has expired")
+)
+
+// SignWebhook returns the hex encoded HMAC-SHA256 of "<unix timestamp>.<body>" with the shared secret.
+func SignWebhook(secret string, timestamp time.Time, body []byte) string {
+	return signWebhook(secret, strconv.FormatInt(timestamp.Unix(), 10), body)
+}
+
+// VerifyWebhookSignature checks the signature and timestamp headers of a notification against its body.
+func VerifyWebhookSignature(secret, timestamp, signature string, body []byte, now time.Time) error {
+	if timestamp == "" || signature == "" {
+		return ErrWebhookSignatureMissing
+	}
+
+	unix, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return ErrWebhookSignatureInvalid
+	}
+
+	if !hmac.Equal([]byte(signWebhook(secret, timestamp, body)), []byte(signature)) {
+		return ErrWebhookSignatureInvalid
+	}
+
+	if age := now.Sub(time.Unix(unix, 0)); age > WebhookSignatureTolerance || age < -WebhookSignatureTolerance {
+		return ErrWebhookSignatureExpired
+	}
+
+	return nil
+}
+
+func signWebhook(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -24,9 +24,9 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/admin/orders": {
+        "/admin/categories": {
             "get": {
-                "description": "Get a list of all orders with pagination",
+                "description": "Lista as categorias ativas e inativas na ordem de exibição, com a quantidade de produtos de cada uma",
                 "consumes": [
                     "application/json"
                 ],
@@ -34,36 +34,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "orders"
-                ],
-                "summary": "Retrieve all orders",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "default": 1,
-                        "description": "Page number",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "default": 10,
-                        "description": "Number of items per page",
-                        "name": "pageSize",
-                        "in": "query"
-                    }
+                    "categories"
                 ],
+                "summary": "Lista todas as categorias",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.PaginatedOrdersDTO"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/handler.ErrorResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.CategoryOutput"
+                            }
                         }
                     },
                     "500": {
@@ -73,11 +54,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/admin/orders/{id}/delivered": {
-            "patch": {
-                "description": "Mark ordeer as delivered",
+            },
+            "post": {
+                "description": "Cria uma categoria, o handle é gerado a partir do nome e numerado quando já existe",
                 "consumes": [
                     "application/json"
                 ],
@@ -85,34 +64,45 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "orders"
+                    "categories"
                 ],
-                "summary": "Mark order as delivered",
+                "summary": "Cria uma categoria",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Order ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Dados da Categoria",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CategoryInput"
+                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No content"
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CategoryOutput"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/handler.ErrorResponse"
                         }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
                     }
                 }
             }
         },
-        "/admin/orders/{id}/ready": {
-            "patch": {
-                "description": "Mark ordeer as ready",
+        "/admin/categories/{id}": {
+            "get": {
+                "description": "Obtém a categoria com o ID fornecido",
                 "consumes": [
                     "application/json"
                 ],
@@ -120,64 +110,33 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "orders"
+                    "categories"
                 ],
-                "summary": "Mark order as ready",
+                "summary": "Obtém uma categoria por ID",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Order ID",
+                        "description": "ID da Categoria",
                         "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CategoryOutput"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/handler.ErrorResponse"
                         }
-                    }
-                }
-            }
-        },
-        "/admin/products": {
-            "post": {
-                "description": "Create Product",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "products"
-                ],
-                "summary": "Create Product",
-                "parameters": [
-                    {
-                        "description": "Product data",
-                        "name": "input",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.ProductInputCreate"
-                        }
-                    }
-                ],
-                "responses": {
-                    "201": {
-                        "description": "Created",
-                        "schema": {
-                            "$ref": "#/definitions/dto.ProductOutput"
-                        }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/handler.ErrorResponse"
                         }
@@ -189,11 +148,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/admin/products/{id}": {
+            },
             "put": {
-                "description": "Update Product",
+                "description": "Atualiza uma categoria, o handle é mantido mesmo quando o nome muda",
                 "consumes": [
                     "application/json"
                 ],
@@ -201,24 +158,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "products"
+                    "categories"
                 ],
-                "summary": "Update Product",
+                "summary": "Atualiza uma categoria",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Product ID",
+                        "description": "ID da Categoria",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Product data",
+                        "description": "Dados da Categoria",
                         "name": "input",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.ProductInputUpdate"
+                            "$ref": "#/definitions/dto.CategoryInput"
                         }
                     }
                 ],
@@ -226,7 +183,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.ProductOutput"
+                            "$ref": "#/definitions/dto.CategoryOutput"
                         }
                     },
                     "400": {
@@ -235,6 +192,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/handler.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -244,7 +207,7 @@ const docTemplate = `{
                 }
             },
             "delete": {
-                "description": "Delete Product",
+                "description": "Remove uma categoria sem produtos. Categorias com produtos retornam 409, mova os produtos antes com o endpoint de reatribuição",
                 "consumes": [
                     "application/json"
                 ],
@@ -252,13 +215,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "products"
+                    "categories"
                 ],
-                "summary": "Delete Product",
+                "summary": "Remove uma categoria",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Product ID",
+                        "description": "ID da Categoria",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -274,6 +237,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/handler.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -283,9 +258,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/checkout": {
-            "post": {
-                "description": "Cria um novo pedido com os dados fornecidos",
+        "/admin/categories/{id}/availability": {
+            "get": {
+                "description": "Lista os horários seguidos pelos produtos da categoria que não têm horários próprios",
                 "consumes": [
                     "application/json"
                 ],
@@ -293,25 +268,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "checkout"
+                    "availability"
                 ],
-                "summary": "Cria um novo pedido",
+                "summary": "Obtém os horários de uma categoria",
                 "parameters": [
                     {
-                        "description": "Dados do Pedido",
-                        "name": "order",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.CreateOrderRequest"
-                        }
+                        "type": "integer",
+                        "description": "ID da Categoria",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.OrderResponse"
+                            "$ref": "#/definitions/dto.AvailabilityOutput"
                         }
                     },
                     "400": {
@@ -320,6 +293,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/handler.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -327,11 +306,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/clients": {
-            "post": {
-                "description": "Cria um novo cliente com os dados fornecidos",
+            },
+            "put": {
+                "description": "Substitui os horários da categoria, uma lista vazia deixa seus produtos sem horários próprios sempre disponíveis",
                 "consumes": [
                     "application/json"
                 ],
@@ -339,25 +316,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "clients"
+                    "availability"
                 ],
-                "summary": "Cria um novo cliente",
+                "summary": "Substitui os horários de uma categoria",
                 "parameters": [
                     {
-                        "description": "Dados do Cliente",
-                        "name": "client",
+                        "type": "integer",
+                        "description": "ID da Categoria",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Horários",
+                        "name": "input",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.ClientInput"
+                            "$ref": "#/definitions/dto.AvailabilityInput"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.ClientOutput"
+                            "$ref": "#/definitions/dto.AvailabilityOutput"
                         }
                     },
                     "400": {
@@ -366,6 +350,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/handler.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -375,9 +365,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/clients/{cpf}": {
-            "get": {
-                "description": "Retorna os dados de um cliente específico pelo CPF",
+        "/admin/categories/{id}/products/reassign": {
+            "post": {
+                "description": "Move os produtos informados em product_ids, ou todos quando vazio, da categoria para a categoria de destino",
                 "consumes": [
                     "application/json"
                 ],
@@ -385,23 +375,38 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "clients"
+                    "categories"
                 ],
-                "summary": "Obtém um cliente pelo CPF",
+                "summary": "Move produtos para outra categoria",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "CPF do Cliente",
-                        "name": "cpf",
+                        "type": "integer",
+                        "description": "ID da Categoria de origem",
+                        "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Categoria de destino e produtos",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReassignCategoryProductsInput"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.ClientOutput"
+                            "$ref": "#/definitions/dto.ReassignCategoryProductsOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
                         }
                     },
                     "404": {
@@ -419,9 +424,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/healthcheck": {
+        "/admin/coupons": {
             "get": {
-                "description": "Verifica se a aplicação está respondendo para o liveness e readiness probe do K8s",
+                "description": "Lista os cupons de desconto com as regras de validade, limites de uso e restrições",
                 "consumes": [
                     "application/json"
                 ],
@@ -429,16 +434,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "healthcheck"
+                    "coupons"
                 ],
-                "summary": "Verifica se a aplicação está respondendo",
+                "summary": "Lista os cupons",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/dto.HealthCheckOutput"
+                                "$ref": "#/definitions/dto.CouponOutput"
                             }
                         }
                     },
@@ -449,11 +454,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/orders/{id}": {
-            "get": {
-                "description": "Obtém um pedido com o ID fornecido",
+            },
+            "post": {
+                "description": "Cria um cupom de desconto fixo ou percentual, o código não diferencia maiúsculas de minúsculas",
                 "consumes": [
                     "application/json"
                 ],
@@ -461,23 +464,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "orders"
+                    "coupons"
                 ],
-                "summary": "Obtém um pedido por ID",
+                "summary": "Cria um cupom",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "ID do Pedido",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Dados do Cupom",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CouponInput"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/dto.OrderResponse"
+                            "$ref": "#/definitions/dto.CouponOutput"
                         }
                     },
                     "400": {
@@ -495,9 +500,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/products": {
-            "get": {
-                "description": "Get Products",
+        "/admin/coupons/{id}": {
+            "put": {
+                "description": "Atualiza um cupom, pedidos já criados mantêm os descontos concedidos",
                 "consumes": [
                     "application/json"
                 ],
@@ -505,315 +510,5007 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "products"
+                    "coupons"
                 ],
-                "summary": "Get Products",
+                "summary": "Atualiza um cupom",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Page number",
-                        "name": "page",
-                        "in": "query"
+                        "description": "ID do Cupom",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Dados do Cupom",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CouponInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CouponOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
                     },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Remove um cupom, pedidos já criados mantêm os descontos concedidos",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "coupons"
+                ],
+                "summary": "Remove um cupom",
+                "parameters": [
                     {
                         "type": "integer",
-                        "description": "Page size",
-                        "name": "pageSize",
-                        "in": "query"
+                        "description": "ID do Cupom",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/fees": {
+            "get": {
+                "description": "Lista as taxas fixas ou percentuais aplicadas por método de pagamento, plataforma ou entrega",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "fees"
+                ],
+                "summary": "Lista as taxas configuradas",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.PaymentTaxSettingOutput"
+                            }
+                        }
                     },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Cria uma taxa fixa ou percentual",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "fees"
+                ],
+                "summary": "Cria uma taxa",
+                "parameters": [
                     {
-                        "type": "string",
-                        "description": "Category",
-                        "name": "category",
-                        "in": "query"
+                        "description": "Dados da Taxa",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.PaymentTaxSettingInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.PaymentTaxSettingOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/fees/{id}": {
+            "put": {
+                "description": "Atualiza uma taxa, pedidos já criados mantêm as taxas cobradas",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "fees"
+                ],
+                "summary": "Atualiza uma taxa",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID da Taxa",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Dados da Taxa",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.PaymentTaxSettingInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.PaymentTaxSettingOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Remove uma taxa, pedidos já criados mantêm as taxas cobradas",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "fees"
+                ],
+                "summary": "Remove uma taxa",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID da Taxa",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/ingredients": {
+            "get": {
+                "description": "Lista os ingredientes com o estoque teórico, recebimentos menos o consumo dos pedidos desde a última contagem",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ingredients"
+                ],
+                "summary": "Lista os ingredientes",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.IngredientOutput"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Cria um ingrediente sem estoque, com a unidade em que é recebido e usado nas receitas (g, kg, ml, l ou unit)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ingredients"
+                ],
+                "summary": "Cria um ingrediente",
+                "parameters": [
+                    {
+                        "description": "Dados do Ingrediente",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.IngredientInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.IngredientOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/ingredients/report": {
+            "get": {
+                "description": "Compara o estoque teórico de cada ingrediente com a última contagem. A variância é negativa quando foi contado menos do que o esperado",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ingredients"
+                ],
+                "summary": "Relatório de inventário",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.InventoryReportOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/ingredients/{id}": {
+            "put": {
+                "description": "Atualiza o nome e a unidade do ingrediente. A unidade não muda depois que o ingrediente entra em receitas ou tem movimentações",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ingredients"
+                ],
+                "summary": "Atualiza um ingrediente",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID do Ingrediente",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Dados do Ingrediente",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.IngredientInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.IngredientOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Remove um ingrediente que nenhuma receita usa. Ingredientes em receitas retornam 409",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ingredients"
+                ],
+                "summary": "Remove um ingrediente",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID do Ingrediente",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/ingredients/{id}/counts": {
+            "post": {
+                "description": "Registra a quantidade contada fisicamente. O estoque teórico passa a ser a quantidade contada e a diferença fica no relatório de inventário",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ingredients"
+                ],
+                "summary": "Registra uma contagem de ingrediente",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID do Ingrediente",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Dados da Contagem",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.IngredientCountInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.IngredientCountOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/ingredients/{id}/movements": {
+            "get": {
+                "description": "Lista os últimos recebimentos, consumos de pedidos e ajustes de contagem do ingrediente, dos mais recentes para os mais antigos",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ingredients"
+                ],
+                "summary": "Lista as movimentações de um ingrediente",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID do Ingrediente",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.IngredientMovementOutput"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/ingredients/{id}/receipts": {
+            "post": {
+                "description": "Soma a quantidade recebida ao estoque do ingrediente. Produtos desativados por falta dele voltam a ficar disponíveis quando todos os ingredientes da receita estão em estoque",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ingredients"
+                ],
+                "summary": "Registra um recebimento de ingrediente",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID do Ingrediente",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Dados do Recebimento",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.IngredientReceiptInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.IngredientMovementOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/orders": {
+            "get": {
+                "description": "Get a list of all orders with pagination",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Retrieve all orders",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Number of items per page",
+                        "name": "pageSize",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.PaginatedOrdersDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/orders/{id}/delivered": {
+            "patch": {
+                "description": "Mark ordeer as delivered",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Mark order as delivered",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/orders/{id}/ready": {
+            "patch": {
+                "description": "Mark ordeer as ready",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Mark order as ready",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/orders/{id}/refunds": {
+            "get": {
+                "description": "Lista todos os estornos solicitados para o pagamento do pedido",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "refunds"
+                ],
+                "summary": "Lista os estornos de um pedido",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID do Pedido",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.RefundOutput"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Cria um estorno total (sem amount) ou parcial para o pagamento aprovado do pedido",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "refunds"
+                ],
+                "summary": "Estorna o pagamento de um pedido",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID do Pedido",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Dados do Estorno",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.RefundInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.RefundOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/payments/reconciliation": {
+            "get": {
+                "description": "Retorna o resultado da última execução da conciliação de pagamentos pendentes",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "payments"
+                ],
+                "summary": "Resumo da última conciliação de pagamentos",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.PaymentReconciliationSummary"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Consulta o gateway para os pagamentos pendentes há mais tempo que o configurado e aplica as mudanças de status",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "payments"
+                ],
+                "summary": "Executa a conciliação de pagamentos",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.PaymentReconciliationSummary"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/products": {
+            "get": {
+                "description": "Lists products like the menu does, optionally with the soft-deleted ones, that carry deleted_at. only_deleted takes precedence over include_deleted",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "Get Products (admin)",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size",
+                        "name": "pageSize",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Category",
+                        "name": "category",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search by name, category and description",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Also list deleted products",
+                        "name": "include_deleted",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "List only deleted products",
+                        "name": "only_deleted",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.ProductOutput"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create Product",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "Create Product",
+                "parameters": [
+                    {
+                        "description": "Product data",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ProductInputCreate"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Admin recorded as the author of the price",
+                        "name": "X-Admin-User",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ProductOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/products/cache": {
+            "get": {
+                "description": "Acertos, falhas e invalidações do cache de produtos desta instância desde que ela iniciou",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "Métricas do cache de produtos",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ProductCacheStatsOutput"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Descarta as leituras de produtos em cache, para mudanças feitas direto no banco. As alterações feitas pela API já invalidam o cache",
+                "tags": [
+                    "products"
+                ],
+                "summary": "Invalida o cache de produtos",
+                "responses": {
+                    "204": {
+                        "description": "No content"
+                    }
+                }
+            }
+        },
+        "/admin/products/export": {
+            "get": {
+                "description": "Lista todos os produtos no formato lido pela importação, para levar o cardápio a outro ambiente. Imagens enviadas são exportadas pela URL deste ambiente",
+                "produces": [
+                    "application/json",
+                    "text/csv"
+                ],
+                "tags": [
+                    "catalog"
+                ],
+                "summary": "Exporta o catálogo de produtos",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "json (padrão) ou csv",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.CatalogProduct"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/products/import": {
+            "post": {
+                "description": "Cria ou atualiza os produtos pelo SKU, a partir de um JSON (lista de produtos) ou de um CSV com cabeçalho, no formato da exportação. Nada é importado se algum produto tiver erros. Com dry_run=true só valida e informa o que seria criado e atualizado. O estoque dos produtos existentes é mantido",
+                "consumes": [
+                    "application/json",
+                    "text/csv"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "catalog"
+                ],
+                "summary": "Importa o catálogo de produtos",
+                "parameters": [
+                    {
+                        "description": "Catálogo",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.CatalogProduct"
+                            }
+                        }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Só valida o catálogo, sem gravar",
+                        "name": "dry_run",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Admin registrado como autor das mudanças de preço",
+                        "name": "X-Admin-User",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CatalogImportOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CatalogImportOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "413": {
+                        "description": "Request Entity Too Large",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/products/{id}": {
+            "put": {
+                "description": "Update Product",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "Update Product",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Product data",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ProductInputUpdate"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Admin recorded as the author of a price change",
+                        "name": "X-Admin-User",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ProductOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Soft deletes the product with its images and variants, it can be restored later",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "Delete Product",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/products/{id}/availability": {
+            "get": {
+                "description": "Lista os horários em que o produto pode ser pedido, no fuso horário da loja. Sem horários próprios o produto segue os da categoria",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "availability"
+                ],
+                "summary": "Obtém os horários de um produto",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID do Produto",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.AvailabilityOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Substitui os horários do produto, uma lista vazia faz o produto seguir os horários da categoria. Um horário que termina antes de começar passa da meia-noite, 00:00 a 00:00 cobre o dia todo",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "availability"
+                ],
+                "summary": "Substitui os horários de um produto",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID do Produto",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Horários",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.AvailabilityInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.AvailabilityOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/products/{id}/images": {
+            "post": {
+                "description": "Uploads a JPEG, PNG or GIF image of the product, up to STORAGE_MAX_UPLOAD_BYTES (5 MB by default) and 4096 pixels per side. Small (200px) and medium (600px) thumbnails are generated, all served from stable URLs",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "Upload Product Image",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Image file",
+                        "name": "image",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ProductImageOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "413": {
+                        "description": "Request Entity Too Large",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/products/{id}/images/{image_id}": {
+            "delete": {
+                "description": "Removes the image from the product, uploaded files and thumbnails are deleted from the storage",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "Delete Product Image",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Image ID",
+                        "name": "image_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/products/{id}/prices": {
+            "get": {
+                "description": "Lists every price of the product with its effective date and author, scheduled prices included, latest effective date first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "Get Product Price History",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.ProductPriceOutput"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Changes the price at once or, with a future effective_at, schedules it. Scheduled prices are applied by a worker shortly after their effective date",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "Change Product Price",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Price data",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ProductPriceInput"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Admin recorded as the author of the price",
+                        "name": "X-Admin-User",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ProductPriceOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/products/{id}/prices/{price_id}": {
+            "delete": {
+                "description": "Cancels a price that was not applied yet, it stays in the history as canceled",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "Cancel Scheduled Product Price",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Price ID",
+                        "name": "price_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/products/{id}/purge": {
+            "delete": {
+                "description": "Erases a deleted product for good, with its images, variants, prices, recipe and availability. Products ever ordered can only stay soft deleted",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "Purge Product",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/products/{id}/recipe": {
+            "get": {
+                "description": "Lista os ingredientes usados para fazer uma unidade do produto",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ingredients"
+                ],
+                "summary": "Obtém a receita de um produto",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID do Produto",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.RecipeOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Substitui os ingredientes do produto, uma lista vazia remove a receita. O consumo é lançado quando o pedido entra em preparo e o produto é desativado enquanto faltar algum ingrediente",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ingredients"
+                ],
+                "summary": "Substitui a receita de um produto",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID do Produto",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Ingredientes da Receita",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.RecipeInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.RecipeOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/products/{id}/restore": {
+            "post": {
+                "description": "Puts a deleted product back on the menu with the images and variants deleted along with it. Fails when the product is not deleted, its category was deleted or its SKU was taken",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "Restore Product",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ProductOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/products/{id}/stock": {
+            "put": {
+                "description": "Sets the stock of the product after a count or a restock. Untracked products are always available",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "Update Product Stock",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Stock data",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ProductStockInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ProductOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/products/{id}/variants": {
+            "get": {
+                "description": "Lists the sizes of the product in display order, with their current stock",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "Get Product Variants",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.ProductVariantOutput"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Adds a size to the product, like 500ml or G. Products with variants are ordered by variant, each at its own price",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "Create Product Variant",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Variant data",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ProductVariantInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ProductVariantOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/products/{id}/variants/{variant_id}": {
+            "put": {
+                "description": "Replaces the variant, orders already placed keep the price they were charged",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "Update Product Variant",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Variant ID",
+                        "name": "variant_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Variant data",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ProductVariantInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ProductVariantOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Removes the variant from the menu, once the last one is removed the product is ordered at its own price again",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "Delete Product Variant",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Variant ID",
+                        "name": "variant_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/categories": {
+            "get": {
+                "description": "Lista as categorias ativas na ordem de exibição",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "categories"
+                ],
+                "summary": "Lista as categorias do cardápio",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ETag da cópia que o cliente tem, respondido com 304 enquanto estiver atual",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified da cópia que o cliente tem, usado apenas sem If-None-Match",
+                        "name": "If-Modified-Since",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.CategoryOutput"
+                            }
+                        }
+                    },
+                    "304": {
+                        "description": "Not modified"
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/checkout": {
+            "post": {
+                "description": "Cria um novo pedido com os dados fornecidos",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "checkout"
+                ],
+                "summary": "Cria um novo pedido",
+                "parameters": [
+                    {
+                        "description": "Dados do Pedido",
+                        "name": "order",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateOrderRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.OrderResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "402": {
+                        "description": "Payment Required",
+                        "schema": {
+                            "$ref": "#/definitions/handler.PaymentDeclinedResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/handler.InsufficientStockResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/clients": {
+            "post": {
+                "description": "Cria um novo cliente com os dados fornecidos",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "clients"
+                ],
+                "summary": "Cria um novo cliente",
+                "parameters": [
+                    {
+                        "description": "Dados do Cliente",
+                        "name": "client",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ClientInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ClientOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/clients/{cpf}": {
+            "get": {
+                "description": "Retorna os dados de um cliente específico pelo CPF",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "clients"
+                ],
+                "summary": "Obtém um cliente pelo CPF",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "CPF do Cliente",
+                        "name": "cpf",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ClientOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/clients/{cpf}/loyalty": {
+            "get": {
+                "description": "Retorna o saldo de pontos, o valor em dinheiro equivalente e o extrato de lançamentos do cliente",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "clients"
+                ],
+                "summary": "Obtém o saldo de pontos de fidelidade do cliente",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "CPF do Cliente",
+                        "name": "cpf",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ClientLoyaltyOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/healthcheck": {
+            "get": {
+                "description": "Verifica se a aplicação está respondendo para o liveness e readiness probe do K8s",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "healthcheck"
+                ],
+                "summary": "Verifica se a aplicação está respondendo",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.HealthCheckOutput"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/images/{key}": {
+            "get": {
+                "description": "Serves an uploaded product image or thumbnail. Keys are never reused, so responses are cached for a year",
+                "produces": [
+                    "image/jpeg",
+                    "image/png",
+                    "image/gif"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "Get Image",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Storage key, like products/1/{uuid}/small.jpg",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/{id}": {
+            "get": {
+                "description": "Obtém um pedido com o ID fornecido",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Obtém um pedido por ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID do Pedido",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.OrderResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/{id}/payments": {
+            "post": {
+                "description": "Cria uma nova tentativa de pagamento, com o mesmo ou outro método, para um pedido em payment_failed. O pedido é cancelado se não for pago dentro da janela configurada",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Paga novamente um pedido com pagamento recusado",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID do Pedido",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Método de pagamento",
+                        "name": "payment",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreatePaymentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.OrderResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "402": {
+                        "description": "Payment Required",
+                        "schema": {
+                            "$ref": "#/definitions/handler.PaymentDeclinedResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/{id}/receipt": {
+            "get": {
+                "description": "Retorna o recibo do pedido com itens, descontos, taxas, forma de pagamento e CPF do consumidor. Use format=pdf para a versão para impressão ou format=xml para exportar no layout da NFC-e (sem assinatura)",
+                "produces": [
+                    "application/json",
+                    "application/pdf",
+                    "application/xml"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Obtém o recibo de um pedido pago",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID do Pedido",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "pdf",
+                            "xml"
+                        ],
+                        "type": "string",
+                        "default": "json",
+                        "description": "Formato do recibo",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReceiptOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/products": {
+            "get": {
+                "description": "Get Products",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "Get Products",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size",
+                        "name": "pageSize",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Category",
+                        "name": "category",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Busca por nome, categoria e descrição, tolera acentos e erros de digitação",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only products that can be ordered now",
+                        "name": "available",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only products that can be ordered at this RFC 3339 time, like 2025-01-06T08:30:00-03:00",
+                        "name": "at",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Drops products containing any of these comma-separated allergens, like gluten,milk",
+                        "name": "exclude_allergens",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only products with all of these comma-separated dietary tags: vegan, vegetarian, gluten_free, lactose_free",
+                        "name": "diet",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the copy the client has, answered with 304 when it is still current",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified of the copy the client has, only used without If-None-Match",
+                        "name": "If-Modified-Since",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.ProductOutput"
+                            }
+                        }
+                    },
+                    "304": {
+                        "description": "Not modified"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/products/recommendations": {
+            "get": {
+                "description": "Products frequently bought together with those in the cart, like a dessert for a burger and fries. Products already in the cart are never suggested",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "Get Cart Recommendations",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Comma-separated ids of the products in the cart, like 1,5,8",
+                        "name": "product_ids",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "How many products to recommend, 4 by default and at most 20",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.ProductOutput"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/products/{id}/recommendations": {
+            "get": {
+                "description": "Products frequently bought together with the product in paid orders, most frequent first. Only products that can be ordered now are recommended",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "Get Product Recommendations",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "How many products to recommend, 4 by default and at most 20",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.ProductOutput"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/sandbox/payments": {
+            "get": {
+                "description": "Disponível apenas com SANDBOX_ENABLED=true. Lista os pagamentos que aguardam a notificação do gateway",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "sandbox"
+                ],
+                "summary": "Lista os pagamentos pendentes (sandbox)",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.SandboxPaymentOutput"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/sandbox/payments/{external_reference}/notifications": {
+            "post": {
+                "description": "Disponível apenas com SANDBOX_ENABLED=true. Envia ao webhook de pagamentos uma notificação assinada aprovando ou recusando o pagamento. Com delay_seconds a entrega é agendada e a resposta é 202, duplicates envia cópias simultâneas para reproduzir entregas concorrentes",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "sandbox"
+                ],
+                "summary": "Simula a notificação do gateway para um pagamento (sandbox)",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Referência externa do pagamento",
+                        "name": "external_reference",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Notificação",
+                        "name": "notification",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.SandboxNotificationInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "502": {
+                        "description": "Bad Gateway",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "dto.AllergenWarningResponse": {
+            "type": "object",
+            "properties": {
+                "allergen": {
+                    "type": "string",
+                    "example": "milk"
+                },
+                "product_names": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "X-Burger",
+                        "Milkshake"
+                    ]
+                }
+            }
+        },
+        "dto.AvailabilityInput": {
+            "type": "object",
+            "properties": {
+                "windows": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.AvailabilityWindowInput"
+                    }
+                }
+            }
+        },
+        "dto.AvailabilityOutput": {
+            "type": "object",
+            "properties": {
+                "windows": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.AvailabilityWindowOutput"
+                    }
+                }
+            }
+        },
+        "dto.AvailabilityWindowInput": {
+            "type": "object",
+            "required": [
+                "end",
+                "start",
+                "weekday"
+            ],
+            "properties": {
+                "end": {
+                    "type": "string",
+                    "example": "11:00"
+                },
+                "start": {
+                    "type": "string",
+                    "example": "06:00"
+                },
+                "weekday": {
+                    "description": "Weekday goes from 0 (Sunday) to 6 (Saturday)",
+                    "type": "integer",
+                    "maximum": 6,
+                    "minimum": 0,
+                    "example": 1
+                }
+            }
+        },
+        "dto.AvailabilityWindowOutput": {
+            "type": "object",
+            "properties": {
+                "end": {
+                    "type": "string",
+                    "example": "11:00"
+                },
+                "start": {
+                    "type": "string",
+                    "example": "06:00"
+                },
+                "weekday": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "dto.CardResponse": {
+            "type": "object",
+            "properties": {
+                "brand": {
+                    "type": "string",
+                    "example": "visa"
+                },
+                "last4": {
+                    "type": "string",
+                    "example": "1111"
+                }
+            }
+        },
+        "dto.CatalogImportOutput": {
+            "type": "object",
+            "properties": {
+                "created": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "dry_run": {
+                    "type": "boolean"
+                },
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.CatalogRowError"
+                    }
+                },
+                "updated": {
+                    "type": "integer",
+                    "example": 12
+                }
+            }
+        },
+        "dto.CatalogProduct": {
+            "type": "object",
+            "required": [
+                "category",
+                "description",
+                "name",
+                "sku"
+            ],
+            "properties": {
+                "allergens": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "gluten",
+                        "milk"
+                    ]
+                },
+                "category": {
+                    "type": "string",
+                    "minLength": 3,
+                    "example": "lanche"
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "BRL"
+                },
+                "description": {
+                    "type": "string",
+                    "minLength": 10,
+                    "example": "Hambúrguer de carne bovina"
+                },
+                "dietary_tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "vegetarian"
+                    ]
+                },
+                "images": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "name": {
+                    "type": "string",
+                    "minLength": 2,
+                    "example": "X-Burger"
+                },
+                "nutrition": {
+                    "$ref": "#/definitions/dto.NutritionInput"
+                },
+                "price_cents": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 1990
+                },
+                "sku": {
+                    "type": "string",
+                    "maxLength": 64,
+                    "example": "XB-001"
+                }
+            }
+        },
+        "dto.CatalogRowError": {
+            "type": "object",
+            "properties": {
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/validator.ErrorResponse"
+                    }
+                },
+                "row": {
+                    "type": "integer",
+                    "example": 4
+                },
+                "sku": {
+                    "type": "string",
+                    "example": "XB-001"
+                }
+            }
+        },
+        "dto.CategoryInput": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "active": {
+                    "type": "boolean"
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "display_order": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 5
+                },
+                "image": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "https://cdn.example.com/categorias/paes.png"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 2,
+                    "example": "Pães \u0026 Doces"
+                }
+            }
+        },
+        "dto.CategoryOutput": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "display_order": {
+                    "type": "integer"
+                },
+                "handle": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "image": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "product_count": {
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ClientDTO": {
+            "type": "object",
+            "properties": {
+                "cpf": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ClientInput": {
+            "type": "object",
+            "required": [
+                "cpf",
+                "name"
+            ],
+            "properties": {
+                "cpf": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100
+                }
+            }
+        },
+        "dto.ClientLoyaltyOutput": {
+            "type": "object",
+            "properties": {
+                "balance": {
+                    "type": "integer"
+                },
+                "balance_value": {
+                    "$ref": "#/definitions/entities.Money"
+                },
+                "client_id": {
+                    "type": "integer"
+                },
+                "cpf": {
+                    "type": "string"
+                },
+                "entries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.LoyaltyEntryOutput"
+                    }
+                },
+                "point_value": {
+                    "$ref": "#/definitions/entities.Money"
+                }
+            }
+        },
+        "dto.ClientOutput": {
+            "type": "object",
+            "properties": {
+                "cpf": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.CouponInput": {
+            "type": "object",
+            "required": [
+                "amount_type",
+                "code"
+            ],
+            "properties": {
+                "active": {
+                    "type": "boolean"
+                },
+                "amount_type": {
+                    "type": "string",
+                    "enum": [
+                        "fixed",
+                        "percentage"
+                    ]
+                },
+                "amount_value": {
+                    "type": "number"
+                },
+                "category_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "code": {
+                    "type": "string",
+                    "maxLength": 50,
+                    "minLength": 3
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "ends_at": {
+                    "type": "string"
+                },
+                "max_redemptions": {
+                    "type": "integer"
+                },
+                "max_redemptions_per_client": {
+                    "type": "integer"
+                },
+                "min_order_amount": {
+                    "$ref": "#/definitions/entities.Money"
+                },
+                "product_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "starts_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.CouponOutput": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean"
+                },
+                "amount_type": {
+                    "type": "string"
+                },
+                "amount_value": {
+                    "type": "number"
+                },
+                "category_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "code": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "ends_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "max_redemptions": {
+                    "type": "integer"
+                },
+                "max_redemptions_per_client": {
+                    "type": "integer"
+                },
+                "min_order_amount": {
+                    "$ref": "#/definitions/entities.Money"
+                },
+                "product_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "redemptions_count": {
+                    "type": "integer"
+                },
+                "starts_at": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.CreateCardRequest": {
+            "type": "object",
+            "required": [
+                "cvv",
+                "exp_month",
+                "exp_year",
+                "holder_name",
+                "number"
+            ],
+            "properties": {
+                "cvv": {
+                    "type": "string",
+                    "example": "123"
+                },
+                "exp_month": {
+                    "type": "integer",
+                    "maximum": 12,
+                    "minimum": 1,
+                    "example": 12
+                },
+                "exp_year": {
+                    "type": "integer",
+                    "example": 2030
+                },
+                "holder_name": {
+                    "type": "string",
+                    "example": "MARIA SILVA"
+                },
+                "number": {
+                    "type": "string",
+                    "example": "4111111111111111"
+                }
+            }
+        },
+        "dto.CreateOrderItemRequest": {
+            "type": "object",
+            "required": [
+                "product_id",
+                "quantity"
+            ],
+            "properties": {
+                "product_id": {
+                    "type": "integer"
+                },
+                "quantity": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "variant_id": {
+                    "description": "VariantID is required for products with variants, like the size of a drink",
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 3
+                }
+            }
+        },
+        "dto.CreateOrderRequest": {
+            "type": "object",
+            "properties": {
+                "client_id": {
+                    "type": "integer"
+                },
+                "coupon_code": {
+                    "type": "string",
+                    "example": "PROMO10"
+                },
+                "delivery": {
+                    "type": "boolean"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.CreateOrderItemRequest"
+                    }
+                },
+                "loyalty_points": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "payment": {
+                    "$ref": "#/definitions/dto.CreatePaymentRequest"
+                }
+            }
+        },
+        "dto.CreatePaymentRequest": {
+            "type": "object",
+            "required": [
+                "method"
+            ],
+            "properties": {
+                "card": {
+                    "$ref": "#/definitions/dto.CreateCardRequest"
+                },
+                "method": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.HealthCheckOutput": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.IngredientCountInput": {
+            "type": "object",
+            "required": [
+                "counted_quantity"
+            ],
+            "properties": {
+                "counted_quantity": {
+                    "type": "number",
+                    "minimum": 0,
+                    "example": 87.5
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "Contagem semanal"
+                }
+            }
+        },
+        "dto.IngredientCountOutput": {
+            "type": "object",
+            "properties": {
+                "counted_at": {
+                    "type": "string"
+                },
+                "counted_quantity": {
+                    "type": "number"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "ingredient_id": {
+                    "type": "integer"
+                },
+                "theoretical_quantity": {
+                    "type": "number"
+                },
+                "variance": {
+                    "type": "number"
+                }
+            }
+        },
+        "dto.IngredientInput": {
+            "type": "object",
+            "required": [
+                "name",
+                "unit"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 2,
+                    "example": "Pão de hambúrguer"
+                },
+                "unit": {
+                    "type": "string",
+                    "enum": [
+                        "g",
+                        "kg",
+                        "ml",
+                        "l",
+                        "unit"
+                    ],
+                    "example": "unit"
+                }
+            }
+        },
+        "dto.IngredientMovementOutput": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "ingredient_id": {
+                    "type": "integer"
+                },
+                "order_id": {
+                    "type": "integer"
+                },
+                "quantity": {
+                    "type": "number"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "receipt"
+                }
+            }
+        },
+        "dto.IngredientOutput": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "stock_quantity": {
+                    "type": "number"
+                },
+                "unit": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.IngredientReceiptInput": {
+            "type": "object",
+            "required": [
+                "quantity"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "NF 4521 - Padaria Central"
+                },
+                "quantity": {
+                    "type": "number",
+                    "example": 120
+                }
+            }
+        },
+        "dto.InventoryReportLineOutput": {
+            "type": "object",
+            "properties": {
+                "ingredient_id": {
+                    "type": "integer"
+                },
+                "last_count": {
+                    "$ref": "#/definitions/dto.IngredientCountOutput"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "theoretical_quantity": {
+                    "type": "number"
+                },
+                "unit": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.InventoryReportOutput": {
+            "type": "object",
+            "properties": {
+                "ingredients": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.InventoryReportLineOutput"
+                    }
+                }
+            }
+        },
+        "dto.LoyaltyEntryOutput": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "order_id": {
+                    "type": "integer"
+                },
+                "points": {
+                    "type": "integer"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "earn"
+                }
+            }
+        },
+        "dto.NutritionInput": {
+            "type": "object",
+            "properties": {
+                "carbohydrates_g": {
+                    "type": "number",
+                    "minimum": 0,
+                    "example": 42
+                },
+                "energy_kcal": {
+                    "type": "number",
+                    "minimum": 0,
+                    "example": 520
+                },
+                "fat_g": {
+                    "type": "number",
+                    "minimum": 0,
+                    "example": 26
+                },
+                "fiber_g": {
+                    "type": "number",
+                    "minimum": 0,
+                    "example": 3
+                },
+                "protein_g": {
+                    "type": "number",
+                    "minimum": 0,
+                    "example": 28
+                },
+                "saturated_fat_g": {
+                    "type": "number",
+                    "minimum": 0,
+                    "example": 11
+                },
+                "serving_size_g": {
+                    "type": "number",
+                    "example": 180
+                },
+                "sodium_mg": {
+                    "type": "number",
+                    "minimum": 0,
+                    "example": 890
+                },
+                "sugars_g": {
+                    "type": "number",
+                    "minimum": 0,
+                    "example": 8.5
+                }
+            }
+        },
+        "dto.NutritionOutput": {
+            "type": "object",
+            "properties": {
+                "carbohydrates_g": {
+                    "type": "number",
+                    "example": 42
+                },
+                "energy_kcal": {
+                    "type": "number",
+                    "example": 520
+                },
+                "fat_g": {
+                    "type": "number",
+                    "example": 26
+                },
+                "fiber_g": {
+                    "type": "number",
+                    "example": 3
+                },
+                "protein_g": {
+                    "type": "number",
+                    "example": 28
+                },
+                "saturated_fat_g": {
+                    "type": "number",
+                    "example": 11
+                },
+                "serving_size_g": {
+                    "type": "number",
+                    "example": 180
+                },
+                "sodium_mg": {
+                    "type": "number",
+                    "example": 890
+                },
+                "sugars_g": {
+                    "type": "number",
+                    "example": 8.5
+                }
+            }
+        },
+        "dto.OrderDTO": {
+            "type": "object",
+            "properties": {
+                "client": {
+                    "$ref": "#/definitions/dto.ClientDTO"
+                },
+                "client_id": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.OrderItemDTO"
+                    }
+                },
+                "payment": {
+                    "$ref": "#/definitions/dto.PaymentDTO"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.OrderDiscountResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "$ref": "#/definitions/entities.Money"
+                },
+                "amount_type": {
+                    "type": "string"
+                },
+                "amount_value": {
+                    "type": "number"
+                },
+                "code": {
+                    "type": "string"
+                },
+                "coupon_id": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "loyalty_points": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.OrderFeeResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "$ref": "#/definitions/entities.Money"
+                },
+                "amount_type": {
+                    "type": "string"
+                },
+                "amount_value": {
+                    "type": "number"
+                },
+                "applicable_to": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "payment_tax_setting_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.OrderItemDTO": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "order_id": {
+                    "type": "integer"
+                },
+                "price": {
+                    "$ref": "#/definitions/entities.Money"
+                },
+                "product": {
+                    "$ref": "#/definitions/dto.ProductDTO"
+                },
+                "product_id": {
+                    "type": "integer"
+                },
+                "quantity": {
+                    "type": "integer"
+                },
+                "variant_id": {
+                    "description": "VariantID and VariantName are the size ordered, omitted for products without variants",
+                    "type": "integer",
+                    "example": 3
+                },
+                "variant_name": {
+                    "type": "string",
+                    "example": "500ml"
+                }
+            }
+        },
+        "dto.OrderItemResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "order_id": {
+                    "type": "integer"
+                },
+                "price": {
+                    "$ref": "#/definitions/entities.Money"
+                },
+                "product_id": {
+                    "type": "integer"
+                },
+                "quantity": {
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "variant_id": {
+                    "description": "VariantID and VariantName are the size ordered, omitted for products without variants",
+                    "type": "integer",
+                    "example": 3
+                },
+                "variant_name": {
+                    "type": "string",
+                    "example": "500ml"
+                }
+            }
+        },
+        "dto.OrderResponse": {
+            "type": "object",
+            "properties": {
+                "allergen_warnings": {
+                    "description": "AllergenWarnings lists the allergens in the order and the products containing them, only at checkout",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.AllergenWarningResponse"
+                    }
+                },
+                "client_id": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "delivery": {
+                    "type": "boolean"
+                },
+                "discounts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.OrderDiscountResponse"
+                    }
+                },
+                "fees": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.OrderFeeResponse"
+                    }
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.OrderItemResponse"
+                    }
+                },
+                "payment": {
+                    "$ref": "#/definitions/dto.PaymentResponse"
+                },
+                "payment_failed_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "subtotal": {
+                    "$ref": "#/definitions/entities.Money"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.PaginatedOrdersDTO": {
+            "type": "object",
+            "properties": {
+                "orders": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.OrderDTO"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.PaymentDTO": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "$ref": "#/definitions/entities.Money"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "method": {
+                    "type": "string"
+                },
+                "order_id": {
+                    "type": "integer"
+                },
+                "refunded_amount": {
+                    "$ref": "#/definitions/entities.Money"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.PaymentDiscrepancy": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "external_reference": {
+                    "type": "string"
+                },
+                "gateway_status": {
+                    "type": "string"
+                },
+                "local_status": {
+                    "type": "string"
+                },
+                "method": {
+                    "type": "string"
+                },
+                "order_id": {
+                    "type": "integer"
+                },
+                "payment_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.PaymentReconciliationSummary": {
+            "type": "object",
+            "properties": {
+                "approved": {
+                    "type": "integer"
+                },
+                "checked": {
+                    "type": "integer"
+                },
+                "discrepancies": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.PaymentDiscrepancy"
+                    }
+                },
+                "errors": {
+                    "type": "integer"
+                },
+                "failed": {
+                    "type": "integer"
+                },
+                "finished_at": {
+                    "type": "string"
+                },
+                "started_at": {
+                    "type": "string"
+                },
+                "still_pending": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.PaymentResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "$ref": "#/definitions/entities.Money"
+                },
+                "card": {
+                    "$ref": "#/definitions/dto.CardResponse"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "method": {
+                    "type": "string"
+                },
+                "order_id": {
+                    "type": "integer"
+                },
+                "qr_data": {
+                    "type": "string"
+                },
+                "refunded_amount": {
+                    "$ref": "#/definitions/entities.Money"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.PaymentTaxSettingInput": {
+            "type": "object",
+            "required": [
+                "amount_type",
+                "applicable_to",
+                "name"
+            ],
+            "properties": {
+                "active": {
+                    "type": "boolean"
+                },
+                "amount_type": {
+                    "type": "string",
+                    "enum": [
+                        "fixed",
+                        "percentage"
+                    ]
+                },
+                "amount_value": {
+                    "type": "number",
+                    "minimum": 0
+                },
+                "applicable_to": {
+                    "type": "string",
+                    "enum": [
+                        "credit_card",
+                        "qr_code",
+                        "platform_fee",
+                        "delivery"
+                    ]
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 2
+                }
+            }
+        },
+        "dto.PaymentTaxSettingOutput": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean"
+                },
+                "amount_type": {
+                    "type": "string"
+                },
+                "amount_value": {
+                    "type": "number"
+                },
+                "applicable_to": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ProductCacheStatsOutput": {
+            "type": "object",
+            "properties": {
+                "enabled": {
+                    "type": "boolean"
+                },
+                "errors": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "hit_ratio": {
+                    "description": "HitRatio is hits over all reads, 0 before the first one",
+                    "type": "number",
+                    "example": 0.94
+                },
+                "hits": {
+                    "type": "integer",
+                    "example": 1250
+                },
+                "invalidations": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "misses": {
+                    "type": "integer",
+                    "example": 80
+                },
+                "version": {
+                    "type": "integer",
+                    "example": 42
+                }
+            }
+        },
+        "dto.ProductDTO": {
+            "type": "object",
+            "properties": {
+                "category_handle": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "images": {},
+                "name": {
+                    "type": "string"
+                },
+                "price": {
+                    "$ref": "#/definitions/entities.Money"
+                }
+            }
+        },
+        "dto.ProductImageOutput": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer",
+                    "example": 7
+                },
+                "thumbnails": {
+                    "description": "Thumbnails maps each size, small (200px) and medium (600px), to its URL. Empty for external images",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "url": {
+                    "type": "string",
+                    "example": "http://localhost:8080/api/v1/images/products/1/0b5c.../original.jpg"
+                }
+            }
+        },
+        "dto.ProductInputCreate": {
+            "type": "object",
+            "required": [
+                "category",
+                "description",
+                "images",
+                "name",
+                "price"
+            ],
+            "properties": {
+                "allergens": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "gluten",
+                        "milk"
+                    ]
+                },
+                "category": {
+                    "type": "string",
+                    "minLength": 3
+                },
+                "description": {
+                    "type": "string",
+                    "minLength": 10
+                },
+                "dietary_tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "vegetarian"
+                    ]
+                },
+                "images": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
                     }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/dto.ProductOutput"
-                            }
+                },
+                "name": {
+                    "type": "string",
+                    "minLength": 2
+                },
+                "nutrition": {
+                    "description": "Nutrition is optional, allergens and dietary tags use the vocabulary listed in the docs",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/dto.NutritionInput"
                         }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/handler.ErrorResponse"
+                    ]
+                },
+                "price": {
+                    "$ref": "#/definitions/entities.Money"
+                },
+                "sku": {
+                    "description": "SKU is optional, products without it get one like PRD-000042",
+                    "type": "string",
+                    "maxLength": 64,
+                    "example": "XB-001"
+                },
+                "stock": {
+                    "description": "Stock is optional, products without it are not tracked",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/dto.ProductStockInput"
                         }
+                    ]
+                }
+            }
+        },
+        "dto.ProductInputUpdate": {
+            "type": "object",
+            "required": [
+                "id"
+            ],
+            "properties": {
+                "allergens": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
                     },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/handler.ErrorResponse"
-                        }
+                    "example": [
+                        "gluten",
+                        "milk"
+                    ]
+                },
+                "category": {
+                    "type": "string",
+                    "minLength": 3
+                },
+                "description": {
+                    "type": "string",
+                    "minLength": 10
+                },
+                "dietary_tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "vegetarian"
+                    ]
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "images": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
                     }
+                },
+                "name": {
+                    "type": "string",
+                    "minLength": 2
+                },
+                "nutrition": {
+                    "description": "Nutrition, Allergens and DietaryTags are kept when omitted, an empty list clears them",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/dto.NutritionInput"
+                        }
+                    ]
+                },
+                "price": {
+                    "$ref": "#/definitions/entities.Money"
+                },
+                "sku": {
+                    "type": "string",
+                    "maxLength": 64,
+                    "example": "XB-001"
                 }
             }
-        }
-    },
-    "definitions": {
-        "dto.ClientDTO": {
+        },
+        "dto.ProductOutput": {
             "type": "object",
             "properties": {
-                "cpf": {
+                "allergens": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "gluten",
+                        "milk"
+                    ]
+                },
+                "availability": {
+                    "description": "Availability lists when the product can be ordered, in the store timezone. Empty means always",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.AvailabilityWindowOutput"
+                    }
+                },
+                "available": {
+                    "description": "Available is false while an ingredient of the recipe is out, the product cannot be ordered",
+                    "type": "boolean"
+                },
+                "category": {
+                    "type": "string"
+                },
+                "deleted_at": {
+                    "description": "DeletedAt is only sent for deleted products, listed by admins",
+                    "type": "string"
+                },
+                "description": {
                     "type": "string"
                 },
+                "dietary_tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "vegetarian"
+                    ]
+                },
                 "id": {
                     "type": "integer"
                 },
+                "image_details": {
+                    "description": "ImageDetails carries the ids of the images, to delete them, and the thumbnails of uploaded ones",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ProductImageOutput"
+                    }
+                },
+                "images": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "name": {
                     "type": "string"
+                },
+                "nutrition": {
+                    "description": "Nutrition is omitted when the facts were not informed",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/dto.NutritionOutput"
+                        }
+                    ]
+                },
+                "price": {
+                    "$ref": "#/definitions/entities.Money"
+                },
+                "sku": {
+                    "type": "string",
+                    "example": "XB-001"
+                },
+                "stock_quantity": {
+                    "description": "StockQuantity is only sent for products with tracked stock",
+                    "type": "integer",
+                    "example": 12
+                },
+                "stock_status": {
+                    "description": "StockStatus is untracked, in_stock, low_stock or out_of_stock",
+                    "type": "string",
+                    "example": "in_stock"
+                },
+                "variants": {
+                    "description": "Variants are the sizes to choose from, order items send the id of the chosen one. Empty for products ordered as they are",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ProductVariantOutput"
+                    }
                 }
             }
         },
-        "dto.ClientInput": {
+        "dto.ProductPriceInput": {
             "type": "object",
             "required": [
-                "cpf",
-                "name"
+                "price"
             ],
             "properties": {
-                "cpf": {
-                    "type": "string"
-                },
-                "name": {
+                "effective_at": {
                     "type": "string",
-                    "maxLength": 100
+                    "example": "2025-01-01T00:00:00-03:00"
+                },
+                "price": {
+                    "$ref": "#/definitions/entities.Money"
                 }
             }
         },
-        "dto.ClientOutput": {
+        "dto.ProductPriceOutput": {
             "type": "object",
             "properties": {
-                "cpf": {
+                "applied_at": {
+                    "type": "string"
+                },
+                "canceled_at": {
                     "type": "string"
                 },
+                "changed_by": {
+                    "type": "string",
+                    "example": "maria"
+                },
                 "created_at": {
                     "type": "string"
                 },
+                "effective_at": {
+                    "type": "string"
+                },
                 "id": {
                     "type": "integer"
                 },
-                "name": {
-                    "type": "string"
+                "price": {
+                    "$ref": "#/definitions/entities.Money"
                 },
-                "updated_at": {
-                    "type": "string"
+                "product_id": {
+                    "type": "integer"
+                },
+                "status": {
+                    "description": "Status is scheduled, applied or canceled",
+                    "type": "string",
+                    "example": "scheduled"
                 }
             }
         },
-        "dto.CreateOrderItemRequest": {
+        "dto.ProductStockInput": {
             "type": "object",
-            "required": [
-                "product_id",
-                "quantity"
-            ],
             "properties": {
-                "product_id": {
-                    "type": "integer"
+                "low_stock_threshold": {
+                    "type": "integer",
+                    "minimum": 0
                 },
                 "quantity": {
                     "type": "integer",
-                    "minimum": 1
+                    "minimum": 0
+                },
+                "track_stock": {
+                    "type": "boolean"
                 }
             }
         },
-        "dto.CreateOrderRequest": {
+        "dto.ProductVariantInput": {
             "type": "object",
+            "required": [
+                "name",
+                "price"
+            ],
             "properties": {
-                "client_id": {
-                    "type": "integer"
+                "display_order": {
+                    "type": "integer",
+                    "example": 1
                 },
-                "items": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 50,
+                    "example": "500ml"
+                },
+                "price": {
+                    "$ref": "#/definitions/entities.Money"
+                },
+                "sku": {
+                    "type": "string",
+                    "maxLength": 64,
+                    "example": "COCA-500ML"
+                },
+                "stock": {
+                    "description": "Stock is optional, variants without it are not tracked",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/dto.ProductStockInput"
+                        }
+                    ]
+                }
+            }
+        },
+        "dto.ProductVariantOutput": {
+            "type": "object",
+            "properties": {
+                "display_order": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "name": {
+                    "type": "string",
+                    "example": "500ml"
+                },
+                "price": {
+                    "$ref": "#/definitions/entities.Money"
+                },
+                "sku": {
+                    "type": "string",
+                    "example": "COCA-500ML"
+                },
+                "stock_quantity": {
+                    "description": "StockQuantity is only sent for variants with tracked stock",
+                    "type": "integer",
+                    "example": 12
+                },
+                "stock_status": {
+                    "description": "StockStatus is untracked, in_stock, low_stock or out_of_stock",
+                    "type": "string",
+                    "example": "in_stock"
+                }
+            }
+        },
+        "dto.ReassignCategoryProductsInput": {
+            "type": "object",
+            "required": [
+                "target_category_id"
+            ],
+            "properties": {
+                "product_ids": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/dto.CreateOrderItemRequest"
+                        "type": "integer"
                     }
                 },
-                "payment": {
-                    "$ref": "#/definitions/dto.CreatePaymentRequest"
+                "target_category_id": {
+                    "type": "integer"
                 }
             }
         },
-        "dto.CreatePaymentRequest": {
+        "dto.ReassignCategoryProductsOutput": {
             "type": "object",
-            "required": [
-                "method"
-            ],
             "properties": {
-                "method": {
-                    "type": "string"
+                "reassigned": {
+                    "type": "integer"
                 }
             }
         },
-        "dto.HealthCheckOutput": {
+        "dto.ReceiptAdjustmentOutput": {
             "type": "object",
             "properties": {
-                "status": {
+                "amount": {
+                    "$ref": "#/definitions/entities.Money"
+                },
+                "description": {
                     "type": "string"
                 }
             }
         },
-        "dto.OrderDTO": {
+        "dto.ReceiptCustomerOutput": {
             "type": "object",
             "properties": {
-                "client": {
-                    "$ref": "#/definitions/dto.ClientDTO"
-                },
-                "client_id": {
-                    "type": "integer"
-                },
-                "id": {
-                    "type": "integer"
+                "cpf": {
+                    "type": "string"
                 },
-                "items": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/dto.OrderItemDTO"
-                    }
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ReceiptIssuerOutput": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string"
                 },
-                "payment": {
-                    "$ref": "#/definitions/dto.PaymentDTO"
+                "cnpj": {
+                    "type": "string"
                 },
-                "status": {
+                "name": {
                     "type": "string"
                 }
             }
         },
-        "dto.OrderItemDTO": {
+        "dto.ReceiptItemOutput": {
             "type": "object",
             "properties": {
-                "id": {
-                    "type": "integer"
-                },
-                "order_id": {
-                    "type": "integer"
-                },
-                "price": {
-                    "type": "number"
-                },
-                "product": {
-                    "$ref": "#/definitions/dto.ProductDTO"
+                "name": {
+                    "type": "string"
                 },
                 "product_id": {
                     "type": "integer"
                 },
                 "quantity": {
                     "type": "integer"
+                },
+                "total": {
+                    "$ref": "#/definitions/entities.Money"
+                },
+                "unit_price": {
+                    "$ref": "#/definitions/entities.Money"
                 }
             }
         },
-        "dto.OrderItemResponse": {
+        "dto.ReceiptOutput": {
             "type": "object",
             "properties": {
-                "created_at": {
-                    "type": "string"
+                "customer": {
+                    "$ref": "#/definitions/dto.ReceiptCustomerOutput"
                 },
-                "id": {
-                    "type": "integer"
+                "discounts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ReceiptAdjustmentOutput"
+                    }
                 },
-                "order_id": {
-                    "type": "integer"
+                "fees": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ReceiptAdjustmentOutput"
+                    }
                 },
-                "price": {
-                    "type": "number"
+                "issued_at": {
+                    "type": "string"
                 },
-                "product_id": {
-                    "type": "integer"
+                "issuer": {
+                    "$ref": "#/definitions/dto.ReceiptIssuerOutput"
                 },
-                "quantity": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ReceiptItemOutput"
+                    }
+                },
+                "number": {
                     "type": "integer"
                 },
-                "updated_at": {
-                    "type": "string"
+                "payment": {
+                    "$ref": "#/definitions/dto.ReceiptPaymentOutput"
+                },
+                "subtotal": {
+                    "$ref": "#/definitions/entities.Money"
+                },
+                "total": {
+                    "$ref": "#/definitions/entities.Money"
                 }
             }
         },
-        "dto.OrderResponse": {
+        "dto.ReceiptPaymentOutput": {
             "type": "object",
             "properties": {
-                "client_id": {
-                    "type": "integer"
+                "amount": {
+                    "$ref": "#/definitions/entities.Money"
                 },
-                "created_at": {
+                "method": {
                     "type": "string"
                 },
-                "id": {
-                    "type": "integer"
+                "refunded_amount": {
+                    "$ref": "#/definitions/entities.Money"
                 },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.RecipeInput": {
+            "type": "object",
+            "properties": {
                 "items": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/dto.OrderItemResponse"
+                        "$ref": "#/definitions/dto.RecipeItemInput"
                     }
+                }
+            }
+        },
+        "dto.RecipeItemInput": {
+            "type": "object",
+            "required": [
+                "ingredient_id",
+                "quantity"
+            ],
+            "properties": {
+                "ingredient_id": {
+                    "type": "integer",
+                    "example": 1
                 },
-                "payment": {
-                    "$ref": "#/definitions/dto.PaymentResponse"
+                "quantity": {
+                    "type": "number",
+                    "example": 1
+                }
+            }
+        },
+        "dto.RecipeItemOutput": {
+            "type": "object",
+            "properties": {
+                "ingredient_id": {
+                    "type": "integer"
                 },
-                "status": {
+                "ingredient_name": {
                     "type": "string"
                 },
-                "updated_at": {
+                "quantity": {
+                    "type": "number"
+                },
+                "unit": {
                     "type": "string"
                 }
             }
         },
-        "dto.PaginatedOrdersDTO": {
+        "dto.RecipeOutput": {
             "type": "object",
             "properties": {
-                "orders": {
+                "items": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/dto.OrderDTO"
+                        "$ref": "#/definitions/dto.RecipeItemOutput"
                     }
                 },
-                "total": {
+                "product_id": {
                     "type": "integer"
                 }
             }
         },
-        "dto.PaymentDTO": {
+        "dto.RefundInput": {
             "type": "object",
             "properties": {
                 "amount": {
-                    "type": "number"
-                },
-                "id": {
-                    "type": "integer"
-                },
-                "method": {
-                    "type": "string"
+                    "$ref": "#/definitions/entities.Money"
                 },
-                "order_id": {
-                    "type": "integer"
-                },
-                "status": {
-                    "type": "string"
+                "reason": {
+                    "type": "string",
+                    "maxLength": 255
                 }
             }
         },
-        "dto.PaymentResponse": {
+        "dto.RefundOutput": {
             "type": "object",
             "properties": {
                 "amount": {
-                    "type": "number"
+                    "$ref": "#/definitions/entities.Money"
                 },
                 "created_at": {
                     "type": "string"
                 },
+                "external_reference": {
+                    "type": "string"
+                },
                 "id": {
                     "type": "integer"
                 },
-                "method": {
-                    "type": "string"
-                },
                 "order_id": {
                     "type": "integer"
                 },
-                "qr_data": {
+                "payment_id": {
+                    "type": "integer"
+                },
+                "reason": {
                     "type": "string"
                 },
                 "status": {
@@ -824,134 +5521,156 @@ const docTemplate = `{
                 }
             }
         },
-        "dto.ProductDTO": {
+        "dto.SandboxNotificationInput": {
             "type": "object",
+            "required": [
+                "payment_method",
+                "status"
+            ],
             "properties": {
-                "category_handle": {
+                "delay_seconds": {
+                    "type": "integer",
+                    "maximum": 300,
+                    "minimum": 0,
+                    "example": 0
+                },
+                "duplicates": {
+                    "type": "integer",
+                    "maximum": 10,
+                    "minimum": 0,
+                    "example": 0
+                },
+                "payment_method": {
+                    "type": "string",
+                    "example": "qr_code"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "approved",
+                        "failed"
+                    ],
+                    "example": "approved"
+                }
+            }
+        },
+        "dto.SandboxPaymentOutput": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "$ref": "#/definitions/entities.Money"
+                },
+                "created_at": {
                     "type": "string"
                 },
-                "description": {
+                "external_reference": {
                     "type": "string"
                 },
                 "id": {
                     "type": "integer"
                 },
-                "images": {},
-                "name": {
+                "method": {
                     "type": "string"
                 },
-                "price": {
-                    "type": "number"
+                "order_id": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string"
                 }
             }
         },
-        "dto.ProductInputCreate": {
+        "entities.Money": {
             "type": "object",
-            "required": [
-                "category",
-                "description",
-                "images",
-                "name",
-                "price"
-            ],
             "properties": {
-                "category": {
-                    "type": "string",
-                    "minLength": 3
-                },
-                "description": {
-                    "type": "string",
-                    "minLength": 10
-                },
-                "images": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "cents": {
+                    "type": "integer",
+                    "example": 1990
                 },
-                "name": {
+                "currency": {
                     "type": "string",
-                    "minLength": 2
-                },
-                "price": {
-                    "type": "number",
-                    "minimum": 0
+                    "example": "BRL"
                 }
             }
         },
-        "dto.ProductInputUpdate": {
+        "handler.ErrorResponse": {
             "type": "object",
-            "required": [
-                "id"
-            ],
             "properties": {
-                "category": {
-                    "type": "string",
-                    "minLength": 3
+                "code": {
+                    "type": "integer",
+                    "example": 400
                 },
-                "description": {
+                "details": {
                     "type": "string",
-                    "minLength": 10
+                    "example": "Additional information"
                 },
-                "id": {
-                    "type": "integer"
+                "error": {
+                    "type": "string",
+                    "example": "Invalid request"
+                }
+            }
+        },
+        "handler.InsufficientStockItem": {
+            "type": "object",
+            "properties": {
+                "available": {
+                    "type": "integer",
+                    "example": 1
                 },
-                "images": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "product_id": {
+                    "type": "integer",
+                    "example": 1
                 },
-                "name": {
+                "product_name": {
                     "type": "string",
-                    "minLength": 2
+                    "example": "X-Burger"
                 },
-                "price": {
-                    "type": "number",
-                    "minimum": 0
+                "requested": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "variant_id": {
+                    "type": "integer",
+                    "example": 3
                 }
             }
         },
-        "dto.ProductOutput": {
+        "handler.InsufficientStockResponse": {
             "type": "object",
             "properties": {
-                "category": {
-                    "type": "string"
-                },
-                "description": {
-                    "type": "string"
-                },
-                "id": {
-                    "type": "integer"
+                "error": {
+                    "type": "string",
+                    "example": "Insufficient stock: X-Burger (requested 3, available 1)"
                 },
-                "images": {
+                "items": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/handler.InsufficientStockItem"
                     }
-                },
-                "name": {
-                    "type": "string"
-                },
-                "price": {
-                    "type": "number"
                 }
             }
         },
-        "handler.ErrorResponse": {
+        "handler.PaymentDeclinedResponse": {
             "type": "object",
             "properties": {
-                "code": {
-                    "type": "integer",
-                    "example": 400
-                },
-                "details": {
+                "decline_code": {
                     "type": "string",
-                    "example": "Additional information"
+                    "example": "insufficient_funds"
                 },
                 "error": {
                     "type": "string",
-                    "example": "Invalid request"
+                    "example": "Saldo insuficiente. Tente outro cartão ou forma de pagamento."
+                }
+            }
+        },
+        "validator.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "field": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
                 }
             }
         }